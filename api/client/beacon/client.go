@@ -31,10 +31,16 @@ const (
 	getForkForStatePath      = "/eth/v1/beacon/states/{{.Id}}/fork"
 	getWeakSubjectivityPath  = "/prysm/v1/beacon/weak_subjectivity"
 	getForkSchedulePath      = "/eth/v1/config/fork_schedule"
+	getGenesisPath           = "/eth/v1/beacon/genesis"
 	getConfigSpecPath        = "/eth/v1/config/spec"
 	getStatePath             = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath       = "/eth/v1/node/version"
 	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getBlockHeaderPath       = "/eth/v1/beacon/headers/{{.Id}}"
+	getCommitteesPath        = "/eth/v1/beacon/states/{{.Id}}/committees"
+	getSyncStatusPath        = "/eth/v1/node/syncing"
+	attesterSlashingsPath    = "/eth/v1/beacon/pool/attester_slashings"
+	proposerSlashingsPath    = "/eth/v1/beacon/pool/proposer_slashings"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -136,6 +142,72 @@ func (c *Client) GetBlockRoot(ctx context.Context, blockId StateOrBlockId) ([32]
 	return bytesutil.ToBytes32(rs), nil
 }
 
+var getBlockHeaderTpl = idTemplate(getBlockHeaderPath)
+
+// GetBlockHeader retrieves the signed header of the block for the given block id.
+// Block identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded blockRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetBlockHeader(ctx context.Context, blockId StateOrBlockId) (*structs.SignedBeaconBlockHeaderContainer, error) {
+	body, err := c.Get(ctx, getBlockHeaderTpl(blockId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting block header by id = %s", blockId)
+	}
+	hr := &structs.GetBlockHeaderResponse{}
+	if err := json.Unmarshal(body, hr); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetBlockHeader")
+	}
+	if hr.Data == nil || hr.Data.Header == nil {
+		return nil, errors.New("empty block header response")
+	}
+	return hr.Data, nil
+}
+
+var getCommitteesTpl = idTemplate(getCommitteesPath)
+
+// GetCommittees retrieves the beacon committees of the given epoch, computed from the state identified by stateId.
+func (c *Client) GetCommittees(ctx context.Context, stateId StateOrBlockId, epoch primitives.Epoch) ([]*structs.Committee, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{
+		Path:     getCommitteesTpl(stateId),
+		RawQuery: url.Values{"epoch": []string{strconv.FormatUint(uint64(epoch), 10)}}.Encode(),
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting committees of epoch %d", epoch)
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, client.Non200Err(resp)
+	}
+	cr := &structs.GetCommitteesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(cr); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetCommittees")
+	}
+	return cr.Data, nil
+}
+
+// GetSyncStatus retrieves the sync status of the beacon node.
+func (c *Client) GetSyncStatus(ctx context.Context) (*structs.SyncStatusResponseData, error) {
+	body, err := c.Get(ctx, getSyncStatusPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting sync status")
+	}
+	sr := &structs.SyncStatusResponse{}
+	if err := json.Unmarshal(body, sr); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetSyncStatus")
+	}
+	if sr.Data == nil {
+		return nil, errors.New("empty sync status response")
+	}
+	return sr.Data, nil
+}
+
 var getForkTpl = idTemplate(getForkForStatePath)
 
 // GetFork queries the Beacon Node API for the Fork from the state identified by stateId.
@@ -175,6 +247,22 @@ func (c *Client) GetForkSchedule(ctx context.Context) (forks.OrderedSchedule, er
 	return ofs, nil
 }
 
+// GetGenesis retrieves the genesis time, genesis validators root and genesis fork version of the network.
+func (c *Client) GetGenesis(ctx context.Context) (*structs.Genesis, error) {
+	body, err := c.Get(ctx, getGenesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting genesis")
+	}
+	gr := &structs.GetGenesisResponse{}
+	if err := json.Unmarshal(body, gr); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetGenesis")
+	}
+	if gr.Data == nil {
+		return nil, errors.New("empty genesis response")
+	}
+	return gr.Data, nil
+}
+
 // GetConfigSpec retrieve the current configs of the network used by the beacon node.
 func (c *Client) GetConfigSpec(ctx context.Context) (*structs.GetSpecResponse, error) {
 	body, err := c.Get(ctx, getConfigSpecPath)
@@ -319,6 +407,42 @@ func (c *Client) SubmitChangeBLStoExecution(ctx context.Context, request []*stru
 	return nil
 }
 
+// SubmitAttesterSlashing submits an attester slashing to the operations pool of the beacon node, which verifies it
+// and broadcasts it to the network.
+func (c *Client) SubmitAttesterSlashing(ctx context.Context, slashing *structs.AttesterSlashing) error {
+	return c.post(ctx, attesterSlashingsPath, slashing)
+}
+
+// SubmitProposerSlashing submits a proposer slashing to the operations pool of the beacon node, which verifies it
+// and broadcasts it to the network.
+func (c *Client) SubmitProposerSlashing(ctx context.Context, slashing *structs.ProposerSlashing) error {
+	return c.post(ctx, proposerSlashingsPath, slashing)
+}
+
+func (c *Client) post(ctx context.Context, p string, v interface{}) error {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: p})
+	body, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	return nil
+}
+
 // GetBLStoExecutionChanges gets all the set withdrawal messages in the node's operation pool.
 // Returns a struct representation of json response.
 func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*structs.BLSToExecutionChangesPoolResponse, error) {
@@ -0,0 +1,90 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+)
+
+const (
+	getLightClientBootstrapPath        = "/eth/v1/beacon/light_client/bootstrap/{{.Id}}"
+	getLightClientUpdatesByRangePath   = "/eth/v1/beacon/light_client/updates"
+	getLightClientFinalityUpdatePath   = "/eth/v1/beacon/light_client/finality_update"
+	getLightClientOptimisticUpdatePath = "/eth/v1/beacon/light_client/optimistic_update"
+)
+
+var getLightClientBootstrapTpl = idTemplate(getLightClientBootstrapPath)
+
+// GetLightClientBootstrap retrieves the light client bootstrap for the given block root, which is the trusted
+// starting point from which a light client follows the chain.
+func (c *Client) GetLightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*structs.LightClientBootstrapResponse, error) {
+	body, err := c.Get(ctx, getLightClientBootstrapTpl(IdFromRoot(blockRoot)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting light client bootstrap for block root %#x", blockRoot)
+	}
+	resp := &structs.LightClientBootstrapResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetLightClientBootstrap")
+	}
+	return resp, nil
+}
+
+// GetLightClientUpdatesByRange retrieves the best light client updates of up to count consecutive sync committee
+// periods, starting from startPeriod.
+func (c *Client) GetLightClientUpdatesByRange(ctx context.Context, startPeriod, count uint64) ([]*structs.LightClientUpdateWithVersion, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{
+		Path: getLightClientUpdatesByRangePath,
+		RawQuery: url.Values{
+			"start_period": []string{strconv.FormatUint(startPeriod, 10)},
+			"count":        []string{strconv.FormatUint(count, 10)},
+		}.Encode(),
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting light client updates")
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, client.Non200Err(resp)
+	}
+	var updates []*structs.LightClientUpdateWithVersion
+	if err := json.NewDecoder(resp.Body).Decode(&updates); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetLightClientUpdatesByRange")
+	}
+	return updates, nil
+}
+
+// GetLightClientFinalityUpdate retrieves the latest light client finality update known to the beacon node.
+func (c *Client) GetLightClientFinalityUpdate(ctx context.Context) (*structs.LightClientUpdateWithVersion, error) {
+	return c.getLightClientUpdate(ctx, getLightClientFinalityUpdatePath)
+}
+
+// GetLightClientOptimisticUpdate retrieves the latest light client optimistic update known to the beacon node.
+func (c *Client) GetLightClientOptimisticUpdate(ctx context.Context) (*structs.LightClientUpdateWithVersion, error) {
+	return c.getLightClientUpdate(ctx, getLightClientOptimisticUpdatePath)
+}
+
+func (c *Client) getLightClientUpdate(ctx context.Context, path string) (*structs.LightClientUpdateWithVersion, error) {
+	body, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting %s", path)
+	}
+	resp := &structs.LightClientUpdateWithVersion{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error decoding json response from %s", path))
+	}
+	return resp, nil
+}
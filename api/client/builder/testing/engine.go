@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// Engine is the execution engine the relay builds the payloads of its bids with.
+type Engine interface {
+	BuildPayload(ctx context.Context, attr *PayloadAttributes) (interfaces.ExecutionData, *v1.BlobsBundle, error)
+}
+
+// PayloadAttributes are the attributes of the payload the relay requests from the engine for a slot.
+type PayloadAttributes struct {
+	Version      int
+	Slot         primitives.Slot
+	ParentHash   [32]byte
+	FeeRecipient []byte
+	GasLimit     uint64
+}
+
+// MockEngine is an Engine building empty payloads on top of the requested parent hash. The block hash of a payload
+// is derived from its attributes, so that the same attributes always give the same payload.
+type MockEngine struct {
+	// GenesisTime is used to compute the timestamp of the payloads.
+	GenesisTime uint64
+	// Value is the value in wei of the payloads, zero when nil.
+	Value *big.Int
+	// Withdrawals are the withdrawals of the payloads since Capella.
+	Withdrawals []*v1.Withdrawal
+	// BlobsBundle is the blobs bundle of the payloads since Deneb, empty when nil.
+	BlobsBundle *v1.BlobsBundle
+}
+
+// BuildPayload --
+func (e *MockEngine) BuildPayload(_ context.Context, attr *PayloadAttributes) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	value := big.NewInt(0)
+	if e.Value != nil {
+		value = new(big.Int).Set(e.Value)
+	}
+	feeRecipient := make([]byte, fieldparams.FeeRecipientLength)
+	copy(feeRecipient, attr.FeeRecipient)
+	slot := make([]byte, 8)
+	binary.LittleEndian.PutUint64(slot, uint64(attr.Slot))
+	blockHash := hash.Hash(append(append(attr.ParentHash[:], slot...), feeRecipient...))
+	timestamp := e.GenesisTime + uint64(attr.Slot)*params.BeaconConfig().SecondsPerSlot
+
+	switch attr.Version {
+	case version.Bellatrix:
+		p, err := blocks.WrappedExecutionPayload(&v1.ExecutionPayload{
+			ParentHash:    attr.ParentHash[:],
+			FeeRecipient:  feeRecipient,
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BlockNumber:   uint64(attr.Slot),
+			GasLimit:      attr.GasLimit,
+			Timestamp:     timestamp,
+			ExtraData:     make([]byte, 0),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     blockHash[:],
+			Transactions:  make([][]byte, 0),
+		})
+		return p, nil, err
+	case version.Capella:
+		p, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{
+			ParentHash:    attr.ParentHash[:],
+			FeeRecipient:  feeRecipient,
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BlockNumber:   uint64(attr.Slot),
+			GasLimit:      attr.GasLimit,
+			Timestamp:     timestamp,
+			ExtraData:     make([]byte, 0),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     blockHash[:],
+			Transactions:  make([][]byte, 0),
+			Withdrawals:   e.withdrawals(),
+		}, value)
+		return p, nil, err
+	case version.Deneb:
+		p, err := blocks.WrappedExecutionPayloadDeneb(&v1.ExecutionPayloadDeneb{
+			ParentHash:    attr.ParentHash[:],
+			FeeRecipient:  feeRecipient,
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BlockNumber:   uint64(attr.Slot),
+			GasLimit:      attr.GasLimit,
+			Timestamp:     timestamp,
+			ExtraData:     make([]byte, 0),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     blockHash[:],
+			Transactions:  make([][]byte, 0),
+			Withdrawals:   e.withdrawals(),
+		}, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		bundle := e.BlobsBundle
+		if bundle == nil {
+			bundle = &v1.BlobsBundle{KzgCommitments: [][]byte{}, Proofs: [][]byte{}, Blobs: [][]byte{}}
+		}
+		return p, bundle, nil
+	default:
+		return nil, nil, errors.Errorf("unsupported payload version %s", version.String(attr.Version))
+	}
+}
+
+func (e *MockEngine) withdrawals() []*v1.Withdrawal {
+	if e.Withdrawals == nil {
+		return make([]*v1.Withdrawal, 0)
+	}
+	return e.Withdrawals
+}
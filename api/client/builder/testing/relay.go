@@ -0,0 +1,573 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	fssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	relayStatusPath        = "/eth/v1/builder/status"
+	relayValidatorsPath    = "/eth/v1/builder/validators"
+	relayHeaderPath        = "/eth/v1/builder/header/{slot:[0-9]+}/{parent_hash:0x[a-fA-F0-9]+}/{pubkey:0x[a-fA-F0-9]+}"
+	relayBlindedBlocksPath = "/eth/v1/builder/blinded_blocks"
+)
+
+// Behavior scripts how the relay answers the requests of a slot. The zero value is an honest relay.
+type Behavior struct {
+	// Offline makes every endpoint of the relay fail, including the status endpoint.
+	Offline bool
+	// NoBid makes the relay answer the header request with no content.
+	NoBid bool
+	// BidDelay delays the answer to the header request.
+	BidDelay time.Duration
+	// BidValue is the value in wei of the bid. The value of the payload built by the engine is used when nil.
+	BidValue *big.Int
+	// InvalidSignature signs the bid with a key which does not match the builder public key of the bid.
+	InvalidSignature bool
+	// WrongParentHash builds the bid on another parent hash than the requested one.
+	WrongParentHash bool
+	// WithholdPayload makes the relay fail to reveal the payload of a blinded block built on its bid.
+	WithholdPayload bool
+}
+
+// builtPayload is a payload the relay built for a bid, kept until the blinded block built on it is submitted.
+type builtPayload struct {
+	slot    primitives.Slot
+	payload interfaces.ExecutionData
+	bundle  *v1.BlobsBundle
+}
+
+// RelayOpt is a functional option for the Relay type.
+type RelayOpt func(*Relay)
+
+// WithSecretKey sets the BLS key the relay signs its bids with. A random key is used by default.
+func WithSecretKey(sk bls.SecretKey) RelayOpt {
+	return func(r *Relay) {
+		r.sk = sk
+	}
+}
+
+// WithEngine sets the execution engine the relay builds its payloads with. A MockEngine is used by default.
+func WithEngine(e Engine) RelayOpt {
+	return func(r *Relay) {
+		r.engine = e
+	}
+}
+
+// Relay is an in-process builder relay implementing the builder API, for tests. It builds its payloads with an
+// execution engine stand-in, signs its bids with a configurable BLS key, and can be scripted to misbehave.
+type Relay struct {
+	sk            bls.SecretKey
+	engine        Engine
+	router        *mux.Router
+	srv           *http.Server
+	url           string
+	lock          sync.RWMutex
+	behavior      Behavior
+	slotBehaviors map[primitives.Slot]Behavior
+	registrations map[[fieldparams.BLSPubkeyLength]byte]*ethpb.ValidatorRegistrationV1
+	payloads      map[[32]byte]*builtPayload
+	revealed      map[[32]byte]bool
+	headerCount   int
+	blindedCount  int
+}
+
+// NewRelay creates a relay. It serves HTTP requests once started, or through ServeHTTP.
+func NewRelay(opts ...RelayOpt) (*Relay, error) {
+	r := &Relay{
+		slotBehaviors: make(map[primitives.Slot]Behavior),
+		registrations: make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.ValidatorRegistrationV1),
+		payloads:      make(map[[32]byte]*builtPayload),
+		revealed:      make(map[[32]byte]bool),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	if r.sk == nil {
+		sk, err := bls.RandKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate relay key")
+		}
+		r.sk = sk
+	}
+	if r.engine == nil {
+		r.engine = &MockEngine{}
+	}
+	r.router = mux.NewRouter()
+	r.router.HandleFunc(relayStatusPath, r.handleStatus).Methods(http.MethodGet)
+	r.router.HandleFunc(relayValidatorsPath, r.handleRegisterValidators).Methods(http.MethodPost)
+	r.router.HandleFunc(relayHeaderPath, r.handleHeader).Methods(http.MethodGet)
+	r.router.HandleFunc(relayBlindedBlocksPath, r.handleBlindedBlock).Methods(http.MethodPost)
+	return r, nil
+}
+
+// Start serves the builder API on a random local port.
+func (r *Relay) Start() error {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return errors.Wrap(err, "could not listen")
+	}
+	r.url = "http://" + l.Addr().String()
+	r.srv = &http.Server{Handler: r, ReadHeaderTimeout: time.Second}
+	go func() {
+		if err := r.srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error("Mock relay stopped serving")
+		}
+	}()
+	return nil
+}
+
+// Stop stops serving the builder API.
+func (r *Relay) Stop(ctx context.Context) error {
+	if r.srv == nil {
+		return nil
+	}
+	return r.srv.Shutdown(ctx)
+}
+
+// URL returns the base URL of the relay once started.
+func (r *Relay) URL() string {
+	return r.url
+}
+
+// PublicKey returns the public key of the builder of the relay.
+func (r *Relay) PublicKey() bls.PublicKey {
+	return r.sk.PublicKey()
+}
+
+// ServeHTTP --
+func (r *Relay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.router.ServeHTTP(w, req)
+}
+
+// SetBehavior sets the behavior of the relay for the slots without a behavior of their own.
+func (r *Relay) SetBehavior(b Behavior) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.behavior = b
+}
+
+// SetSlotBehavior sets the behavior of the relay for the slot.
+func (r *Relay) SetSlotBehavior(slot primitives.Slot, b Behavior) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.slotBehaviors[slot] = b
+}
+
+// Registration returns the registration the relay received for the validator.
+func (r *Relay) Registration(pubkey [fieldparams.BLSPubkeyLength]byte) (*ethpb.ValidatorRegistrationV1, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	reg, ok := r.registrations[pubkey]
+	return reg, ok
+}
+
+// Revealed returns whether the relay revealed the payload of the block hash.
+func (r *Relay) Revealed(blockHash [32]byte) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.revealed[blockHash]
+}
+
+// HeaderRequests returns the number of header requests the relay received.
+func (r *Relay) HeaderRequests() int {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.headerCount
+}
+
+// BlindedBlocks returns the number of blinded blocks the relay received.
+func (r *Relay) BlindedBlocks() int {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.blindedCount
+}
+
+func (r *Relay) behaviorAt(slot primitives.Slot) Behavior {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if b, ok := r.slotBehaviors[slot]; ok {
+		return b
+	}
+	return r.behavior
+}
+
+func (r *Relay) isOffline() bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.behavior.Offline
+}
+
+func (r *Relay) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	if r.isOffline() {
+		writeRelayError(w, http.StatusServiceUnavailable, "relay is offline")
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Relay) handleRegisterValidators(w http.ResponseWriter, req *http.Request) {
+	if r.isOffline() {
+		writeRelayError(w, http.StatusServiceUnavailable, "relay is offline")
+		return
+	}
+	var registrations []*structs.SignedValidatorRegistration
+	if err := json.NewDecoder(req.Body).Decode(&registrations); err != nil {
+		writeRelayError(w, http.StatusBadRequest, "could not decode registrations: "+err.Error())
+		return
+	}
+	d, err := builderDomain()
+	if err != nil {
+		writeRelayError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	verified := make([]*ethpb.ValidatorRegistrationV1, len(registrations))
+	for i, reg := range registrations {
+		if reg == nil {
+			writeRelayError(w, http.StatusBadRequest, "nil registration")
+			return
+		}
+		signed, err := reg.ToConsensus()
+		if err != nil {
+			writeRelayError(w, http.StatusBadRequest, "could not convert registration: "+err.Error())
+			return
+		}
+		if err := signing.VerifySigningRoot(signed.Message, signed.Message.Pubkey, signed.Signature, d); err != nil {
+			writeRelayError(w, http.StatusBadRequest, fmt.Sprintf("invalid registration signature of %#x", signed.Message.Pubkey))
+			return
+		}
+		verified[i] = signed.Message
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, reg := range verified {
+		r.registrations[bytesutil.ToBytes48(reg.Pubkey)] = reg
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Relay) handleHeader(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	s, err := strconv.ParseUint(vars["slot"], 10, 64)
+	if err != nil {
+		writeRelayError(w, http.StatusBadRequest, "invalid slot")
+		return
+	}
+	slot := primitives.Slot(s)
+	parentHash, err := hexutil.Decode(vars["parent_hash"])
+	if err != nil || len(parentHash) != fieldparams.RootLength {
+		writeRelayError(w, http.StatusBadRequest, "invalid parent hash")
+		return
+	}
+	pubkey, err := hexutil.Decode(vars["pubkey"])
+	if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+		writeRelayError(w, http.StatusBadRequest, "invalid pubkey")
+		return
+	}
+	r.lock.Lock()
+	r.headerCount++
+	r.lock.Unlock()
+
+	b := r.behaviorAt(slot)
+	if b.Offline {
+		writeRelayError(w, http.StatusServiceUnavailable, "relay is offline")
+		return
+	}
+	if b.BidDelay > 0 {
+		select {
+		case <-time.After(b.BidDelay):
+		case <-req.Context().Done():
+			return
+		}
+	}
+	reg, registered := r.Registration(bytesutil.ToBytes48(pubkey))
+	if b.NoBid || !registered {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	attr := &PayloadAttributes{
+		Version:      slotVersion(slot),
+		Slot:         slot,
+		ParentHash:   bytesutil.ToBytes32(parentHash),
+		FeeRecipient: reg.FeeRecipient,
+		GasLimit:     reg.GasLimit,
+	}
+	if attr.Version < version.Bellatrix {
+		writeRelayError(w, http.StatusBadRequest, "slot is before bellatrix")
+		return
+	}
+	if b.WrongParentHash {
+		attr.ParentHash = hash.Hash(parentHash)
+	}
+	payload, bundle, err := r.engine.BuildPayload(req.Context(), attr)
+	if err != nil {
+		writeRelayError(w, http.StatusInternalServerError, "could not build payload: "+err.Error())
+		return
+	}
+	value := b.BidValue
+	if value == nil {
+		v, err := payload.ValueInWei()
+		if err != nil || v == nil {
+			value = big.NewInt(0)
+		} else {
+			value = v
+		}
+	}
+	resp, err := r.signedBid(attr.Version, payload, bundle, value, b.InvalidSignature)
+	if err != nil {
+		writeRelayError(w, http.StatusInternalServerError, "could not sign bid: "+err.Error())
+		return
+	}
+	r.lock.Lock()
+	r.payloads[bytesutil.ToBytes32(payload.BlockHash())] = &builtPayload{slot: slot, payload: payload, bundle: bundle}
+	r.lock.Unlock()
+	writeRelayJson(w, resp)
+}
+
+// signedBid is the builder API representation of a signed bid of any fork.
+type signedBid struct {
+	Version string `json:"version"`
+	Data    struct {
+		Signature hexutil.Bytes `json:"signature"`
+		Message   interface{}   `json:"message"`
+	} `json:"data"`
+}
+
+func (r *Relay) signedBid(v int, payload interfaces.ExecutionData, bundle *v1.BlobsBundle, value *big.Int, invalidSignature bool) (*signedBid, error) {
+	pubkey := r.sk.PublicKey().Marshal()
+	val := builder.Uint256{Int: value}
+	var msg interface{}
+	var sszMsg fssz.HashRoot
+	switch v {
+	case version.Bellatrix:
+		hdr, err := blocks.PayloadToHeader(payload)
+		if err != nil {
+			return nil, err
+		}
+		msg = &builder.BuilderBid{Header: &builder.ExecutionPayloadHeader{ExecutionPayloadHeader: hdr}, Value: val, Pubkey: pubkey}
+		sszMsg = &ethpb.BuilderBid{Header: hdr, Value: val.SSZBytes(), Pubkey: pubkey}
+	case version.Capella:
+		hdr, err := blocks.PayloadToHeaderCapella(payload)
+		if err != nil {
+			return nil, err
+		}
+		msg = &builder.BuilderBidCapella{Header: &builder.ExecutionPayloadHeaderCapella{ExecutionPayloadHeaderCapella: hdr}, Value: val, Pubkey: pubkey}
+		sszMsg = &ethpb.BuilderBidCapella{Header: hdr, Value: val.SSZBytes(), Pubkey: pubkey}
+	case version.Deneb:
+		hdr, err := blocks.PayloadToHeaderDeneb(payload)
+		if err != nil {
+			return nil, err
+		}
+		commitments := make([]hexutil.Bytes, len(bundle.KzgCommitments))
+		for i, c := range bundle.KzgCommitments {
+			commitments[i] = c
+		}
+		msg = &builder.BuilderBidDeneb{
+			Header:             &builder.ExecutionPayloadHeaderDeneb{ExecutionPayloadHeaderDeneb: hdr},
+			BlobKzgCommitments: commitments,
+			Value:              val,
+			Pubkey:             pubkey,
+		}
+		sszMsg = &ethpb.BuilderBidDeneb{Header: hdr, BlobKzgCommitments: bundle.KzgCommitments, Value: val.SSZBytes(), Pubkey: pubkey}
+	default:
+		return nil, errors.Errorf("unsupported bid version %s", version.String(v))
+	}
+	d, err := builderDomain()
+	if err != nil {
+		return nil, err
+	}
+	root, err := signing.ComputeSigningRoot(sszMsg, d)
+	if err != nil {
+		return nil, err
+	}
+	sk := r.sk
+	if invalidSignature {
+		if sk, err = bls.RandKey(); err != nil {
+			return nil, err
+		}
+	}
+	resp := &signedBid{Version: version.String(v)}
+	resp.Data.Signature = sk.Sign(root[:]).Marshal()
+	resp.Data.Message = msg
+	return resp, nil
+}
+
+func (r *Relay) handleBlindedBlock(w http.ResponseWriter, req *http.Request) {
+	if r.isOffline() {
+		writeRelayError(w, http.StatusServiceUnavailable, "relay is offline")
+		return
+	}
+	blk, err := decodeBlindedBlock(req)
+	if err != nil {
+		writeRelayError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	header, err := blk.Block().Body().Execution()
+	if err != nil {
+		writeRelayError(w, http.StatusBadRequest, "could not get execution payload header: "+err.Error())
+		return
+	}
+	blockHash := bytesutil.ToBytes32(header.BlockHash())
+	r.lock.Lock()
+	r.blindedCount++
+	p, ok := r.payloads[blockHash]
+	r.lock.Unlock()
+	if !ok {
+		writeRelayError(w, http.StatusBadRequest, fmt.Sprintf("unknown payload %#x", blockHash))
+		return
+	}
+	if r.behaviorAt(p.slot).WithholdPayload {
+		writeRelayError(w, http.StatusInternalServerError, "payload withheld")
+		return
+	}
+	resp, err := payloadResponse(p)
+	if err != nil {
+		writeRelayError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	r.lock.Lock()
+	r.revealed[blockHash] = true
+	r.lock.Unlock()
+	writeRelayJson(w, resp)
+}
+
+func decodeBlindedBlock(req *http.Request) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	var generic *ethpb.GenericSignedBeaconBlock
+	var err error
+	switch req.Header.Get("Eth-Consensus-Version") {
+	case version.String(version.Bellatrix):
+		b := &structs.SignedBlindedBeaconBlockBellatrix{}
+		if err := json.NewDecoder(req.Body).Decode(b); err != nil {
+			return nil, errors.Wrap(err, "could not decode blinded block")
+		}
+		generic, err = b.ToGeneric()
+	case version.String(version.Capella):
+		b := &structs.SignedBlindedBeaconBlockCapella{}
+		if err := json.NewDecoder(req.Body).Decode(b); err != nil {
+			return nil, errors.Wrap(err, "could not decode blinded block")
+		}
+		generic, err = b.ToGeneric()
+	case version.String(version.Deneb):
+		b := &structs.SignedBlindedBeaconBlockDeneb{}
+		if err := json.NewDecoder(req.Body).Decode(b); err != nil {
+			return nil, errors.Wrap(err, "could not decode blinded block")
+		}
+		generic, err = b.ToGeneric()
+	default:
+		return nil, errors.Errorf("unsupported consensus version %q", req.Header.Get("Eth-Consensus-Version"))
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert blinded block")
+	}
+	return blocks.NewSignedBeaconBlock(generic.Block)
+}
+
+func payloadResponse(p *builtPayload) (interface{}, error) {
+	switch p.payload.Proto().(type) {
+	case *v1.ExecutionPayload:
+		pb, err := p.payload.PbBellatrix()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := builder.FromProto(pb)
+		if err != nil {
+			return nil, err
+		}
+		return &builder.ExecPayloadResponse{Version: version.String(version.Bellatrix), Data: payload}, nil
+	case *v1.ExecutionPayloadCapella:
+		pb, err := p.payload.PbCapella()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := builder.FromProtoCapella(pb)
+		if err != nil {
+			return nil, err
+		}
+		return &builder.ExecPayloadResponseCapella{Version: version.String(version.Capella), Data: payload}, nil
+	case *v1.ExecutionPayloadDeneb:
+		pb, err := p.payload.PbDeneb()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := builder.FromProtoDeneb(pb)
+		if err != nil {
+			return nil, err
+		}
+		return &builder.ExecPayloadResponseDeneb{
+			Version: version.String(version.Deneb),
+			Data: &builder.ExecutionPayloadDenebAndBlobsBundle{
+				ExecutionPayload: &payload,
+				BlobsBundle:      builder.FromBundleProto(p.bundle),
+			},
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported payload type %T", p.payload.Proto())
+	}
+}
+
+// slotVersion returns the fork version of the payloads of the slot.
+func slotVersion(slot primitives.Slot) int {
+	epoch := slots.ToEpoch(slot)
+	cfg := params.BeaconConfig()
+	switch {
+	case epoch >= cfg.DenebForkEpoch:
+		return version.Deneb
+	case epoch >= cfg.CapellaForkEpoch:
+		return version.Capella
+	case epoch >= cfg.BellatrixForkEpoch:
+		return version.Bellatrix
+	default:
+		return version.Altair
+	}
+}
+
+func builderDomain() ([]byte, error) {
+	return signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+}
+
+func writeRelayJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not encode mock relay response")
+	}
+}
+
+func writeRelayError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(&builder.ErrorMessage{Code: code, Message: message}); err != nil {
+		log.WithError(err).Error("Could not encode mock relay error")
+	}
+}
@@ -0,0 +1,234 @@
+package testing
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+const (
+	bellatrixSlot = primitives.Slot(32)
+	capellaSlot   = primitives.Slot(64)
+	denebSlot     = primitives.Slot(96)
+)
+
+func setupRelayTestConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 1
+	cfg.CapellaForkEpoch = 2
+	cfg.DenebForkEpoch = 3
+	params.OverrideBeaconConfig(cfg)
+}
+
+func startRelay(t *testing.T, opts ...RelayOpt) (*Relay, *builder.Client) {
+	r, err := NewRelay(opts...)
+	require.NoError(t, err)
+	require.NoError(t, r.Start())
+	t.Cleanup(func() {
+		require.NoError(t, r.Stop(context.Background()))
+	})
+	c, err := builder.NewClient(r.URL())
+	require.NoError(t, err)
+	return r, c
+}
+
+func signedRegistration(t *testing.T, sk bls.SecretKey) *ethpb.SignedValidatorRegistrationV1 {
+	msg := &ethpb.ValidatorRegistrationV1{
+		FeeRecipient: bytesutil.PadTo([]byte{'f'}, fieldparams.FeeRecipientLength),
+		GasLimit:     30000000,
+		Timestamp:    uint64(time.Now().Unix()),
+		Pubkey:       sk.PublicKey().Marshal(),
+	}
+	d, err := builderDomain()
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(msg, d)
+	require.NoError(t, err)
+	return &ethpb.SignedValidatorRegistrationV1{Message: msg, Signature: sk.Sign(root[:]).Marshal()}
+}
+
+func verifyBid(bid builder.SignedBid) error {
+	msg, err := bid.Message()
+	if err != nil {
+		return err
+	}
+	d, err := builderDomain()
+	if err != nil {
+		return err
+	}
+	return signing.VerifySigningRoot(msg, msg.Pubkey(), bid.Signature(), d)
+}
+
+func bidHeader(t *testing.T, bid builder.SignedBid) interfaces.ExecutionData {
+	msg, err := bid.Message()
+	require.NoError(t, err)
+	header, err := msg.Header()
+	require.NoError(t, err)
+	return header
+}
+
+func blindedBlock(t *testing.T, slot primitives.Slot, header interfaces.ExecutionData) interfaces.ReadOnlySignedBeaconBlock {
+	var blk interfaces.ReadOnlySignedBeaconBlock
+	var err error
+	switch h := header.Proto().(type) {
+	case *v1.ExecutionPayloadHeader:
+		b := util.NewBlindedBeaconBlockBellatrix()
+		b.Block.Slot = slot
+		b.Block.Body.ExecutionPayloadHeader = h
+		blk, err = blocks.NewSignedBeaconBlock(b)
+	case *v1.ExecutionPayloadHeaderCapella:
+		b := util.NewBlindedBeaconBlockCapella()
+		b.Block.Slot = slot
+		b.Block.Body.ExecutionPayloadHeader = h
+		blk, err = blocks.NewSignedBeaconBlock(b)
+	case *v1.ExecutionPayloadHeaderDeneb:
+		b := util.NewBlindedBeaconBlockDeneb()
+		b.Message.Slot = slot
+		b.Message.Body.ExecutionPayloadHeader = h
+		blk, err = blocks.NewSignedBeaconBlock(b)
+	default:
+		t.Fatalf("unexpected header type %T", h)
+	}
+	require.NoError(t, err)
+	return blk
+}
+
+func TestRelay_Honest(t *testing.T) {
+	setupRelayTestConfig(t)
+	ctx := context.Background()
+	engine := &MockEngine{Value: big.NewInt(1000)}
+	r, c := startRelay(t, WithEngine(engine))
+	require.NoError(t, c.Status(ctx))
+
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	reg := signedRegistration(t, sk)
+	require.NoError(t, c.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{reg}))
+	saved, ok := r.Registration(pubkey)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, reg.Message.FeeRecipient, saved.FeeRecipient)
+
+	parent := [32]byte{'p'}
+	for _, slot := range []primitives.Slot{bellatrixSlot, capellaSlot, denebSlot} {
+		bid, err := c.GetHeader(ctx, slot, parent, pubkey)
+		require.NoError(t, err)
+		require.NoError(t, verifyBid(bid))
+		msg, err := bid.Message()
+		require.NoError(t, err)
+		assert.DeepEqual(t, r.PublicKey().Marshal(), msg.Pubkey())
+		header := bidHeader(t, bid)
+		assert.DeepEqual(t, parent[:], header.ParentHash())
+		assert.DeepEqual(t, reg.Message.FeeRecipient, header.FeeRecipient())
+		assert.Equal(t, reg.Message.GasLimit, header.GasLimit())
+		if slot >= capellaSlot {
+			assert.DeepEqual(t, bytesutil.PadTo([]byte{0xe8, 0x03}, 32), msg.Value())
+		}
+
+		payload, _, err := c.SubmitBlindedBlock(ctx, blindedBlock(t, slot, header))
+		require.NoError(t, err)
+		assert.DeepEqual(t, header.BlockHash(), payload.BlockHash())
+		assert.Equal(t, true, r.Revealed(bytesutil.ToBytes32(header.BlockHash())))
+	}
+	assert.Equal(t, 3, r.HeaderRequests())
+	assert.Equal(t, 3, r.BlindedBlocks())
+}
+
+func TestRelay_Misbehavior(t *testing.T) {
+	setupRelayTestConfig(t)
+	ctx := context.Background()
+	r, c := startRelay(t)
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	parent := [32]byte{'p'}
+
+	t.Run("unregistered validator", func(t *testing.T) {
+		_, err := c.GetHeader(ctx, capellaSlot, parent, pubkey)
+		require.ErrorIs(t, err, builder.ErrNoContent)
+	})
+	t.Run("invalid registration signature", func(t *testing.T) {
+		reg := signedRegistration(t, sk)
+		reg.Message.GasLimit++
+		require.ErrorContains(t, "invalid registration signature", c.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{reg}))
+	})
+	require.NoError(t, c.RegisterValidator(ctx, []*ethpb.SignedValidatorRegistrationV1{signedRegistration(t, sk)}))
+
+	t.Run("no bid", func(t *testing.T) {
+		r.SetSlotBehavior(capellaSlot+1, Behavior{NoBid: true})
+		_, err := c.GetHeader(ctx, capellaSlot+1, parent, pubkey)
+		require.ErrorIs(t, err, builder.ErrNoContent)
+	})
+	t.Run("late bid", func(t *testing.T) {
+		r.SetSlotBehavior(capellaSlot+2, Behavior{BidDelay: time.Second})
+		tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err := c.GetHeader(tctx, capellaSlot+2, parent, pubkey)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		r.SetSlotBehavior(capellaSlot+3, Behavior{InvalidSignature: true})
+		bid, err := c.GetHeader(ctx, capellaSlot+3, parent, pubkey)
+		require.NoError(t, err)
+		require.NotNil(t, verifyBid(bid))
+	})
+	t.Run("wrong parent hash", func(t *testing.T) {
+		r.SetSlotBehavior(capellaSlot+4, Behavior{WrongParentHash: true})
+		bid, err := c.GetHeader(ctx, capellaSlot+4, parent, pubkey)
+		require.NoError(t, err)
+		require.NoError(t, verifyBid(bid))
+		assert.NotEqual(t, parent, bytesutil.ToBytes32(bidHeader(t, bid).ParentHash()))
+	})
+	t.Run("bid value", func(t *testing.T) {
+		r.SetSlotBehavior(capellaSlot+5, Behavior{BidValue: big.NewInt(256)})
+		bid, err := c.GetHeader(ctx, capellaSlot+5, parent, pubkey)
+		require.NoError(t, err)
+		msg, err := bid.Message()
+		require.NoError(t, err)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{0, 1}, 32), msg.Value())
+	})
+	t.Run("withheld payload", func(t *testing.T) {
+		r.SetSlotBehavior(capellaSlot+6, Behavior{WithholdPayload: true})
+		bid, err := c.GetHeader(ctx, capellaSlot+6, parent, pubkey)
+		require.NoError(t, err)
+		header := bidHeader(t, bid)
+		_, _, err = c.SubmitBlindedBlock(ctx, blindedBlock(t, capellaSlot+6, header))
+		require.ErrorContains(t, "payload withheld", err)
+		assert.Equal(t, false, r.Revealed(bytesutil.ToBytes32(header.BlockHash())))
+	})
+	t.Run("unknown payload", func(t *testing.T) {
+		bid, err := c.GetHeader(ctx, capellaSlot+7, parent, pubkey)
+		require.NoError(t, err)
+		header, ok := bidHeader(t, bid).Proto().(*v1.ExecutionPayloadHeaderCapella)
+		require.Equal(t, true, ok)
+		header.BlockHash = bytesutil.PadTo([]byte{'u'}, 32)
+		wrapped, err := blocks.WrappedExecutionPayloadHeaderCapella(header, big.NewInt(0))
+		require.NoError(t, err)
+		_, _, err = c.SubmitBlindedBlock(ctx, blindedBlock(t, capellaSlot+7, wrapped))
+		require.ErrorContains(t, "unknown payload", err)
+	})
+	t.Run("offline", func(t *testing.T) {
+		r.SetBehavior(Behavior{Offline: true})
+		require.ErrorIs(t, c.Status(ctx), builder.ErrNotOK)
+		_, err := c.GetHeader(ctx, capellaSlot+8, parent, pubkey)
+		require.ErrorIs(t, err, builder.ErrNotOK)
+		r.SetBehavior(Behavior{})
+		require.NoError(t, c.Status(ctx))
+	})
+}
@@ -1088,3 +1088,56 @@ func DepositSnapshotFromConsensus(ds *eth.DepositSnapshot) *DepositSnapshot {
 		ExecutionBlockHeight: fmt.Sprintf("%d", ds.ExecutionDepth),
 	}
 }
+
+func ValidatorEpochPerformanceFromConsensus(p *validator.EpochPerformance) *ValidatorEpochPerformance {
+	return &ValidatorEpochPerformance{
+		ValidatorIndex:             fmt.Sprintf("%d", p.ValidatorIndex),
+		Epoch:                      fmt.Sprintf("%d", p.Epoch),
+		AttestationIncluded:        p.AttestationIncluded,
+		InclusionDistance:          fmt.Sprintf("%d", p.InclusionDistance),
+		MissedSource:               !p.CorrectSource,
+		MissedTarget:               !p.CorrectTarget,
+		MissedHead:                 !p.CorrectHead,
+		ProposerDuties:             fmt.Sprintf("%d", p.ProposerDuties),
+		MissedProposals:            fmt.Sprintf("%d", p.MissedProposals()),
+		SyncCommitteeDuties:        fmt.Sprintf("%d", p.SyncCommitteeDuties),
+		SyncCommitteeContributions: fmt.Sprintf("%d", p.SyncCommitteeContributions),
+		StartBalance:               fmt.Sprintf("%d", p.StartBalance),
+		EndBalance:                 fmt.Sprintf("%d", p.EndBalance),
+		BalanceChange:              fmt.Sprintf("%d", p.BalanceChange()),
+	}
+}
+
+func BuilderBidAuditFromConsensus(a *eth.BuilderBidAudit) *BuilderBidAudit {
+	bids := make([]*BuilderBid, len(a.Bids))
+	for i, b := range a.Bids {
+		bids[i] = BuilderBidFromConsensus(primitives.Slot(a.Slot), b)
+	}
+	return &BuilderBidAudit{
+		Slot:                  fmt.Sprintf("%d", a.Slot),
+		ProposerIndex:         fmt.Sprintf("%d", a.ProposerIndex),
+		ParentHash:            hexutil.Encode(a.ParentHash),
+		Bids:                  bids,
+		LocalValue:            bytesutil.LittleEndianBytesToBigInt(a.LocalValue).String(),
+		BuilderChosen:         a.BuilderChosen,
+		ChosenRelay:           a.ChosenRelay,
+		Reason:                a.Reason,
+		BlindedBlockSubmitted: a.BlindedBlockSubmitted,
+		SubmitError:           a.SubmitError,
+	}
+}
+
+func BuilderBidFromConsensus(slot primitives.Slot, b *eth.BuilderBidRecord) *BuilderBid {
+	return &BuilderBid{
+		Slot:          fmt.Sprintf("%d", slot),
+		Relay:         b.Relay,
+		Value:         bytesutil.LittleEndianBytesToBigInt(b.Value).String(),
+		BlockHash:     hexutil.Encode(b.BlockHash),
+		GasLimit:      fmt.Sprintf("%d", b.GasLimit),
+		FeeRecipient:  hexutil.Encode(b.FeeRecipient),
+		BuilderPubkey: hexutil.Encode(b.BuilderPubkey),
+		LatencyMs:     fmt.Sprintf("%d", b.LatencyMs),
+		ReceivedAtMs:  fmt.Sprintf("%d", b.ReceivedAtMs),
+		Error:         b.Error,
+	}
+}
@@ -12,3 +12,59 @@ type ExpectedWithdrawal struct {
 	Index          string `json:"index"`
 	ValidatorIndex string `json:"validator_index"`
 }
+
+type GetBuilderBidsResponse struct {
+	Data *BuilderBidAudit `json:"data"`
+}
+
+type BuilderBidAudit struct {
+	Slot                  string        `json:"slot"`
+	ProposerIndex         string        `json:"proposer_index"`
+	ParentHash            string        `json:"parent_hash"`
+	Bids                  []*BuilderBid `json:"bids"`
+	LocalValue            string        `json:"local_value"`
+	BuilderChosen         bool          `json:"builder_chosen"`
+	ChosenRelay           string        `json:"chosen_relay"`
+	Reason                string        `json:"reason"`
+	BlindedBlockSubmitted bool          `json:"blinded_block_submitted"`
+	SubmitError           string        `json:"submit_error,omitempty"`
+}
+
+type BuilderBid struct {
+	Slot          string `json:"slot"`
+	Relay         string `json:"relay"`
+	Value         string `json:"value"`
+	BlockHash     string `json:"block_hash"`
+	GasLimit      string `json:"gas_limit"`
+	FeeRecipient  string `json:"fee_recipient"`
+	BuilderPubkey string `json:"builder_pubkey"`
+	LatencyMs     string `json:"latency_ms"`
+	ReceivedAtMs  string `json:"received_at_ms"`
+	Error         string `json:"error,omitempty"`
+}
+
+type GetBuilderRelaysResponse struct {
+	Data []*BuilderRelay `json:"data"`
+}
+
+type BuilderRelay struct {
+	Url                string                  `json:"url"`
+	Healthy            bool                    `json:"healthy"`
+	Quarantined        bool                    `json:"quarantined"`
+	QuarantinedUntilMs string                  `json:"quarantined_until_ms"`
+	Incidents          []*BuilderRelayIncident `json:"incidents"`
+}
+
+type ValidatorBuilderRelays struct {
+	ValidatorIndex string   `json:"validator_index"`
+	Relays         []string `json:"relays"`
+}
+
+type BuilderRelayIncident struct {
+	Kind      string `json:"kind"`
+	Slot      string `json:"slot"`
+	BlockRoot string `json:"block_root"`
+	BlockHash string `json:"block_hash"`
+	TimeMs    string `json:"time_ms"`
+	Error     string `json:"error,omitempty"`
+}
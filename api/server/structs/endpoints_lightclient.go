@@ -29,3 +29,12 @@ type LightClientUpdateWithVersion struct {
 type LightClientUpdatesByRangeResponse struct {
 	Updates []*LightClientUpdateWithVersion `json:"updates"`
 }
+
+type LightClientVerifiedHeaderResponse struct {
+	Data *LightClientVerifiedHeader `json:"data"`
+}
+
+type LightClientVerifiedHeader struct {
+	Root   string             `json:"root"`
+	Header *BeaconBlockHeader `json:"header"`
+}
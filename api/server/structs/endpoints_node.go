@@ -71,3 +71,68 @@ type AddrRequest struct {
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type GetPeerScoreResponse struct {
+	Data *PeerScore `json:"data"`
+}
+
+type PeerScore struct {
+	PeerId           string                 `json:"peer_id"`
+	Score            string                 `json:"score"`
+	Scorers          []*ScorerScore         `json:"scorers"`
+	BadResponses     string                 `json:"bad_responses"`
+	ProcessedBlocks  string                 `json:"processed_blocks"`
+	BehaviourPenalty string                 `json:"behaviour_penalty"`
+	TopicScores      map[string]*TopicScore `json:"topic_scores"`
+	ValidationError  string                 `json:"validation_error"`
+	IsBad            bool                   `json:"is_bad"`
+	BadPeerReasons   []string               `json:"bad_peer_reasons"`
+}
+
+type ScorerScore struct {
+	Name         string `json:"name"`
+	Score        string `json:"score"`
+	Weight       string `json:"weight"`
+	Contribution string `json:"contribution"`
+}
+
+type TopicScore struct {
+	TimeInMeshMs             string `json:"time_in_mesh_ms"`
+	FirstMessageDeliveries   string `json:"first_message_deliveries"`
+	MeshMessageDeliveries    string `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries string `json:"invalid_message_deliveries"`
+}
+
+type GetPeerScoringResponse struct {
+	Data *PeerScoring `json:"data"`
+}
+
+type PeerScoring struct {
+	Weights    *PeerScorerWeights    `json:"weights"`
+	Thresholds *PeerScorerThresholds `json:"thresholds"`
+}
+
+type PeerScorerWeights struct {
+	BadResponses  string `json:"bad_responses"`
+	BlockProvider string `json:"block_provider"`
+	PeerStatus    string `json:"peer_status"`
+	Gossip        string `json:"gossip"`
+}
+
+type PeerScorerThresholds struct {
+	BadResponses string `json:"bad_responses"`
+	Gossip       string `json:"gossip"`
+}
+
+type GetExecutionEnginesResponse struct {
+	Data []*ExecutionEngine `json:"data"`
+}
+
+type ExecutionEngine struct {
+	Endpoint    string `json:"endpoint"`
+	Primary     bool   `json:"primary"`
+	Status      string `json:"status"`
+	LatencyMs   string `json:"latency_ms"`
+	LastUpdated string `json:"last_updated"`
+	LastError   string `json:"last_error"`
+}
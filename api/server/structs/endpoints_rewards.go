@@ -52,3 +52,19 @@ type SyncCommitteeReward struct {
 	ValidatorIndex string `json:"validator_index"`
 	Reward         string `json:"reward"`
 }
+
+type ValidatorRewardsHistoryResponse struct {
+	Data []*ValidatorEpochRewards `json:"data"`
+}
+
+type ValidatorEpochRewards struct {
+	ValidatorIndex string `json:"validator_index"`
+	Epoch          string `json:"epoch"`
+	Head           string `json:"head"`
+	Source         string `json:"source"`
+	Target         string `json:"target"`
+	Inactivity     string `json:"inactivity"`
+	Proposer       string `json:"proposer"`
+	SyncCommittee  string `json:"sync_committee"`
+	Total          string `json:"total"`
+}
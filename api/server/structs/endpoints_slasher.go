@@ -0,0 +1,27 @@
+package structs
+
+type GetSlasherAttesterSlashingsResponse struct {
+	Data []*AttesterSlashing `json:"data"`
+}
+
+type GetSlasherProposerSlashingsResponse struct {
+	Data []*ProposerSlashing `json:"data"`
+}
+
+type IsSlashableAttestationResponse struct {
+	Data *SlashableAttestation `json:"data"`
+}
+
+type SlashableAttestation struct {
+	Slashable         bool                `json:"slashable"`
+	AttesterSlashings []*AttesterSlashing `json:"attester_slashings"`
+}
+
+type IsSlashableBlockResponse struct {
+	Data *SlashableBlock `json:"data"`
+}
+
+type SlashableBlock struct {
+	Slashable        bool              `json:"slashable"`
+	ProposerSlashing *ProposerSlashing `json:"proposer_slashing,omitempty"`
+}
@@ -118,3 +118,36 @@ type GetValidatorPerformanceResponse struct {
 	MissingValidators             [][]byte `json:"missing_validators,omitempty"`
 	InactivityScores              []uint64 `json:"inactivity_scores,omitempty"`
 }
+
+type GetValidatorMonitorPerformanceResponse struct {
+	Data []*ValidatorEpochPerformance `json:"data"`
+}
+
+type ValidatorEpochPerformance struct {
+	ValidatorIndex             string `json:"validator_index"`
+	Epoch                      string `json:"epoch"`
+	AttestationIncluded        bool   `json:"attestation_included"`
+	InclusionDistance          string `json:"inclusion_distance"`
+	MissedSource               bool   `json:"missed_source"`
+	MissedTarget               bool   `json:"missed_target"`
+	MissedHead                 bool   `json:"missed_head"`
+	ProposerDuties             string `json:"proposer_duties"`
+	MissedProposals            string `json:"missed_proposals"`
+	SyncCommitteeDuties        string `json:"sync_committee_duties"`
+	SyncCommitteeContributions string `json:"sync_committee_contributions"`
+	StartBalance               string `json:"start_balance"`
+	EndBalance                 string `json:"end_balance"`
+	BalanceChange              string `json:"balance_change"`
+}
+
+type ValidatorMonitorIndicesRequest struct {
+	Indices []string `json:"indices"`
+}
+
+type ValidatorMonitorIndicesResponse struct {
+	Data []string `json:"data"`
+}
+
+type ValidatorMonitorPubkeysRequest struct {
+	Pubkeys []string `json:"pubkeys"`
+}
@@ -3,8 +3,11 @@ package blockchain
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -12,8 +15,10 @@ import (
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 type testlc struct {
@@ -153,8 +158,191 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 	require.DeepSSZEqual(t, zeroHash, update.FinalizedHeader.ParentRoot, "Finalized header parent root is not zero")
 	require.DeepSSZEqual(t, zeroHash, update.FinalizedHeader.StateRoot, "Finalized header state root is not zero")
 	require.DeepSSZEqual(t, zeroHash, update.FinalizedHeader.BodyRoot, "Finalized header body root is not zero")
-	require.Equal(t, finalityBranchNumOfLeaves, len(update.FinalityBranch), "Invalid finality branch leaves")
+	require.Equal(t, fieldparams.FinalityBranchDepth, len(update.FinalityBranch), "Invalid finality branch leaves")
 	for _, leaf := range update.FinalityBranch {
 		require.DeepSSZEqual(t, zeroHash, leaf, "Leaf is not zero")
 	}
 }
+
+func TestLightClient_NewLightClientUpdateFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	update, err := NewLightClientUpdateFromBeaconState(l.ctx, l.state, l.block, l.attestedState, nil)
+	require.NoError(t, err)
+	require.NotNil(t, update, "update is nil")
+
+	l.checkSyncAggregate(update)
+	l.checkAttestedHeader(update)
+
+	nextSyncCommittee, err := l.attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommittee.Pubkeys, update.NextSyncCommittee.Pubkeys, "Next sync committee is not equal")
+	require.Equal(t, true, update.IsSyncCommiteeUpdate(), "Update does not carry the next sync committee")
+	require.Equal(t, false, update.IsFinalityUpdate(), "Update without finalized block must not be a finality update")
+}
+
+func TestLightClient_NewLightClientBootstrapFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	bootstrap, err := NewLightClientBootstrapFromBeaconState(l.ctx, l.attestedState)
+	require.NoError(t, err)
+
+	attestedStateRoot, err := l.attestedState.HashTreeRoot(l.ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, attestedStateRoot[:], bootstrap.Header.StateRoot, "Header state root is not equal")
+	require.DeepSSZEqual(t, l.attestedHeader.BodyRoot, bootstrap.Header.BodyRoot, "Header body root is not equal")
+	currentSyncCommittee, err := l.attestedState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, currentSyncCommittee.AggregatePubkey, bootstrap.CurrentSyncCommittee.AggregatePubkey, "Current sync committee is not equal")
+}
+
+func TestLightClient_IsBetterUpdate(t *testing.T) {
+	cfg := params.BeaconConfig()
+	slotsPerPeriod := primitives.Slot(cfg.EpochsPerSyncCommitteePeriod) * cfg.SlotsPerEpoch
+	zeroBranch := func(n int) [][]byte {
+		branch := make([][]byte, n)
+		for i := range branch {
+			branch[i] = make([]byte, 32)
+		}
+		return branch
+	}
+	nonZeroBranch := func(n int) [][]byte {
+		branch := zeroBranch(n)
+		branch[0][0] = 1
+		return branch
+	}
+	newUpdate := func(participants uint64, attestedSlot, signatureSlot primitives.Slot, syncCommittee, finality bool) *ethpbv2.LightClientUpdate {
+		bits := make([]byte, cfg.SyncCommitteeSize/8)
+		update := &ethpbv2.LightClientUpdate{
+			AttestedHeader:          &v1.BeaconBlockHeader{Slot: attestedSlot},
+			FinalizedHeader:         &v1.BeaconBlockHeader{Slot: attestedSlot},
+			NextSyncCommitteeBranch: zeroBranch(5),
+			FinalityBranch:          zeroBranch(6),
+			SyncAggregate:           &v1.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:           signatureSlot,
+		}
+		for i := uint64(0); i < participants; i++ {
+			update.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+		}
+		if syncCommittee {
+			update.NextSyncCommitteeBranch = nonZeroBranch(5)
+		}
+		if finality {
+			update.FinalityBranch = nonZeroBranch(6)
+		}
+		return update
+	}
+	supermajority := cfg.SyncCommitteeSize*2/3 + 1
+
+	tests := []struct {
+		name      string
+		newUpdate *ethpbv2.LightClientUpdate
+		oldUpdate *ethpbv2.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority wins",
+			newUpdate: newUpdate(supermajority, 10, 11, false, false),
+			oldUpdate: newUpdate(supermajority-1, 10, 11, true, true),
+			want:      true,
+		},
+		{
+			name:      "more participants without supermajority wins",
+			newUpdate: newUpdate(10, 10, 11, false, false),
+			oldUpdate: newUpdate(9, 10, 11, true, true),
+			want:      true,
+		},
+		{
+			name:      "relevant sync committee wins",
+			newUpdate: newUpdate(supermajority, 10, 11, true, false),
+			oldUpdate: newUpdate(supermajority, 10, 11, false, true),
+			want:      true,
+		},
+		{
+			name:      "sync committee signed in another period is not relevant",
+			newUpdate: newUpdate(supermajority, slotsPerPeriod-1, slotsPerPeriod, true, false),
+			oldUpdate: newUpdate(supermajority, 10, 11, true, false),
+			want:      false,
+		},
+		{
+			name:      "finality wins",
+			newUpdate: newUpdate(supermajority, 10, 11, true, true),
+			oldUpdate: newUpdate(supermajority+1, 10, 11, true, false),
+			want:      true,
+		},
+		{
+			name:      "more participants beyond supermajority wins",
+			newUpdate: newUpdate(supermajority+1, 12, 13, true, true),
+			oldUpdate: newUpdate(supermajority, 10, 11, true, true),
+			want:      true,
+		},
+		{
+			name:      "older attested header wins",
+			newUpdate: newUpdate(supermajority, 10, 13, true, true),
+			oldUpdate: newUpdate(supermajority, 11, 12, true, true),
+			want:      true,
+		},
+		{
+			name:      "older signature slot wins",
+			newUpdate: newUpdate(supermajority, 10, 12, true, true),
+			oldUpdate: newUpdate(supermajority, 10, 11, true, true),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
+
+func TestService_SaveLightClientUpdate(t *testing.T) {
+	s, tr := minimalTestService(t, WithLightClientRetentionPeriods(1))
+	l := newTestLc(t).setupTest()
+
+	parentRoot := l.block.Block().ParentRoot()
+	require.NoError(t, tr.db.SaveState(l.ctx, l.attestedState, parentRoot))
+
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(l.attestedHeader.Slot))
+	stale := &ethpbv2.LightClientUpdate{AttestedHeader: &v1.BeaconBlockHeader{Slot: 1}}
+	require.NoError(t, tr.db.SaveLightClientUpdate(l.ctx, period-1, version.Altair, stale))
+
+	require.NoError(t, s.saveLightClientUpdate(l.ctx, l.block, l.state))
+	update, v, err := tr.db.LightClientUpdate(l.ctx, period)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	require.Equal(t, version.Capella, v)
+	require.Equal(t, l.block.Block().Slot(), update.SignatureSlot)
+
+	// The update of the previous period falls outside of the retention window.
+	update, _, err = tr.db.LightClientUpdate(l.ctx, period-1)
+	require.NoError(t, err)
+	require.Equal(t, (*ethpbv2.LightClientUpdate)(nil), update)
+}
+
+func TestService_SendLightClientFeeds_OneUpdateAtATime(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableLightClient: true})
+	defer resetCfg()
+	s, tr := minimalTestService(t)
+	l := newTestLc(t).setupTest()
+	require.NoError(t, tr.db.SaveState(l.ctx, l.attestedState, l.block.Block().ParentRoot()))
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(l.attestedHeader.Slot))
+	cfg := &postBlockProcessConfig{ctx: l.ctx, signed: l.block, postState: l.state}
+
+	// The update of a block imported while another update is computed is skipped.
+	s.lightClientUpdateInProgress.Store(true)
+	s.sendLightClientFeeds(cfg)
+	update, _, err := tr.db.LightClientUpdate(l.ctx, period)
+	require.NoError(t, err)
+	require.Equal(t, (*ethpbv2.LightClientUpdate)(nil), update)
+
+	s.lightClientUpdateInProgress.Store(false)
+	s.sendLightClientFeeds(cfg)
+	for i := 0; i < 100 && s.lightClientUpdateInProgress.Load(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, false, s.lightClientUpdateInProgress.Load())
+	update, _, err = tr.db.LightClientUpdate(l.ctx, period)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+}
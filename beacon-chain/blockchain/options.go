@@ -29,6 +29,15 @@ func WithMaxGoroutines(x int) Option {
 	}
 }
 
+// WithLightClientRetentionPeriods sets the number of sync committee periods for which
+// light client data is kept in the database.
+func WithLightClientRetentionPeriods(periods uint64) Option {
+	return func(s *Service) error {
+		s.cfg.LightClientRetention = periods
+		return nil
+	}
+}
+
 // WithWeakSubjectivityCheckpoint for checkpoint sync.
 func WithWeakSubjectivityCheckpoint(c *ethpb.Checkpoint) Option {
 	return func(s *Service) error {
@@ -124,7 +124,115 @@ func (s *Service) sendLightClientFeeds(cfg *postBlockProcessConfig) {
 
 		// LightClientFinalityUpdate needs super majority
 		s.tryPublishLightClientFinalityUpdate(cfg.ctx, cfg.signed, finalized, cfg.postState)
+
+		// Computing the update regenerates the attested state, which is kept off the block import path. A single
+		// update is computed at a time, blocks imported meanwhile being skipped, for updates not to pile up when
+		// blocks are imported faster than updates are computed.
+		if !s.lightClientUpdateInProgress.CompareAndSwap(false, true) {
+			log.WithField("slot", cfg.signed.Block().Slot()).Debug("Skipping light client update, another one is in progress")
+			return
+		}
+		postState := cfg.postState.Copy()
+		go func() {
+			defer s.lightClientUpdateInProgress.Store(false)
+			if err := s.saveLightClientUpdate(s.ctx, cfg.signed, postState); err != nil {
+				log.WithError(err).Debug("Could not save light client update")
+			}
+		}()
+	}
+}
+
+// saveLightClientUpdate computes the light client update attested by the given block and saves it to
+// the DB if it is better than the update currently stored for its sync committee period. It must not
+// run concurrently with itself.
+func (s *Service) saveLightClientUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, postState state.BeaconState) error {
+	if slots.ToEpoch(signed.Block().Slot()) < params.BeaconConfig().AltairForkEpoch {
+		return nil
+	}
+	syncAggregate, err := signed.Block().Body().SyncAggregate()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil
+	}
+
+	attestedRoot := signed.Block().ParentRoot()
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	finalizedCheckPoint := attestedState.FinalizedCheckpoint()
+	if finalizedCheckPoint != nil {
+		finalizedRoot := bytesutil.ToBytes32(finalizedCheckPoint.Root)
+		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, finalizedRoot)
+		if err != nil {
+			finalizedBlock = nil
+		}
+	}
+
+	update, err := NewLightClientUpdateFromBeaconState(ctx, postState, signed, attestedState, finalizedBlock)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	period := syncCommitteePeriodAtSlot(update.AttestedHeader.Slot)
+	oldUpdate, _, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrap(err, "could not get current light client update")
+	}
+	if oldUpdate != nil && !IsBetterUpdate(update, oldUpdate) {
+		return nil
+	}
+	if err := s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, attestedState.Version(), update); err != nil {
+		return errors.Wrap(err, "could not save light client update")
+	}
+	// The first update of a period is a good time to drop data that fell out of the retention window.
+	if oldUpdate == nil {
+		return s.pruneLightClientData(ctx, period)
+	}
+	return nil
+}
+
+// saveLightClientBootstrap saves the light client bootstrap of a finalized checkpoint block,
+// so that light clients can bootstrap from it without the node regenerating its state.
+func (s *Service) saveLightClientBootstrap(ctx context.Context, root [32]byte) error {
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	if slots.ToEpoch(st.Slot()) < params.BeaconConfig().AltairForkEpoch {
+		return nil
+	}
+	bootstrap, err := NewLightClientBootstrapFromBeaconState(ctx, st)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client bootstrap")
+	}
+	return s.cfg.BeaconDB.SaveLightClientBootstrap(ctx, root, st.Version(), bootstrap)
+}
+
+// pruneLightClientData removes light client updates and bootstraps that fall outside of the configured
+// retention window, which spans the given sync committee period and the ones right before it.
+func (s *Service) pruneLightClientData(ctx context.Context, period uint64) error {
+	retention := s.cfg.LightClientRetention
+	if retention == 0 || period+1 < retention {
+		return nil
 	}
+	cutoff := period + 1 - retention
+	if err := s.cfg.BeaconDB.DeleteLightClientUpdatesBefore(ctx, cutoff); err != nil {
+		return errors.Wrap(err, "could not prune light client updates")
+	}
+	cutoffEpoch := primitives.Epoch(cutoff).Mul(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+	cutoffSlot, err := slots.EpochStart(cutoffEpoch)
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.BeaconDB.DeleteLightClientBootstrapsBefore(ctx, cutoffSlot); err != nil {
+		return errors.Wrap(err, "could not prune light client bootstraps")
+	}
+	return nil
 }
 
 func (s *Service) tryPublishLightClientFinalityUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, finalized *forkchoicetypes.Checkpoint, postState state.BeaconState) {
@@ -397,6 +505,11 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 		if err := s.cfg.StateGen.MigrateToCold(s.ctx, fRoot); err != nil {
 			log.WithError(err).Error("could not migrate to cold")
 		}
+		if features.Get().EnableLightClient {
+			if err := s.saveLightClientBootstrap(s.ctx, fRoot); err != nil {
+				log.WithError(err).Debug("Could not save light client bootstrap")
+			}
+		}
 	}()
 	return nil
 }
@@ -0,0 +1,202 @@
+package builder
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// bidAuditsRetention is the number of slots for which the bid audit of a proposal is kept in memory, so that it can
+// be completed with the choice of the proposer and the outcome of the blinded block submission.
+const bidAuditsRetention = primitives.Slot(64)
+
+// ErrNoBidAudit is returned when no builder bid was recorded for a slot.
+var ErrNoBidAudit = errors.Wrap(db.ErrNotFound, "no builder bid audit")
+
+// PayloadChoice is the choice the proposer of a slot made between the payload of the local execution client and
+// the payload of the builder.
+type PayloadChoice struct {
+	Slot          primitives.Slot
+	ProposerIndex primitives.ValidatorIndex
+	// LocalValue is the value of the local payload in wei, nil when it is not known.
+	LocalValue    *big.Int
+	BuilderChosen bool
+	Reason        string
+}
+
+// bidAudits keeps the bid audits of the recent proposal slots.
+type bidAudits struct {
+	sync.Mutex
+	audits map[primitives.Slot]*bidAudit
+}
+
+type bidAudit struct {
+	audit *ethpb.BuilderBidAudit
+	// bestRelay is the relay of the highest valid bid, it becomes the chosen relay if the proposer uses the bid.
+	bestRelay string
+}
+
+func newBidAudits() *bidAudits {
+	return &bidAudits{audits: make(map[primitives.Slot]*bidAudit)}
+}
+
+// update applies f to the bid audit of the slot, creating it if needed, and returns a copy of the result.
+func (b *bidAudits) update(slot primitives.Slot, f func(a *bidAudit)) *ethpb.BuilderBidAudit {
+	b.Lock()
+	defer b.Unlock()
+	a, ok := b.audits[slot]
+	if !ok {
+		for s := range b.audits {
+			if s+bidAuditsRetention < slot {
+				delete(b.audits, s)
+			}
+		}
+		a = &bidAudit{audit: &ethpb.BuilderBidAudit{Slot: uint64(slot)}}
+		b.audits[slot] = a
+	}
+	f(a)
+	return proto.Clone(a.audit).(*ethpb.BuilderBidAudit)
+}
+
+func (b *bidAudits) get(slot primitives.Slot) (*ethpb.BuilderBidAudit, bool) {
+	b.Lock()
+	defer b.Unlock()
+	a, ok := b.audits[slot]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(a.audit).(*ethpb.BuilderBidAudit), true
+}
+
+// auditBids records the answers of the relays to the GetHeader requests of the slot, and sends a builder bid event
+// for each of them.
+func (s *Service) auditBids(ctx context.Context, slot primitives.Slot, parentHash [32]byte, results []relayBid, best relayBid) {
+	records := make([]*ethpb.BuilderBidRecord, len(results))
+	for i, res := range results {
+		records[i] = bidRecord(res)
+	}
+	audit := s.audits.update(slot, func(a *bidAudit) {
+		a.audit.ParentHash = bytesutil.SafeCopyBytes(parentHash[:])
+		a.audit.Bids = records
+		a.bestRelay = ""
+		if best.relay != nil {
+			a.bestRelay = best.relay.NodeURL()
+		}
+	})
+	s.saveBidAudit(ctx, audit)
+	if s.cfg.stateNotifier == nil {
+		return
+	}
+	for _, r := range records {
+		s.cfg.stateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BuilderBidReceived,
+			Data: &statefeed.BuilderBidReceivedData{Slot: slot, Bid: r},
+		})
+	}
+}
+
+// RecordPayloadChoice records the choice of the proposer in the bid audit of the slot.
+func (s *Service) RecordPayloadChoice(ctx context.Context, choice *PayloadChoice) {
+	audit := s.audits.update(choice.Slot, func(a *bidAudit) {
+		a.audit.ProposerIndex = uint64(choice.ProposerIndex)
+		a.audit.LocalValue = nil
+		if choice.LocalValue != nil {
+			a.audit.LocalValue = bytesutil.PadTo(bytesutil.ReverseByteOrder(choice.LocalValue.Bytes()), 32)
+		}
+		a.audit.BuilderChosen = choice.BuilderChosen
+		a.audit.ChosenRelay = ""
+		if choice.BuilderChosen {
+			a.audit.ChosenRelay = a.bestRelay
+		}
+		a.audit.Reason = choice.Reason
+	})
+	s.saveBidAudit(ctx, audit)
+}
+
+// auditSubmission records the outcome of the submission of the blinded block of the slot.
+func (s *Service) auditSubmission(ctx context.Context, slot primitives.Slot, err error) {
+	audit := s.audits.update(slot, func(a *bidAudit) {
+		a.audit.BlindedBlockSubmitted = err == nil
+		a.audit.SubmitError = ""
+		if err != nil {
+			a.audit.SubmitError = err.Error()
+		}
+	})
+	s.saveBidAudit(ctx, audit)
+}
+
+func (s *Service) saveBidAudit(ctx context.Context, audit *ethpb.BuilderBidAudit) {
+	if s.cfg.beaconDB == nil {
+		return
+	}
+	if err := s.cfg.beaconDB.SaveBuilderBidAudit(ctx, audit); err != nil {
+		log.WithError(err).WithField("slot", audit.Slot).Error("Could not save builder bid audit")
+	}
+}
+
+// BidAudit returns the builder bids received for the proposal of the slot, the choice of the proposer and the
+// outcome of the blinded block submission.
+func (s *Service) BidAudit(ctx context.Context, slot primitives.Slot) (*ethpb.BuilderBidAudit, error) {
+	if audit, ok := s.audits.get(slot); ok {
+		return audit, nil
+	}
+	if s.cfg.beaconDB == nil {
+		return nil, errors.Wrapf(ErrNoBidAudit, "slot %d", slot)
+	}
+	audit, err := s.cfg.beaconDB.BuilderBidAudit(ctx, slot)
+	if db.IsNotFound(err) {
+		return nil, errors.Wrapf(ErrNoBidAudit, "slot %d", slot)
+	}
+	return audit, err
+}
+
+// bidRecord converts the answer of a relay to a bid record of the audit log.
+func bidRecord(res relayBid) *ethpb.BuilderBidRecord {
+	r := &ethpb.BuilderBidRecord{
+		Relay:     res.relay.NodeURL(),
+		LatencyMs: uint64(res.latency.Milliseconds()),
+	}
+	if !res.receivedAt.IsZero() {
+		r.ReceivedAtMs = uint64(res.receivedAt.UnixMilli())
+	}
+	if res.err != nil {
+		r.Error = res.err.Error()
+	}
+	if res.bid == nil {
+		return r
+	}
+	bid, err := res.bid.Message()
+	if err != nil || bid == nil || bid.IsNil() {
+		return r
+	}
+	r.Value = bytesutil.SafeCopyBytes(bid.Value())
+	r.BuilderPubkey = bytesutil.SafeCopyBytes(bid.Pubkey())
+	header, err := bid.Header()
+	if err != nil || header == nil || header.IsNil() {
+		return r
+	}
+	r.BlockHash = bytesutil.SafeCopyBytes(header.BlockHash())
+	r.GasLimit = header.GasLimit()
+	r.FeeRecipient = bytesutil.SafeCopyBytes(header.FeeRecipient())
+	return r
+}
+
+// missedBid is the answer recorded for a relay that did not answer before the get header timeout.
+func missedBid(r *relay, timeout time.Duration) relayBid {
+	return relayBid{
+		relay:   r,
+		err:     errors.Errorf("relay %s: no answer before the get header timeout", r.NodeURL()),
+		latency: timeout,
+	}
+}
@@ -28,3 +28,49 @@ var (
 		},
 	)
 )
+
+var (
+	relayRequestsCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_requests_total",
+			Help: "Number of requests sent to each builder relay, by method and result",
+		},
+		[]string{"relay", "method", "result"},
+	)
+	relayLatencyHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_latency_milliseconds",
+			Help:    "Captures RPC latency of each builder relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay", "method"},
+	)
+	relayHealthyGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "builder_relay_healthy",
+			Help: "1 if the last status check of the builder relay succeeded, 0 otherwise",
+		},
+		[]string{"relay"},
+	)
+	relayBidsWonCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_bids_won_total",
+			Help: "Number of times the bid of each builder relay was the highest one",
+		},
+		[]string{"relay"},
+	)
+	relayIncidentsCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_incidents_total",
+			Help: "Number of proposals lost or at risk because of each builder relay, by kind of incident",
+		},
+		[]string{"relay", "kind"},
+	)
+	relayQuarantinedGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "builder_relay_quarantined",
+			Help: "1 if the builder relay is quarantined after an incident, 0 otherwise",
+		},
+		[]string{"relay"},
+	)
+)
@@ -1,9 +1,14 @@
 package builder
 
 import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/urfave/cli/v2"
@@ -13,25 +18,54 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	for _, endpoint := range strings.Split(c.String(flags.MevRelayEndpoint.Name), ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, WithBuilderClient(client))
 	}
-	opts := []Option{
-		WithBuilderClient(client),
+	if c.IsSet(flags.MevRelayTimeout.Name) {
+		opts = append(opts, WithGetHeaderTimeout(c.Duration(flags.MevRelayTimeout.Name)))
+	}
+	if c.IsSet(flags.MevRelayQuarantine.Name) {
+		opts = append(opts, WithRelayQuarantine(c.Duration(flags.MevRelayQuarantine.Name)))
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a builder relay client to the beacon chain builder service.
+// It can be given several times to use several relays.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithGetHeaderTimeout sets the time the relays have to return a bid.
+func WithGetHeaderTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		if timeout <= 0 {
+			return errors.New("get header timeout must be positive")
+		}
+		s.cfg.getHeaderTimeout = timeout
+		return nil
+	}
+}
+
+// WithRelayQuarantine sets the time a relay is not used after an incident. Zero only records the incidents.
+func WithRelayQuarantine(period time.Duration) Option {
+	return func(s *Service) error {
+		if period < 0 {
+			return errors.New("relay quarantine period must not be negative")
+		}
+		s.cfg.relayQuarantine = period
 		return nil
 	}
 }
@@ -44,6 +78,22 @@ func WithHeadFetcher(svc blockchain.HeadFetcher) Option {
 	}
 }
 
+// WithCanonicalFetcher checks that the blocks built on relay payloads became canonical.
+func WithCanonicalFetcher(svc blockchain.CanonicalFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.canonicalFetcher = svc
+		return nil
+	}
+}
+
+// WithStateNotifier sets the notifier of the builder bid events.
+func WithStateNotifier(n statefeed.Notifier) Option {
+	return func(s *Service) error {
+		s.cfg.stateNotifier = n
+		return nil
+	}
+}
+
 // WithDatabase for head access.
 func WithDatabase(beaconDB db.HeadAccessDatabase) Option {
 	return func(s *Service) error {
@@ -0,0 +1,66 @@
+package builder
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFlagOptions_ConfigFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		relays string
+		want   []string
+	}{
+		{
+			name:   "single relay",
+			relays: "http://127.0.0.1:18550",
+			want:   []string{"http://127.0.0.1:18550"},
+		},
+		{
+			name:   "comma-separated relays",
+			relays: "http://127.0.0.1:18550, http://127.0.0.1:18551",
+			want:   []string{"http://127.0.0.1:18550", "http://127.0.0.1:18551"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(configFile, []byte(fmt.Sprintf("%s: %q\n", flags.MevRelayEndpoint.Name, tt.relays)), 0600))
+
+			app := cli.App{}
+			set := flag.NewFlagSet("test", 0)
+			require.NoError(t, set.Parse([]string{"test-command", "--" + cmd.ConfigFileFlag.Name, configFile}))
+			cliCtx := cli.NewContext(&app, set, nil)
+			wrapped := cmd.WrapFlags([]cli.Flag{cmd.ConfigFileFlag, flags.MevRelayEndpoint})
+			command := &cli.Command{
+				Name:  "test-command",
+				Flags: wrapped,
+				Before: func(cliCtx *cli.Context) error {
+					return cmd.LoadFlagsFromConfig(cliCtx, wrapped)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					opts, err := FlagOptions(cliCtx)
+					require.NoError(t, err)
+					s := &Service{cfg: &config{}}
+					for _, opt := range opts {
+						require.NoError(t, opt(s))
+					}
+					require.Equal(t, len(tt.want), len(s.cfg.builderClients))
+					for i, c := range s.cfg.builderClients {
+						require.Equal(t, tt.want[i], c.NodeURL())
+					}
+					return nil
+				},
+			}
+			require.NoError(t, command.Run(cliCtx, cliCtx.Args().Slice()...))
+		})
+	}
+}
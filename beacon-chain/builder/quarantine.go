@@ -0,0 +1,148 @@
+package builder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// defaultRelayQuarantine is the time a relay is not requested for bids after an incident.
+const defaultRelayQuarantine = time.Hour
+
+// maxRelayIncidents is the number of incidents kept in memory for each relay.
+const maxRelayIncidents = 16
+
+// Kinds of relay incidents.
+const (
+	// IncidentPayloadWithheld is a blinded block the proposer signed for which the relay did not reveal the payload.
+	IncidentPayloadWithheld = "payload_withheld"
+	// IncidentNotCanonical is a block built on a payload revealed by the relay which did not become canonical.
+	IncidentNotCanonical = "not_canonical"
+)
+
+// ErrRelaysQuarantined is returned when all the relays a bid could be requested from are quarantined.
+var ErrRelaysQuarantined = errors.New("all the builder relays are quarantined")
+
+// RelayIncident is a proposal lost, or at risk, because of a relay.
+type RelayIncident struct {
+	Kind      string
+	Slot      primitives.Slot
+	BlockRoot [32]byte
+	BlockHash [32]byte
+	Time      time.Time
+	// Err is the error of the relay, if any.
+	Err string
+}
+
+// RelayState is the health and the quarantine status of a relay.
+type RelayState struct {
+	URL     string
+	Healthy bool
+	// QuarantinedUntil is the end of the last quarantine of the relay, zero if it was never quarantined.
+	QuarantinedUntil time.Time
+	// Incidents are the most recent incidents of the relay, oldest first.
+	Incidents []RelayIncident
+}
+
+// Quarantined returns true if the relay is quarantined at the given time.
+func (s *RelayState) Quarantined(now time.Time) bool {
+	return now.Before(s.QuarantinedUntil)
+}
+
+// recordIncident records an incident of the relay and quarantines it for the given period, counted from the time of
+// the incident. A zero period records the incident without quarantining the relay.
+func (r *relay) recordIncident(incident RelayIncident, period time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.incidents = append(r.incidents, incident)
+	if len(r.incidents) > maxRelayIncidents {
+		r.incidents = r.incidents[len(r.incidents)-maxRelayIncidents:]
+	}
+	relayIncidentsCount.WithLabelValues(r.NodeURL(), incident.Kind).Inc()
+	if period <= 0 {
+		return
+	}
+	if until := incident.Time.Add(period); until.After(r.quarantinedUntil) {
+		r.quarantinedUntil = until
+	}
+	relayQuarantinedGauge.WithLabelValues(r.NodeURL()).Set(1)
+}
+
+func (r *relay) isQuarantined(now time.Time) bool {
+	r.RLock()
+	defer r.RUnlock()
+	return now.Before(r.quarantinedUntil)
+}
+
+// updateQuarantineGauge sets the quarantine metric of the relay, so that it is cleared once the quarantine is over.
+func (r *relay) updateQuarantineGauge(now time.Time) {
+	v := 0.0
+	if r.isQuarantined(now) {
+		v = 1
+	}
+	relayQuarantinedGauge.WithLabelValues(r.NodeURL()).Set(v)
+}
+
+func (r *relay) state() *RelayState {
+	r.RLock()
+	defer r.RUnlock()
+	incidents := make([]RelayIncident, len(r.incidents))
+	copy(incidents, r.incidents)
+	return &RelayState{
+		URL:              r.NodeURL(),
+		Healthy:          r.healthy,
+		QuarantinedUntil: r.quarantinedUntil,
+		Incidents:        incidents,
+	}
+}
+
+// unquarantinedRelays returns the relays which are not quarantined at the given time.
+func unquarantinedRelays(relays []*relay, now time.Time) []*relay {
+	available := make([]*relay, 0, len(relays))
+	for _, r := range relays {
+		if !r.isQuarantined(now) {
+			available = append(available, r)
+		}
+	}
+	return available
+}
+
+// relayProposals are the blocks built on payloads revealed by relays whose canonicality is not checked yet.
+type relayProposals struct {
+	sync.Mutex
+	proposals map[[32]byte]relayProposal
+}
+
+type relayProposal struct {
+	slot      primitives.Slot
+	blockHash [32]byte
+	relay     *relay
+}
+
+func newRelayProposals() *relayProposals {
+	return &relayProposals{proposals: make(map[[32]byte]relayProposal)}
+}
+
+func (p *relayProposals) add(blockRoot [32]byte, proposal relayProposal) {
+	p.Lock()
+	defer p.Unlock()
+	p.proposals[blockRoot] = proposal
+}
+
+// due removes and returns the proposals which should be canonical at the given head slot, i.e. the proposals at
+// least an epoch older than the head.
+func (p *relayProposals) due(headSlot primitives.Slot) map[[32]byte]relayProposal {
+	p.Lock()
+	defer p.Unlock()
+	due := make(map[[32]byte]relayProposal)
+	for root, proposal := range p.proposals {
+		if proposal.slot+params.BeaconConfig().SlotsPerEpoch <= headSlot {
+			due[root] = proposal
+			delete(p.proposals, root)
+		}
+	}
+	return due
+}
@@ -0,0 +1,193 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+const (
+	relayResultOK      = "ok"
+	relayResultNoBid   = "no_bid"
+	relayResultInvalid = "invalid"
+	relayResultTimeout = "timeout"
+	relayResultError   = "error"
+)
+
+// winningRelaysRetention is the number of slots for which the relay of a winning bid is remembered, so that the
+// blinded block built on its header is submitted back to it.
+const winningRelaysRetention = primitives.Slot(64)
+
+var (
+	errNoRelayBid       = errors.New("no relay returned a valid bid")
+	errNoPermittedRelay = errors.New("none of the permitted relays is configured")
+)
+
+// relay is a builder relay the service sends requests to.
+type relay struct {
+	builder.BuilderClient
+	sync.RWMutex
+	healthy          bool
+	quarantinedUntil time.Time
+	incidents        []RelayIncident
+}
+
+func newRelay(c builder.BuilderClient) *relay {
+	r := &relay{BuilderClient: c}
+	r.setHealthy(true)
+	return r
+}
+
+func (r *relay) setHealthy(healthy bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.healthy = healthy
+	v := 0.0
+	if healthy {
+		v = 1
+	}
+	relayHealthyGauge.WithLabelValues(r.NodeURL()).Set(v)
+}
+
+func (r *relay) isHealthy() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.healthy
+}
+
+// checkStatus calls the status endpoint of the relay and updates its health.
+func (r *relay) checkStatus(ctx context.Context) error {
+	err := r.Status(ctx)
+	r.setHealthy(err == nil)
+	return err
+}
+
+// relayKey normalizes a relay URL so that the relays of the proposer settings can be matched with the configured
+// relay endpoints. The credentials of the URL, like the relay public key, and a trailing slash are ignored.
+func relayKey(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return strings.ToLower(strings.TrimSuffix(endpoint, "/"))
+	}
+	return strings.ToLower(u.Host) + strings.TrimSuffix(u.Path, "/")
+}
+
+// relayBid is the outcome of a GetHeader request to a relay. The bid is kept when it is invalid, for the audit log.
+type relayBid struct {
+	relay      *relay
+	bid        builder.SignedBid
+	value      *big.Int
+	err        error
+	latency    time.Duration
+	receivedAt time.Time
+}
+
+// getRelayBid requests a bid from the relay and validates it against the parent hash.
+func getRelayBid(ctx context.Context, r *relay, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) relayBid {
+	start := time.Now()
+	bid, err := r.GetHeader(ctx, slot, parentHash, pubKey)
+	receivedAt := time.Now()
+	latency := receivedAt.Sub(start)
+	relayLatencyHistogram.WithLabelValues(r.NodeURL(), "get_header").Observe(float64(latency.Milliseconds()))
+	result := relayResultOK
+	var value *big.Int
+	switch {
+	case errors.Is(err, builder.ErrNoContent):
+		result = relayResultNoBid
+	case err != nil && ctx.Err() != nil:
+		result = relayResultTimeout
+	case err != nil:
+		result = relayResultError
+	case bid == nil || bid.IsNil():
+		result, err = relayResultNoBid, builder.ErrNoContent
+	default:
+		value, err = validateBid(bid, parentHash)
+		if err != nil {
+			result = relayResultInvalid
+		}
+	}
+	relayRequestsCount.WithLabelValues(r.NodeURL(), "get_header", result).Inc()
+	res := relayBid{relay: r, latency: latency, receivedAt: receivedAt}
+	if bid != nil && !bid.IsNil() {
+		res.bid = bid
+	}
+	if err != nil {
+		res.err = errors.Wrapf(err, "relay %s", r.NodeURL())
+		return res
+	}
+	res.value = value
+	return res
+}
+
+// validateBid checks that the bid builds on the parent hash and is signed by the builder, and returns its value in wei.
+func validateBid(signedBid builder.SignedBid, parentHash [32]byte) (*big.Int, error) {
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid.IsNil() {
+		return nil, errors.New("builder returned nil bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, err
+	}
+	if err := signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d); err != nil {
+		return nil, errors.Wrap(err, "could not validate builder signature")
+	}
+	return bytesutil.LittleEndianBytesToBigInt(bid.Value()), nil
+}
+
+// winningRelays remembers the relay of the highest bid for each payload block hash.
+type winningRelays struct {
+	sync.Mutex
+	relays map[[32]byte]winningRelay
+}
+
+type winningRelay struct {
+	slot  primitives.Slot
+	relay *relay
+}
+
+func newWinningRelays() *winningRelays {
+	return &winningRelays{relays: make(map[[32]byte]winningRelay)}
+}
+
+func (w *winningRelays) add(slot primitives.Slot, blockHash [32]byte, r *relay) {
+	w.Lock()
+	defer w.Unlock()
+	for h, wr := range w.relays {
+		if wr.slot+winningRelaysRetention < slot {
+			delete(w.relays, h)
+		}
+	}
+	w.relays[blockHash] = winningRelay{slot: slot, relay: r}
+}
+
+func (w *winningRelays) get(blockHash [32]byte) (*relay, bool) {
+	w.Lock()
+	defer w.Unlock()
+	wr, ok := w.relays[blockHash]
+	return wr.relay, ok
+}
@@ -2,6 +2,7 @@ package builder
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -26,53 +28,84 @@ var ErrNoBuilder = errors.New("builder endpoint not configured")
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error)
-	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error)
+	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte, relays []string) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	UpdateRelays(ctx context.Context, relays map[primitives.ValidatorIndex][]string)
+	RelaysByValidatorID(id primitives.ValidatorIndex) []string
+	RecordPayloadChoice(ctx context.Context, choice *PayloadChoice)
+	BidAudit(ctx context.Context, slot primitives.Slot) (*ethpb.BuilderBidAudit, error)
+	RelayStates() []*RelayState
 	Configured() bool
 }
 
+// defaultGetHeaderTimeout is the time the relays have to return a bid. This value is known as
+// `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
+const defaultGetHeaderTimeout = time.Second
+
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients   []builder.BuilderClient
+	getHeaderTimeout time.Duration
+	beaconDB         db.HeadAccessDatabase
+	headFetcher      blockchain.HeadFetcher
+	canonicalFetcher blockchain.CanonicalFetcher
+	stateNotifier    statefeed.Notifier
+	relayQuarantine  time.Duration
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []*relay
+	winners           *winningRelays
+	audits            *bidAudits
+	proposals         *relayProposals
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	relayCache        *cache.RegistrationCache
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       &config{getHeaderTimeout: defaultGetHeaderTimeout, relayQuarantine: defaultRelayQuarantine},
+		winners:   newWinningRelays(),
+		audits:    newBidAudits(),
+		proposals: newRelayProposals(),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	// The permitted relays of the validators are only kept in memory, like the registrations of the cache.
+	s.relayCache = s.registrationCache
+	if s.relayCache == nil {
+		s.relayCache = cache.NewRegistrationCache()
+	}
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		r := newRelay(c)
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := r.checkStatus(ctx); err != nil {
+			log.WithError(err).WithField("endpoint", r.NodeURL()).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", r.NodeURL()).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -87,7 +120,9 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the builder relay network. The block is sent to the relay that
+// returned the bid of its payload header, or to every relay in turn when that relay is not known. The relay is
+// quarantined if it does not reveal the payload, and later if the block does not become canonical.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -95,38 +130,193 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil, nil, ErrNoBuilder
 	}
+	if b == nil || b.IsNil() {
+		return nil, nil, errors.New("nil blinded block")
+	}
+
+	relays := s.relays
+	if len(relays) > 1 {
+		if r, ok := s.winningRelay(b); ok {
+			relays = []*relay{r}
+		} else {
+			log.Warn("Relay of the blinded block payload is unknown, submitting the block to every relay")
+		}
+	}
+	var err error
+	for _, r := range relays {
+		var payload interfaces.ExecutionData
+		var bundle *v1.BlobsBundle
+		payload, bundle, err = s.submitBlindedBlock(ctx, r, b)
+		if err == nil {
+			s.auditSubmission(ctx, b.Block().Slot(), nil)
+			s.trackProposal(r, b)
+			return payload, bundle, nil
+		}
+	}
+	s.auditSubmission(ctx, b.Block().Slot(), err)
+	// The payload can only be blamed on a relay when the block was sent to that relay alone. A request cancelled by
+	// the caller is not an incident of the relay.
+	if len(relays) == 1 && ctx.Err() == nil {
+		s.recordIncident(relays[0], b, IncidentPayloadWithheld, err)
+	}
+	tracing.AnnotateError(span, err)
+	return nil, nil, err
+}
+
+func (s *Service) submitBlindedBlock(ctx context.Context, r *relay, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	start := time.Now()
+	payload, bundle, err := r.SubmitBlindedBlock(ctx, b)
+	relayLatencyHistogram.WithLabelValues(r.NodeURL(), "submit_blinded_block").Observe(float64(time.Since(start).Milliseconds()))
+	result := relayResultOK
+	if err != nil {
+		result = relayResultError
+		log.WithError(err).WithField("endpoint", r.NodeURL()).Error("Failed to submit blinded block to relay")
+	}
+	relayRequestsCount.WithLabelValues(r.NodeURL(), "submit_blinded_block", result).Inc()
+	return payload, bundle, err
+}
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+// winningRelay returns the relay whose bid carried the payload header of the blinded block.
+func (s *Service) winningRelay(b interfaces.ReadOnlySignedBeaconBlock) (*relay, bool) {
+	if b == nil || b.IsNil() {
+		return nil, false
+	}
+	header, err := b.Block().Body().Execution()
+	if err != nil || header == nil || header.IsNil() {
+		return nil, false
+	}
+	return s.winners.get(bytesutil.ToBytes32(header.BlockHash()))
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
-func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
+// GetHeader retrieves the header for a given slot and parent hash from the builder relay network. The header is
+// requested from all the healthy relays which are not quarantined at once, and the valid bid with the highest value received before the
+// get header timeout is returned. When relays is not empty, only the relays with these URLs are requested.
+func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte, relayURLs []string) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
 	start := time.Now()
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	relays, err := s.permittedRelays(relayURLs)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	relays = unquarantinedRelays(relays, time.Now())
+	if len(relays) == 0 {
+		tracing.AnnotateError(span, ErrRelaysQuarantined)
+		return nil, ErrRelaysQuarantined
+	}
+	relays = healthyRelays(relays)
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.getHeaderTimeout)
+	defer cancel()
+	results := make(chan relayBid, len(relays))
+	for _, r := range relays {
+		go func(r *relay) {
+			results <- getRelayBid(ctx, r, slot, parentHash, pubKey)
+		}(r)
+	}
+
+	var best relayBid
+	answered := make(map[*relay]bool, len(relays))
+	received := make([]relayBid, 0, len(relays))
+collect:
+	for range relays {
+		select {
+		case res := <-results:
+			answered[res.relay] = true
+			received = append(received, res)
+			if res.err != nil {
+				if err == nil {
+					err = res.err
+				}
+				log.WithError(res.err).WithField("slot", slot).Debug("Relay did not return a valid bid")
+				continue
+			}
+			if best.bid == nil || res.value.Cmp(best.value) > 0 {
+				best = res
+			}
+		case <-ctx.Done():
+			// Use the best bid received before the deadline.
+			break collect
+		}
+	}
+	for _, r := range relays {
+		if !answered[r] {
+			received = append(received, missedBid(r, s.cfg.getHeaderTimeout))
+		}
+	}
+	// The request context may be past its deadline, the audit is saved with the service context.
+	s.auditBids(s.ctx, slot, parentHash, received, best)
+	if best.bid == nil {
+		if err == nil {
+			err = errNoRelayBid
+		}
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+
+	bid, err := best.bid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	s.winners.add(slot, bytesutil.ToBytes32(header.BlockHash()), best.relay)
+	relayBidsWonCount.WithLabelValues(best.relay.NodeURL()).Inc()
+	span.AddAttributes(trace.StringAttribute("relay", best.relay.NodeURL()))
+	return best.bid, nil
 }
 
-// Status retrieves the status of the builder relay network.
-func (s *Service) Status() error {
-	// Return early if builder isn't initialized in service.
-	if s.c == nil {
-		return nil
+// permittedRelays returns the relays with the given URLs, or all the relays if no URL is given.
+func (s *Service) permittedRelays(urls []string) ([]*relay, error) {
+	if len(urls) == 0 {
+		return s.relays, nil
+	}
+	permitted := make(map[string]bool, len(urls))
+	for _, u := range urls {
+		permitted[relayKey(u)] = true
+	}
+	relays := make([]*relay, 0, len(urls))
+	for _, r := range s.relays {
+		if permitted[relayKey(r.NodeURL())] {
+			relays = append(relays, r)
+		}
+	}
+	if len(relays) == 0 {
+		return nil, errors.Wrapf(errNoPermittedRelay, "permitted relays %v", urls)
 	}
+	return relays, nil
+}
+
+// healthyRelays returns the relays whose last status check succeeded, or all the relays if none did.
+func healthyRelays(relays []*relay) []*relay {
+	healthy := make([]*relay, 0, len(relays))
+	for _, r := range relays {
+		if r.isHealthy() {
+			healthy = append(healthy, r)
+		}
+	}
+	if len(healthy) == 0 {
+		return relays
+	}
+	return healthy
+}
 
+// Status retrieves the status of the builder relay network.
+func (s *Service) Status() error {
 	return nil
 }
 
@@ -139,7 +329,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return ErrNoBuilder
 	}
 
@@ -164,7 +354,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerValidator(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -191,9 +381,63 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 	}
 }
 
+// UpdateRelays sets the relays permitted for the validators. An empty list permits all the relays.
+func (s *Service) UpdateRelays(ctx context.Context, relays map[primitives.ValidatorIndex][]string) {
+	s.relayCache.UpdateIndexToRelays(ctx, relays)
+}
+
+// RelaysByValidatorID returns the relays permitted for the validator, or nil if all the relays are permitted.
+func (s *Service) RelaysByValidatorID(id primitives.ValidatorIndex) []string {
+	return s.relayCache.RelaysByIndex(id)
+}
+
+// registerValidator sends the registrations to all the relays at once. It only fails if no relay accepted them.
+func (s *Service) registerValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make(chan error, len(s.relays))
+	for _, r := range s.relays {
+		go func(r *relay) {
+			start := time.Now()
+			err := r.RegisterValidator(ctx, reg)
+			relayLatencyHistogram.WithLabelValues(r.NodeURL(), "register_validator").Observe(float64(time.Since(start).Milliseconds()))
+			result := relayResultOK
+			if err != nil {
+				result = relayResultError
+				log.WithError(err).WithField("endpoint", r.NodeURL()).Error("Failed to register validators with relay")
+				err = errors.Wrapf(err, "relay %s", r.NodeURL())
+			}
+			relayRequestsCount.WithLabelValues(r.NodeURL(), "register_validator", result).Inc()
+			errs <- err
+		}(r)
+	}
+	var firstErr error
+	registered := 0
+	for range s.relays {
+		if err := <-errs; err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		registered++
+	}
+	if registered == 0 {
+		return firstErr
+	}
+	return nil
+}
+
+// RelayStates returns the health and the quarantine status of the relays.
+func (s *Service) RelayStates() []*RelayState {
+	states := make([]*RelayState, len(s.relays))
+	for i, r := range s.relays {
+		states[i] = r.state()
+	}
+	return states
+}
+
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -202,13 +446,100 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.checkStatus(ctx); err != nil {
+					log.WithError(err).WithField("endpoint", r.NodeURL()).
+						Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
+				r.updateQuarantineGauge(time.Now())
 			}
+			s.checkProposals(ctx)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
+
+// trackProposal remembers the block built on the payload revealed by the relay, to check later that it became canonical.
+func (s *Service) trackProposal(r *relay, b interfaces.ReadOnlySignedBeaconBlock) {
+	if s.cfg.canonicalFetcher == nil || s.cfg.headFetcher == nil {
+		return
+	}
+	root, blockHash, err := blockRootAndHash(b)
+	if err != nil {
+		log.WithError(err).Error("Could not track builder proposal")
+		return
+	}
+	s.proposals.add(root, relayProposal{slot: b.Block().Slot(), blockHash: blockHash, relay: r})
+}
+
+// checkProposals quarantines the relays which revealed the payloads of blocks that did not become canonical within
+// an epoch.
+func (s *Service) checkProposals(ctx context.Context) {
+	if s.cfg.canonicalFetcher == nil || s.cfg.headFetcher == nil {
+		return
+	}
+	for root, p := range s.proposals.due(s.cfg.headFetcher.HeadSlot()) {
+		canonical, err := s.cfg.canonicalFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			log.WithError(err).WithField("slot", p.slot).Error("Could not check if builder proposal is canonical")
+			continue
+		}
+		if canonical {
+			continue
+		}
+		s.quarantine(p.relay, RelayIncident{
+			Kind:      IncidentNotCanonical,
+			Slot:      p.slot,
+			BlockRoot: root,
+			BlockHash: p.blockHash,
+			Time:      time.Now(),
+		})
+	}
+}
+
+// recordIncident quarantines the relay for the incident of the blinded block.
+func (s *Service) recordIncident(r *relay, b interfaces.ReadOnlySignedBeaconBlock, kind string, err error) {
+	incident := RelayIncident{Kind: kind, Slot: b.Block().Slot(), Time: time.Now()}
+	if err != nil {
+		incident.Err = err.Error()
+	}
+	root, blockHash, rootErr := blockRootAndHash(b)
+	if rootErr != nil {
+		log.WithError(rootErr).Error("Could not get blinded block root")
+	}
+	incident.BlockRoot, incident.BlockHash = root, blockHash
+	s.quarantine(r, incident)
+}
+
+// quarantine records the incident of the relay and quarantines it.
+func (s *Service) quarantine(r *relay, incident RelayIncident) {
+	period := s.cfg.relayQuarantine
+	r.recordIncident(incident, period)
+	l := log.WithFields(log.Fields{
+		"endpoint":         r.NodeURL(),
+		"incident":         incident.Kind,
+		"slot":             incident.Slot,
+		"blockRoot":        fmt.Sprintf("%#x", incident.BlockRoot),
+		"quarantinePeriod": period,
+	})
+	if period == 0 {
+		l.Warn("Builder relay incident")
+		return
+	}
+	l.Warn("Builder relay incident, falling back to other relays while the relay is quarantined")
+}
+
+// blockRootAndHash returns the root of the blinded block, which is also the root of the full block, and the block
+// hash of its payload.
+func blockRootAndHash(b interfaces.ReadOnlySignedBeaconBlock) ([32]byte, [32]byte, error) {
+	root, err := b.Block().HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, [32]byte{}, err
+	}
+	header, err := b.Block().Body().Execution()
+	if err != nil {
+		return [32]byte{}, [32]byte{}, err
+	}
+	return root, bytesutil.ToBytes32(header.BlockHash()), nil
+}
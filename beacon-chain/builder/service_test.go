@@ -2,16 +2,28 @@ package builder
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	buildertesting "github.com/prysmaticlabs/prysm/v5/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
 func Test_NewServiceWithBuilder(t *testing.T) {
@@ -59,7 +71,7 @@ func Test_BuilderMethodsWithouClient(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, false, s.Configured())
 
-	_, err = s.GetHeader(context.Background(), 0, [32]byte{}, [48]byte{})
+	_, err = s.GetHeader(context.Background(), 0, [32]byte{}, [48]byte{}, nil)
 	assert.ErrorContains(t, ErrNoBuilder.Error(), err)
 
 	_, _, err = s.SubmitBlindedBlock(context.Background(), nil)
@@ -68,3 +80,421 @@ func Test_BuilderMethodsWithouClient(t *testing.T) {
 	err = s.RegisterValidator(context.Background(), nil)
 	assert.ErrorContains(t, ErrNoBuilder.Error(), err)
 }
+
+// testRelay is a builder client returning a fixed bid.
+type testRelay struct {
+	url        string
+	bid        builder.SignedBid
+	delay      time.Duration
+	submitted  int
+	registered int
+	err        error
+}
+
+func (r *testRelay) NodeURL() string {
+	return r.url
+}
+
+func (r *testRelay) GetHeader(ctx context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	select {
+	case <-time.After(r.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if r.bid == nil {
+		return nil, builder.ErrNoContent
+	}
+	return r.bid, nil
+}
+
+func (r *testRelay) RegisterValidator(_ context.Context, _ []*eth.SignedValidatorRegistrationV1) error {
+	r.registered++
+	return r.err
+}
+
+func (r *testRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	r.submitted++
+	return nil, nil, r.err
+}
+
+func (r *testRelay) Status(_ context.Context) error {
+	return nil
+}
+
+func testSignedBid(t *testing.T, parentHash, blockHash [32]byte, value byte, sign bool) builder.SignedBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	bid := &eth.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			ExtraData:        make([]byte, 0),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        blockHash[:],
+			TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo([]byte{value}, 32),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	if !sign {
+		sr = [32]byte{'x'}
+	}
+	sBid, err := builder.WrappedSignedBuilderBidCapella(&eth.SignedBuilderBidCapella{Message: bid, Signature: sk.Sign(sr[:]).Marshal()})
+	require.NoError(t, err)
+	return sBid
+}
+
+func testBlindedBlock(t *testing.T, blockHash [32]byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockCapella()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash[:]
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+func Test_GetHeader_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	parent := [32]byte{'p'}
+	low := &testRelay{url: "low", bid: testSignedBid(t, parent, [32]byte{'l'}, 1, true)}
+	high := &testRelay{url: "high", bid: testSignedBid(t, parent, [32]byte{'h'}, 3, true)}
+	badSig := &testRelay{url: "bad-signature", bid: testSignedBid(t, parent, [32]byte{'s'}, 5, false)}
+	badParent := &testRelay{url: "bad-parent", bid: testSignedBid(t, [32]byte{'x'}, [32]byte{'x'}, 5, true)}
+	slow := &testRelay{url: "slow", bid: testSignedBid(t, parent, [32]byte{'w'}, 9, true), delay: time.Second}
+	none := &testRelay{url: "none"}
+	s, err := NewService(ctx,
+		WithGetHeaderTimeout(200*time.Millisecond), WithRegistrationCache(),
+		WithBuilderClient(low), WithBuilderClient(high), WithBuilderClient(badSig),
+		WithBuilderClient(badParent), WithBuilderClient(slow), WithBuilderClient(none))
+	require.NoError(t, err)
+
+	bid, err := s.GetHeader(ctx, 1, parent, [48]byte{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, high.bid, bid)
+
+	require.NoError(t, s.RegisterValidator(ctx, nil))
+	for _, r := range []*testRelay{low, high, badSig, badParent, slow, none} {
+		assert.Equal(t, 1, r.registered)
+	}
+
+	// The block built on the winning header goes back to the relay of the bid.
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, [32]byte{'h'}))
+	require.NoError(t, err)
+	assert.Equal(t, 1, high.submitted)
+	assert.Equal(t, 0, low.submitted)
+
+	// A block of an unknown header is sent to all the relays until one accepts it.
+	low.err = errors.New("unknown payload")
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, [32]byte{'u'}))
+	require.NoError(t, err)
+	assert.Equal(t, 1, low.submitted)
+	assert.Equal(t, 2, high.submitted)
+}
+
+func Test_GetHeader_NoValidBid(t *testing.T) {
+	ctx := context.Background()
+	parent := [32]byte{'p'}
+	bad := &testRelay{url: "bad-parent", bid: testSignedBid(t, [32]byte{'x'}, [32]byte{'x'}, 5, true)}
+	none := &testRelay{url: "none"}
+	s, err := NewService(ctx, WithRegistrationCache(), WithBuilderClient(bad), WithBuilderClient(none))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, parent, [48]byte{}, nil)
+	require.NotNil(t, err)
+
+	bad.err, none.err = errors.New("down"), errors.New("down")
+	require.ErrorContains(t, "could not register validator(s)", s.RegisterValidator(ctx, nil))
+}
+
+func Test_GetHeader_PermittedRelays(t *testing.T) {
+	ctx := context.Background()
+	parent := [32]byte{'p'}
+	low := &testRelay{url: "https://0xabcd@Relay-Low.example.com", bid: testSignedBid(t, parent, [32]byte{'l'}, 1, true)}
+	high := &testRelay{url: "https://relay-high.example.com/", bid: testSignedBid(t, parent, [32]byte{'h'}, 3, true)}
+	s, err := NewService(ctx, WithBuilderClient(low), WithBuilderClient(high))
+	require.NoError(t, err)
+
+	s.UpdateRelays(ctx, map[primitives.ValidatorIndex][]string{1: {"https://relay-low.example.com/"}})
+	require.DeepEqual(t, []string{"https://relay-low.example.com/"}, s.RelaysByValidatorID(1))
+	require.Equal(t, 0, len(s.RelaysByValidatorID(2)))
+
+	bid, err := s.GetHeader(ctx, 1, parent, [48]byte{}, s.RelaysByValidatorID(1))
+	require.NoError(t, err)
+	require.Equal(t, low.bid, bid)
+	bid, err = s.GetHeader(ctx, 1, parent, [48]byte{}, s.RelaysByValidatorID(2))
+	require.NoError(t, err)
+	require.Equal(t, high.bid, bid)
+
+	_, err = s.GetHeader(ctx, 1, parent, [48]byte{}, []string{"https://unknown.example.com"})
+	require.ErrorIs(t, err, errNoPermittedRelay)
+}
+
+func Test_BidAudit(t *testing.T) {
+	ctx := context.Background()
+	parent := [32]byte{'p'}
+	low := &testRelay{url: "low", bid: testSignedBid(t, parent, [32]byte{'l'}, 1, true)}
+	high := &testRelay{url: "high", bid: testSignedBid(t, parent, [32]byte{'h'}, 3, true)}
+	badParent := &testRelay{url: "bad-parent", bid: testSignedBid(t, [32]byte{'x'}, [32]byte{'x'}, 5, true)}
+	slow := &testRelay{url: "slow", bid: testSignedBid(t, parent, [32]byte{'w'}, 9, true), delay: time.Second}
+	beaconDB := dbtesting.SetupDB(t)
+	notifier := &blockchainTesting.MockStateNotifier{RecordEvents: true}
+	s, err := NewService(ctx,
+		WithGetHeaderTimeout(200*time.Millisecond), WithDatabase(beaconDB), WithStateNotifier(notifier),
+		WithBuilderClient(low), WithBuilderClient(high), WithBuilderClient(badParent), WithBuilderClient(slow))
+	require.NoError(t, err)
+
+	_, err = s.BidAudit(ctx, 1)
+	require.ErrorIs(t, err, ErrNoBidAudit)
+
+	_, err = s.GetHeader(ctx, 1, parent, [48]byte{}, nil)
+	require.NoError(t, err)
+	audit, err := s.BidAudit(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, parent[:], audit.ParentHash)
+	require.Equal(t, 4, len(audit.Bids))
+	bids := make(map[string]*eth.BuilderBidRecord)
+	for _, b := range audit.Bids {
+		bids[b.Relay] = b
+	}
+	assert.Equal(t, "", bids["high"].Error)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{3}, 32), bids["high"].Value)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'h'}, 32), bids["high"].BlockHash)
+	assert.NotEqual(t, uint64(0), bids["high"].ReceivedAtMs)
+	assert.StringContains(t, "incorrect parent hash", bids["bad-parent"].Error)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{5}, 32), bids["bad-parent"].Value)
+	assert.StringContains(t, "no answer before the get header timeout", bids["slow"].Error)
+	assert.Equal(t, uint64(200), bids["slow"].LatencyMs)
+	// The mock notifier records the events asynchronously.
+	for i := 0; i < 100 && len(notifier.ReceivedEvents()) < 4; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, 4, len(notifier.ReceivedEvents()))
+
+	s.RecordPayloadChoice(ctx, &PayloadChoice{
+		Slot:          1,
+		ProposerIndex: 2,
+		LocalValue:    big.NewInt(2),
+		BuilderChosen: true,
+		Reason:        "builder value higher",
+	})
+	blk := util.NewBlindedBeaconBlockCapella()
+	blk.Block.Slot = 1
+	blk.Block.Body.ExecutionPayloadHeader.BlockHash = bytesutil.PadTo([]byte{'h'}, 32)
+	sBlk, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, _, err = s.SubmitBlindedBlock(ctx, sBlk)
+	require.NoError(t, err)
+
+	audit, err = s.BidAudit(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), audit.ProposerIndex)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{2}, 32), audit.LocalValue)
+	assert.Equal(t, true, audit.BuilderChosen)
+	assert.Equal(t, "high", audit.ChosenRelay)
+	assert.Equal(t, "builder value higher", audit.Reason)
+	assert.Equal(t, true, audit.BlindedBlockSubmitted)
+
+	// The audit survives in the database.
+	saved, err := beaconDB.BuilderBidAudit(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, audit, saved)
+}
+
+func Test_GetHeader_MockRelays(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	signedReg := mockRelayRegistration(t)
+	reg := signedReg.Message
+	newRelay := func(value int64, b buildertesting.Behavior) (*buildertesting.Relay, builder.BuilderClient) {
+		return startMockRelay(t, value, b, signedReg)
+	}
+	honest, honestClient := newRelay(1000, buildertesting.Behavior{})
+	_, badSigClient := newRelay(5000, buildertesting.Behavior{InvalidSignature: true})
+	_, badParentClient := newRelay(9000, buildertesting.Behavior{WrongParentHash: true})
+	_, lateClient := newRelay(9000, buildertesting.Behavior{BidDelay: time.Second})
+	s, err := NewService(ctx,
+		WithGetHeaderTimeout(200*time.Millisecond), WithRegistrationCache(),
+		WithBuilderClient(honestClient), WithBuilderClient(badSigClient),
+		WithBuilderClient(badParentClient), WithBuilderClient(lateClient))
+	require.NoError(t, err)
+
+	// Only the bid of the honest relay is valid, although it is the lowest.
+	parent := [32]byte{'p'}
+	bid, err := s.GetHeader(ctx, 1, parent, bytesutil.ToBytes48(reg.Pubkey), nil)
+	require.NoError(t, err)
+	msg, err := bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, honest.PublicKey().Marshal(), msg.Pubkey())
+	header, err := msg.Header()
+	require.NoError(t, err)
+
+	// The blinded block goes back to the honest relay, which reveals the payload.
+	blk := util.NewBlindedBeaconBlockCapella()
+	blk.Block.Slot = 1
+	blk.Block.Body.ExecutionPayloadHeader = header.Proto().(*v1.ExecutionPayloadHeaderCapella)
+	sBlk, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	payload, _, err := s.SubmitBlindedBlock(ctx, sBlk)
+	require.NoError(t, err)
+	require.DeepEqual(t, header.BlockHash(), payload.BlockHash())
+	require.Equal(t, true, honest.Revealed(bytesutil.ToBytes32(header.BlockHash())))
+
+	// A relay withholding the payload makes the submission fail.
+	honest.SetBehavior(buildertesting.Behavior{WithholdPayload: true})
+	_, _, err = s.SubmitBlindedBlock(ctx, sBlk)
+	require.ErrorContains(t, "payload withheld", err)
+}
+
+func mockRelayRegistration(t *testing.T) *eth.SignedValidatorRegistrationV1 {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	reg := &eth.ValidatorRegistrationV1{
+		FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+		GasLimit:     30000000,
+		Timestamp:    uint64(time.Now().Unix()),
+		Pubkey:       sk.PublicKey().Marshal(),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(reg, domain)
+	require.NoError(t, err)
+	return &eth.SignedValidatorRegistrationV1{Message: reg, Signature: sk.Sign(sr[:]).Marshal()}
+}
+
+// startMockRelay starts a mock relay bidding the value in wei, with the validator of the registration registered.
+func startMockRelay(t *testing.T, value int64, b buildertesting.Behavior, reg *eth.SignedValidatorRegistrationV1) (*buildertesting.Relay, builder.BuilderClient) {
+	r, err := buildertesting.NewRelay(buildertesting.WithEngine(&buildertesting.MockEngine{Value: big.NewInt(value)}))
+	require.NoError(t, err)
+	r.SetBehavior(b)
+	require.NoError(t, r.Start())
+	t.Cleanup(func() {
+		require.NoError(t, r.Stop(context.Background()))
+	})
+	c, err := builder.NewClient(r.URL())
+	require.NoError(t, err)
+	require.NoError(t, c.RegisterValidator(context.Background(), []*eth.SignedValidatorRegistrationV1{reg}))
+	return r, c
+}
+
+func capellaBlindedBlock(t *testing.T, slot primitives.Slot, bid builder.SignedBid) interfaces.ReadOnlySignedBeaconBlock {
+	msg, err := bid.Message()
+	require.NoError(t, err)
+	header, err := msg.Header()
+	require.NoError(t, err)
+	blk := util.NewBlindedBeaconBlockCapella()
+	blk.Block.Slot = slot
+	blk.Block.Body.ExecutionPayloadHeader = header.Proto().(*v1.ExecutionPayloadHeaderCapella)
+	sBlk, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	return sBlk
+}
+
+func Test_RelayQuarantine(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	reg := mockRelayRegistration(t)
+	pubkey := bytesutil.ToBytes48(reg.Message.Pubkey)
+	withholding, withholdingClient := startMockRelay(t, 2000, buildertesting.Behavior{}, reg)
+	_, reorgedClient := startMockRelay(t, 1000, buildertesting.Behavior{}, reg)
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	chain := &blockchainTesting.ChainService{State: st, CanonicalRoots: map[[32]byte]bool{}}
+	s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(chain), WithCanonicalFetcher(chain),
+		WithBuilderClient(withholdingClient), WithBuilderClient(reorgedClient), WithRelayQuarantine(time.Hour))
+	require.NoError(t, err)
+	parent := [32]byte{'p'}
+
+	// The relay of the highest bid does not reveal the payload and is quarantined.
+	bid, err := s.GetHeader(ctx, 1, parent, pubkey, nil)
+	require.NoError(t, err)
+	withholding.SetBehavior(buildertesting.Behavior{WithholdPayload: true})
+	_, _, err = s.SubmitBlindedBlock(ctx, capellaBlindedBlock(t, 1, bid))
+	require.ErrorContains(t, "payload withheld", err)
+	states := s.RelayStates()
+	require.Equal(t, 2, len(states))
+	require.Equal(t, true, states[0].Quarantined(time.Now()))
+	require.Equal(t, 1, len(states[0].Incidents))
+	assert.Equal(t, IncidentPayloadWithheld, states[0].Incidents[0].Kind)
+	assert.Equal(t, primitives.Slot(1), states[0].Incidents[0].Slot)
+	assert.Equal(t, false, states[1].Quarantined(time.Now()))
+
+	// The quarantined relay is not requested anymore, the other relay wins with a lower bid and reveals the payload.
+	bid, err = s.GetHeader(ctx, 2, parent, pubkey, nil)
+	require.NoError(t, err)
+	msg, err := bid.Message()
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{0xe8, 0x03}, 32), msg.Value())
+	blk := capellaBlindedBlock(t, 2, bid)
+	_, _, err = s.SubmitBlindedBlock(ctx, blk)
+	require.NoError(t, err)
+
+	// The block is not checked before it is an epoch old.
+	s.checkProposals(ctx)
+	assert.Equal(t, false, s.RelayStates()[1].Quarantined(time.Now()))
+
+	// The block did not become canonical, the relay is quarantined.
+	require.NoError(t, st.SetSlot(2+params.BeaconConfig().SlotsPerEpoch))
+	s.checkProposals(ctx)
+	states = s.RelayStates()
+	require.Equal(t, true, states[1].Quarantined(time.Now()))
+	require.Equal(t, 1, len(states[1].Incidents))
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, IncidentNotCanonical, states[1].Incidents[0].Kind)
+	assert.Equal(t, root, states[1].Incidents[0].BlockRoot)
+
+	// With all the relays quarantined, the proposer falls back to the local payload.
+	_, err = s.GetHeader(ctx, 3, parent, pubkey, nil)
+	require.ErrorIs(t, err, ErrRelaysQuarantined)
+
+	t.Run("canonical block", func(t *testing.T) {
+		_, c := startMockRelay(t, 1000, buildertesting.Behavior{}, reg)
+		chain.CanonicalRoots = nil
+		s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(chain), WithCanonicalFetcher(chain), WithBuilderClient(c))
+		require.NoError(t, err)
+		bid, err := s.GetHeader(ctx, 4, parent, pubkey, nil)
+		require.NoError(t, err)
+		_, _, err = s.SubmitBlindedBlock(ctx, capellaBlindedBlock(t, 4, bid))
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(4+params.BeaconConfig().SlotsPerEpoch))
+		s.checkProposals(ctx)
+		state := s.RelayStates()[0]
+		assert.Equal(t, false, state.Quarantined(time.Now()))
+		assert.Equal(t, 0, len(state.Incidents))
+	})
+	t.Run("single relay", func(t *testing.T) {
+		relay, c := startMockRelay(t, 1000, buildertesting.Behavior{WithholdPayload: true}, reg)
+		s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(chain), WithCanonicalFetcher(chain),
+			WithBuilderClient(c), WithRelayQuarantine(time.Hour))
+		require.NoError(t, err)
+		bid, err := s.GetHeader(ctx, 5, parent, pubkey, nil)
+		require.NoError(t, err)
+		_, _, err = s.SubmitBlindedBlock(ctx, capellaBlindedBlock(t, 5, bid))
+		require.ErrorContains(t, "payload withheld", err)
+
+		// The only relay is quarantined as well, the proposer falls back to the local payload.
+		state := s.RelayStates()[0]
+		assert.Equal(t, true, state.Quarantined(time.Now()))
+		require.Equal(t, 1, len(state.Incidents))
+		relay.SetBehavior(buildertesting.Behavior{})
+		_, err = s.GetHeader(ctx, 6, parent, pubkey, nil)
+		require.ErrorIs(t, err, ErrRelaysQuarantined)
+	})
+}
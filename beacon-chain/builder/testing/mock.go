@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	blockbuilder "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -38,6 +39,11 @@ type MockBuilderService struct {
 	ErrGetHeader          error
 	ErrRegisterValidator  error
 	Cfg                   *Config
+	Relays                map[primitives.ValidatorIndex][]string
+	GetHeaderRelays       []string
+	PayloadChoices        []*blockbuilder.PayloadChoice
+	BidAudits             map[primitives.Slot]*ethpb.BuilderBidAudit
+	RelayStatus           []*blockbuilder.RelayState
 }
 
 // Configured for mocking.
@@ -72,7 +78,8 @@ func (s *MockBuilderService) SubmitBlindedBlock(_ context.Context, b interfaces.
 }
 
 // GetHeader for mocking.
-func (s *MockBuilderService) GetHeader(_ context.Context, slot primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+func (s *MockBuilderService) GetHeader(_ context.Context, slot primitives.Slot, _ [32]byte, _ [48]byte, relays []string) (builder.SignedBid, error) {
+	s.GetHeaderRelays = relays
 	if slots.ToEpoch(slot) >= params.BeaconConfig().DenebForkEpoch || s.BidDeneb != nil {
 		return builder.WrappedSignedBuilderBidDeneb(s.BidDeneb)
 	}
@@ -101,3 +108,37 @@ func (s *MockBuilderService) RegistrationByValidatorID(ctx context.Context, id p
 func (s *MockBuilderService) RegisterValidator(context.Context, []*ethpb.SignedValidatorRegistrationV1) error {
 	return s.ErrRegisterValidator
 }
+
+// UpdateRelays for mocking.
+func (s *MockBuilderService) UpdateRelays(_ context.Context, relays map[primitives.ValidatorIndex][]string) {
+	if s.Relays == nil {
+		s.Relays = make(map[primitives.ValidatorIndex][]string)
+	}
+	for id, r := range relays {
+		s.Relays[id] = r
+	}
+}
+
+// RelaysByValidatorID for mocking.
+func (s *MockBuilderService) RelaysByValidatorID(id primitives.ValidatorIndex) []string {
+	return s.Relays[id]
+}
+
+// RecordPayloadChoice for mocking.
+func (s *MockBuilderService) RecordPayloadChoice(_ context.Context, choice *blockbuilder.PayloadChoice) {
+	s.PayloadChoices = append(s.PayloadChoices, choice)
+}
+
+// BidAudit for mocking.
+func (s *MockBuilderService) BidAudit(_ context.Context, slot primitives.Slot) (*ethpb.BuilderBidAudit, error) {
+	audit, ok := s.BidAudits[slot]
+	if !ok {
+		return nil, blockbuilder.ErrNoBidAudit
+	}
+	return audit, nil
+}
+
+// RelayStates for mocking.
+func (s *MockBuilderService) RelayStates() []*blockbuilder.RelayState {
+	return s.RelayStatus
+}
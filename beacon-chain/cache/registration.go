@@ -13,8 +13,10 @@ import (
 
 // RegistrationCache is used to store the cached results of an Validator Registration request.
 // beacon api /eth/v1/validator/register_validator
+// It also stores the builder relays each validator permits, as sent with beacon api /eth/v1/validator/prepare_beacon_proposer.
 type RegistrationCache struct {
 	indexToRegistration map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1
+	indexToRelays       map[primitives.ValidatorIndex][]string
 	lock                sync.RWMutex
 }
 
@@ -22,6 +24,7 @@ type RegistrationCache struct {
 func NewRegistrationCache() *RegistrationCache {
 	return &RegistrationCache{
 		indexToRegistration: make(map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1),
+		indexToRelays:       make(map[primitives.ValidatorIndex][]string),
 		lock:                sync.RWMutex{},
 	}
 }
@@ -53,3 +56,31 @@ func (regCache *RegistrationCache) UpdateIndexToRegisteredMap(ctx context.Contex
 		}
 	}
 }
+
+// RelaysByIndex returns the builder relays permitted for the validator. It returns nil when the validator permits all
+// the relays of the beacon node.
+func (regCache *RegistrationCache) RelaysByIndex(id primitives.ValidatorIndex) []string {
+	regCache.lock.RLock()
+	defer regCache.lock.RUnlock()
+	relays, ok := regCache.indexToRelays[id]
+	if !ok {
+		return nil
+	}
+	return append([]string(nil), relays...)
+}
+
+// UpdateIndexToRelays adds or updates the permitted relays of the validators in the cache. An empty list permits all
+// the relays.
+func (regCache *RegistrationCache) UpdateIndexToRelays(ctx context.Context, m map[primitives.ValidatorIndex][]string) {
+	_, span := trace.StartSpan(ctx, "RegistrationCache.UpdateIndexToRelays")
+	defer span.End()
+	regCache.lock.Lock()
+	defer regCache.lock.Unlock()
+	for key, value := range m {
+		if len(value) == 0 {
+			delete(regCache.indexToRelays, key)
+			continue
+		}
+		regCache.indexToRelays[key] = append([]string(nil), value...)
+	}
+}
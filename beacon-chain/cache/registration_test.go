@@ -44,3 +44,20 @@ func TestRegistrationCache(t *testing.T) {
 		require.Equal(t, string(reg.Pubkey), string(pubkey))
 	})
 }
+
+func TestRegistrationCache_Relays(t *testing.T) {
+	cache := NewRegistrationCache()
+	require.Equal(t, 0, len(cache.RelaysByIndex(1)))
+
+	relays := []string{"https://relay-a.example.com", "https://relay-b.example.com"}
+	cache.UpdateIndexToRelays(context.Background(), map[primitives.ValidatorIndex][]string{1: relays, 2: nil})
+	require.DeepEqual(t, relays, cache.RelaysByIndex(1))
+	require.Equal(t, 0, len(cache.RelaysByIndex(2)))
+
+	got := cache.RelaysByIndex(1)
+	got[0] = "https://other.example.com"
+	require.DeepEqual(t, relays, cache.RelaysByIndex(1))
+
+	cache.UpdateIndexToRelays(context.Background(), map[primitives.ValidatorIndex][]string{1: {}})
+	require.Equal(t, 0, len(cache.RelaysByIndex(1)))
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 const (
@@ -31,6 +33,10 @@ const (
 	LightClientFinalityUpdate
 	// LightClientOptimisticUpdate event
 	LightClientOptimisticUpdate
+	// ValidatorPerformance is sent by the validator monitor once the performance of tracked validators for an epoch is final.
+	ValidatorPerformance
+	// BuilderBidReceived is sent by the builder service for each answer of a relay to a get header request.
+	BuilderBidReceived
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -66,3 +72,19 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// ValidatorPerformanceData is the data sent with ValidatorPerformance events.
+type ValidatorPerformanceData struct {
+	// Epoch the performance was recorded for.
+	Epoch primitives.Epoch
+	// Performance of each tracked validator during the epoch.
+	Performance []*validator.EpochPerformance
+}
+
+// BuilderBidReceivedData is the data sent with BuilderBidReceived events.
+type BuilderBidReceivedData struct {
+	// Slot the bid was requested for.
+	Slot primitives.Slot
+	// Bid is the bid of the relay, or the reason the relay did not return a valid bid.
+	Bid *ethpb.BuilderBidRecord
+}
@@ -0,0 +1,314 @@
+package filesystem
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/spf13/afero"
+)
+
+const (
+	archiveDir     = "archive"
+	archiveDataExt = "ssz_snappy"
+	archiveIdxExt  = "idx"
+
+	// archiveRecordSize is the size of an index record: block root, blob index, offset and length in the data file.
+	archiveRecordSize = fieldparams.RootLength + 8 + 8 + 8
+)
+
+var errArchivedBlobCorrupt = errors.New("archived blob sidecar is corrupt")
+
+// blobArchive keeps the blob sidecars that fell out of the retention window when the storage runs in archival mode.
+// The sidecars of an epoch are appended, snappy compressed, to the archive/<epoch>.ssz_snappy data file, and each
+// sidecar gets a fixed size record in the archive/<epoch>.idx file pointing at its position in the data file.
+// The data is written before the index record, so a record always points at complete data. The index records are
+// loaded in memory when the storage is opened.
+type blobArchive struct {
+	sync.RWMutex
+	fs    afero.Fs
+	fsync bool
+	roots map[[32]byte]*archivedRoot
+}
+
+type archivedRoot struct {
+	epoch   primitives.Epoch
+	entries [fieldparams.MaxBlobsPerBlock]*archiveEntry
+}
+
+type archiveEntry struct {
+	offset uint64
+	length uint64
+}
+
+func newBlobArchive(fs afero.Fs, fsync bool) *blobArchive {
+	return &blobArchive{fs: fs, fsync: fsync, roots: make(map[[32]byte]*archivedRoot)}
+}
+
+func archiveDataPath(epoch primitives.Epoch) string {
+	return path.Join(archiveDir, fmt.Sprintf("%s.%s", epochString(epoch), archiveDataExt))
+}
+
+func archiveIdxPath(epoch primitives.Epoch) string {
+	return path.Join(archiveDir, fmt.Sprintf("%s.%s", epochString(epoch), archiveIdxExt))
+}
+
+// load reads all the index files of the archive. An index file ending with an incomplete record, left behind by an
+// interrupted write, is truncated so that the following records are appended at the right position.
+func (a *blobArchive) load() error {
+	a.Lock()
+	defer a.Unlock()
+	a.roots = make(map[[32]byte]*archivedRoot)
+	exists, err := afero.DirExists(a.fs, archiveDir)
+	if err != nil || !exists {
+		return err
+	}
+	entries, err := listDir(a.fs, archiveDir)
+	if err != nil {
+		return errors.Wrap(err, "unable to list blob archive directory")
+	}
+	for _, fname := range entries {
+		if !strings.HasSuffix(fname, "."+archiveIdxExt) {
+			continue
+		}
+		e, err := strconv.ParseUint(strings.TrimSuffix(fname, "."+archiveIdxExt), 10, 64)
+		if err != nil {
+			log.WithField("file", fname).Warn("Blob archive ignoring unexpected index file")
+			continue
+		}
+		if err := a.loadIdx(primitives.Epoch(e)); err != nil {
+			return errors.Wrapf(err, "could not load blob archive index %s", fname)
+		}
+	}
+	return nil
+}
+
+func (a *blobArchive) loadIdx(epoch primitives.Epoch) error {
+	idxPath := archiveIdxPath(epoch)
+	b, err := afero.ReadFile(a.fs, idxPath)
+	if err != nil {
+		return err
+	}
+	if extra := len(b) % archiveRecordSize; extra != 0 {
+		log.WithField("file", idxPath).Warn("Truncating incomplete blob archive index record")
+		b = b[:len(b)-extra]
+		f, err := a.fs.OpenFile(idxPath, os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		if err := f.Truncate(int64(len(b))); err != nil {
+			return closeAfter(f, err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < len(b); i += archiveRecordSize {
+		r := b[i : i+archiveRecordSize]
+		root := bytesutil.ToBytes32(r[:fieldparams.RootLength])
+		idx := binary.LittleEndian.Uint64(r[fieldparams.RootLength:])
+		if idx >= fieldparams.MaxBlobsPerBlock {
+			return errIndexOutOfBounds
+		}
+		a.entry(root, epoch).entries[idx] = &archiveEntry{
+			offset: binary.LittleEndian.Uint64(r[fieldparams.RootLength+8:]),
+			length: binary.LittleEndian.Uint64(r[fieldparams.RootLength+16:]),
+		}
+	}
+	return nil
+}
+
+// entry returns the archived root, creating it if needed. The caller must hold the write lock.
+func (a *blobArchive) entry(root [32]byte, epoch primitives.Epoch) *archivedRoot {
+	ar, ok := a.roots[root]
+	if !ok {
+		ar = &archivedRoot{epoch: epoch}
+		a.roots[root] = ar
+	}
+	return ar
+}
+
+// addDir appends the ssz files of a root directory to the archive of the given epoch. Sidecars that are
+// already archived are skipped, so that a directory can be archived again when its removal failed.
+func (a *blobArchive) addDir(dir string, epoch primitives.Epoch) (int, error) {
+	root, err := rootFromString(rootFromDir(dir))
+	if err != nil {
+		return 0, err
+	}
+	entries, err := listDir(a.fs, dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+	}
+	a.Lock()
+	defer a.Unlock()
+	sidecars := make(map[uint64][]byte)
+	for _, fname := range filter(entries, filterSsz) {
+		idx, err := idxFromPath(fname)
+		if err != nil {
+			return 0, errors.Wrapf(err, "index could not be determined for blob file %s", fname)
+		}
+		if ar, ok := a.roots[root]; ok && ar.entries[idx] != nil {
+			continue
+		}
+		b, err := afero.ReadFile(a.fs, path.Join(dir, fname))
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to read blob file %s", fname)
+		}
+		sidecars[idx] = b
+	}
+	if len(sidecars) == 0 {
+		return 0, nil
+	}
+	if err := a.append(root, epoch, sidecars); err != nil {
+		return 0, errors.Wrapf(err, "unable to archive blobs of directory %s", dir)
+	}
+	blobsArchivedCounter.Add(float64(len(sidecars)))
+	return len(sidecars), nil
+}
+
+// append writes the sidecars to the data file of the epoch, then their records to the index file.
+// The caller must hold the write lock.
+func (a *blobArchive) append(root [32]byte, epoch primitives.Epoch, sidecars map[uint64][]byte) error {
+	if err := a.fs.MkdirAll(archiveDir, directoryPermissions); err != nil {
+		return err
+	}
+	data, err := a.fs.OpenFile(archiveDataPath(epoch), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open archive data file")
+	}
+	info, err := data.Stat()
+	if err != nil {
+		return closeAfter(data, err)
+	}
+	offset := uint64(info.Size())
+	added := make(map[uint64]*archiveEntry, len(sidecars))
+	records := make([]byte, 0, len(sidecars)*archiveRecordSize)
+	for idx := uint64(0); idx < fieldparams.MaxBlobsPerBlock; idx++ {
+		sc, ok := sidecars[idx]
+		if !ok {
+			continue
+		}
+		enc := snappy.Encode(nil, sc)
+		if _, err := data.Write(enc); err != nil {
+			return closeAfter(data, errors.Wrap(err, "failed to write archive data file"))
+		}
+		e := &archiveEntry{offset: offset, length: uint64(len(enc))}
+		added[idx] = e
+		offset += e.length
+		records = append(records, root[:]...)
+		records = binary.LittleEndian.AppendUint64(records, idx)
+		records = binary.LittleEndian.AppendUint64(records, e.offset)
+		records = binary.LittleEndian.AppendUint64(records, e.length)
+	}
+	if a.fsync {
+		if err := data.Sync(); err != nil {
+			return closeAfter(data, err)
+		}
+	}
+	if err := data.Close(); err != nil {
+		return err
+	}
+
+	index, err := a.fs.OpenFile(archiveIdxPath(epoch), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open archive index file")
+	}
+	if _, err := index.Write(records); err != nil {
+		return closeAfter(index, errors.Wrap(err, "failed to write archive index file"))
+	}
+	if a.fsync {
+		if err := index.Sync(); err != nil {
+			return closeAfter(index, err)
+		}
+	}
+	if err := index.Close(); err != nil {
+		return err
+	}
+
+	ar := a.entry(root, epoch)
+	for idx, e := range added {
+		ar.entries[idx] = e
+	}
+	return nil
+}
+
+// get returns the ssz encoded sidecar from the archive. The returned error wraps os.ErrNotExist when the
+// sidecar is not archived.
+func (a *blobArchive) get(root [32]byte, idx uint64) ([]byte, error) {
+	if idx >= fieldparams.MaxBlobsPerBlock {
+		return nil, errIndexOutOfBounds
+	}
+	a.RLock()
+	defer a.RUnlock()
+	ar, ok := a.roots[root]
+	if !ok || ar.entries[idx] == nil {
+		return nil, &os.PathError{Op: "lookup", Path: blobNamer{root: root, index: idx}.path(), Err: os.ErrNotExist}
+	}
+	e := ar.entries[idx]
+	f, err := a.fs.Open(archiveDataPath(ar.epoch))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close file %s", archiveDataPath(ar.epoch))
+		}
+	}()
+	enc := make([]byte, e.length)
+	if _, err := f.ReadAt(enc, int64(e.offset)); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.Wrapf(errArchivedBlobCorrupt, "record of root %#x index %d is past the end of the data file", root, idx)
+		}
+		return nil, err
+	}
+	b, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(errArchivedBlobCorrupt, err.Error())
+	}
+	return b, nil
+}
+
+// indices returns the mask of the archived sidecars of the root, and false if none is archived.
+func (a *blobArchive) indices(root [32]byte) ([fieldparams.MaxBlobsPerBlock]bool, bool) {
+	var mask [fieldparams.MaxBlobsPerBlock]bool
+	a.RLock()
+	defer a.RUnlock()
+	ar, ok := a.roots[root]
+	if !ok {
+		return mask, false
+	}
+	for i := range ar.entries {
+		mask[i] = ar.entries[i] != nil
+	}
+	return mask, true
+}
+
+// rootsInEpochs returns the archived roots from the from epoch to the to epoch, inclusive.
+func (a *blobArchive) rootsInEpochs(from, to primitives.Epoch) [][32]byte {
+	a.RLock()
+	defer a.RUnlock()
+	var roots [][32]byte
+	for root, ar := range a.roots {
+		if ar.epoch >= from && ar.epoch <= to {
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+func closeAfter(f afero.File, err error) error {
+	if closeErr := f.Close(); closeErr != nil {
+		log.WithError(closeErr).Errorf("Could not close file %s", f.Name())
+	}
+	return err
+}
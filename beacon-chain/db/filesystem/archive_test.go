@@ -0,0 +1,116 @@
+package filesystem
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/spf13/afero"
+)
+
+func ephemeralArchivalStorage(t *testing.T, fs afero.Fs, name string) *BlobStorage {
+	archive := newBlobArchive(fs, false)
+	require.NoError(t, archive.load())
+	pruner, err := newBlobPruner(fs, params.BeaconConfig().MinEpochsForBlobsSidecarsRequest, name, archive)
+	require.NoError(t, err)
+	return &BlobStorage{fs: fs, pruner: pruner, layout: pruner.layout, archive: archive}
+}
+
+func TestBlobArchive_Prune(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	for _, name := range LayoutNames {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			bs := ephemeralArchivalStorage(t, fs, name)
+			require.Equal(t, true, bs.Archival())
+			var saved []blocks.VerifiedROBlob
+			for _, slot := range []primitives.Slot{slotsPerEpoch + 1, slotsPerEpoch + 2, 3 * slotsPerEpoch} {
+				scs := testSidecarsAtSlot(t, slot, 3)
+				for _, sc := range scs {
+					require.NoError(t, bs.Save(sc))
+				}
+				saved = append(saved, scs...)
+			}
+			require.NoError(t, bs.pruner.prune(3*slotsPerEpoch))
+
+			// The blobs of epoch 1 are only left in the archive.
+			entries, err := listDir(fs, archiveDir)
+			require.NoError(t, err)
+			sort.Strings(entries)
+			require.DeepEqual(t, []string{"1." + archiveIdxExt, "1." + archiveDataExt}, entries)
+			dirs, err := bs.layout.rootDirs()
+			require.NoError(t, err)
+			require.Equal(t, 1, len(dirs))
+
+			check := func(bs *BlobStorage) {
+				for _, sc := range saved {
+					actual, err := bs.Get(sc.BlockRoot(), sc.Index)
+					require.NoError(t, err)
+					require.DeepSSZEqual(t, sc, actual)
+					indices, err := bs.Indices(sc.BlockRoot())
+					require.NoError(t, err)
+					require.Equal(t, true, indices[0] && indices[1] && indices[2])
+					require.Equal(t, false, indices[3])
+				}
+				_, err := bs.Get(saved[0].BlockRoot(), 3)
+				require.ErrorIs(t, err, os.ErrNotExist)
+			}
+			check(bs)
+			// The archive is found again after a restart.
+			restarted := ephemeralArchivalStorage(t, fs, name)
+			require.NoError(t, restarted.pruner.prune(0))
+			check(restarted)
+		})
+	}
+}
+
+func TestBlobArchive_AddDirTwice(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	bs := ephemeralArchivalStorage(t, fs, LayoutNameFlat)
+	scs := testSidecarsAtSlot(t, 1, 2)
+	for _, sc := range scs {
+		require.NoError(t, bs.Save(sc))
+	}
+	dir := rootString(scs[0].BlockRoot())
+	added, err := bs.archive.addDir(dir, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, added)
+	// Archiving a directory again, as happens when its removal failed, does not duplicate the records.
+	added, err = bs.archive.addDir(dir, 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, added)
+	idx, err := afero.ReadFile(fs, archiveIdxPath(0))
+	require.NoError(t, err)
+	require.Equal(t, 2*archiveRecordSize, len(idx))
+}
+
+func TestBlobArchive_TruncatedIndex(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	bs := ephemeralArchivalStorage(t, fs, LayoutNameFlat)
+	scs := testSidecarsAtSlot(t, 1, 1)
+	require.NoError(t, bs.Save(scs[0]))
+	_, err := bs.archive.addDir(rootString(scs[0].BlockRoot()), 0)
+	require.NoError(t, err)
+
+	// Simulate a crash in the middle of writing an index record.
+	f, err := fs.OpenFile(archiveIdxPath(0), os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	a := newBlobArchive(fs, false)
+	require.NoError(t, a.load())
+	idx, err := afero.ReadFile(fs, archiveIdxPath(0))
+	require.NoError(t, err)
+	require.Equal(t, archiveRecordSize, len(idx))
+	b, err := a.get(scs[0].BlockRoot(), 0)
+	require.NoError(t, err)
+	expected, err := scs[0].MarshalSSZ()
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, b)
+}
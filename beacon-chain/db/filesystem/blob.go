@@ -61,6 +61,24 @@ func WithSaveFsync(fsync bool) BlobStorageOption {
 	}
 }
 
+// WithLayout is an option that selects how blobs are organized on the filesystem, one of LayoutNames.
+// The flat layout is used by default.
+func WithLayout(name string) BlobStorageOption {
+	return func(b *BlobStorage) error {
+		b.layoutName = name
+		return nil
+	}
+}
+
+// WithArchival is an option that makes the pruner move the blobs that are out of the retention window to a compressed
+// per-epoch archive instead of deleting them. Archived blobs can still be read with Get and Indices.
+func WithArchival(archive bool) BlobStorageOption {
+	return func(b *BlobStorage) error {
+		b.archival = archive
+		return nil
+	}
+}
+
 // NewBlobStorage creates a new instance of the BlobStorage object. Note that the implementation of BlobStorage may
 // attempt to hold a file lock to guarantee exclusive control of the blob storage directory, so this should only be
 // initialized once per beacon node.
@@ -79,11 +97,21 @@ func NewBlobStorage(opts ...BlobStorageOption) (*BlobStorage, error) {
 		return nil, errors.Wrapf(err, "failed to create blob storage at %s", b.base)
 	}
 	b.fs = afero.NewBasePathFs(afero.NewOsFs(), b.base)
-	pruner, err := newBlobPruner(b.fs, b.retentionEpochs)
+	if b.archival {
+		b.archive = newBlobArchive(b.fs, b.fsync)
+		if err := b.archive.load(); err != nil {
+			return nil, errors.Wrapf(err, "failed to load blob archive at %s", b.base)
+		}
+	}
+	pruner, err := newBlobPruner(b.fs, b.retentionEpochs, b.layoutName, b.archive)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkLayout(b.fs, pruner.layout); err != nil {
+		return nil, errors.Wrapf(err, "failed to open blob storage at %s", b.base)
+	}
 	b.pruner = pruner
+	b.layout = pruner.layout
 	return b, nil
 }
 
@@ -92,8 +120,17 @@ type BlobStorage struct {
 	base            string
 	retentionEpochs primitives.Epoch
 	fsync           bool
+	layoutName      string
+	archival        bool
 	fs              afero.Fs
 	pruner          *blobPruner
+	layout          fsLayout
+	archive         *blobArchive
+}
+
+// Archival returns true when the blobs out of the retention window are archived instead of deleted.
+func (bs *BlobStorage) Archival() bool {
+	return bs != nil && bs.archive != nil
 }
 
 // WarmCache runs the prune routine with an expiration of slot of 0, so nothing will be pruned, but the pruner's cache
@@ -113,7 +150,8 @@ func (bs *BlobStorage) WarmCache() {
 func (bs *BlobStorage) Save(sidecar blocks.VerifiedROBlob) error {
 	startTime := time.Now()
 	fname := namerForSidecar(sidecar)
-	sszPath := fname.path()
+	dir := bs.layout.sidecarDir(sidecar.BlockRoot(), sidecar.Slot())
+	sszPath := path.Join(dir, fname.fname())
 	exists, err := afero.Exists(bs.fs, sszPath)
 	if err != nil {
 		return err
@@ -136,10 +174,10 @@ func (bs *BlobStorage) Save(sidecar blocks.VerifiedROBlob) error {
 		return errSidecarEmptySSZData
 	}
 
-	if err := bs.fs.MkdirAll(fname.dir(), directoryPermissions); err != nil {
+	if err := bs.fs.MkdirAll(dir, directoryPermissions); err != nil {
 		return err
 	}
-	partPath := fname.partPath(fmt.Sprintf("%p", sidecarData))
+	partPath := path.Join(dir, fname.partFname(fmt.Sprintf("%p", sidecarData)))
 
 	partialMoved := false
 	// Ensure the partial file is deleted.
@@ -204,9 +242,8 @@ func (bs *BlobStorage) Save(sidecar blocks.VerifiedROBlob) error {
 // value is always a VerifiedROBlob.
 func (bs *BlobStorage) Get(root [32]byte, idx uint64) (blocks.VerifiedROBlob, error) {
 	startTime := time.Now()
-	expected := blobNamer{root: root, index: idx}
-	encoded, err := afero.ReadFile(bs.fs, expected.path())
 	var v blocks.VerifiedROBlob
+	encoded, err := bs.read(root, idx)
 	if err != nil {
 		return v, err
 	}
@@ -224,10 +261,38 @@ func (bs *BlobStorage) Get(root [32]byte, idx uint64) (blocks.VerifiedROBlob, er
 	return verification.BlobSidecarNoop(ro)
 }
 
-// Remove removes all blobs for a given root.
+// read returns the ssz encoded sidecar, looking it up in the archive when it is not in the layout directories.
+func (bs *BlobStorage) read(root [32]byte, idx uint64) ([]byte, error) {
+	dir, err := bs.layout.rootDir(root)
+	if err == nil {
+		var encoded []byte
+		encoded, err = afero.ReadFile(bs.fs, path.Join(dir, blobNamer{root: root, index: idx}.fname()))
+		if err == nil {
+			return encoded, nil
+		}
+	}
+	if bs.archive == nil || !os.IsNotExist(err) {
+		return nil, err
+	}
+	return bs.archive.get(root, idx)
+}
+
+// Remove removes all blobs for a given root. Blobs that have been archived are kept.
 func (bs *BlobStorage) Remove(root [32]byte) error {
-	rootDir := blobNamer{root: root}.dir()
-	return bs.fs.RemoveAll(rootDir)
+	rootDir, err := bs.layout.rootDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if err := bs.fs.RemoveAll(rootDir); err != nil {
+		return err
+	}
+	if bs.pruner != nil {
+		bs.pruner.slotMap.evict(rootString(root))
+	}
+	return nil
 }
 
 // Indices generates a bitmap representing which BlobSidecar.Index values are present on disk for a given root.
@@ -235,11 +300,17 @@ func (bs *BlobStorage) Remove(root [32]byte) error {
 // on the network to confirm data availability.
 func (bs *BlobStorage) Indices(root [32]byte) ([fieldparams.MaxBlobsPerBlock]bool, error) {
 	var mask [fieldparams.MaxBlobsPerBlock]bool
-	rootDir := blobNamer{root: root}.dir()
+	rootDir, err := bs.layout.rootDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return bs.archivedIndices(root), nil
+		}
+		return mask, err
+	}
 	entries, err := afero.ReadDir(bs.fs, rootDir)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return mask, nil
+			return bs.archivedIndices(root), nil
 		}
 		return mask, err
 	}
@@ -267,7 +338,15 @@ func (bs *BlobStorage) Indices(root [32]byte) ([fieldparams.MaxBlobsPerBlock]boo
 	return mask, nil
 }
 
-// Clear deletes all files on the filesystem.
+func (bs *BlobStorage) archivedIndices(root [32]byte) [fieldparams.MaxBlobsPerBlock]bool {
+	if bs.archive == nil {
+		return [fieldparams.MaxBlobsPerBlock]bool{}
+	}
+	mask, _ := bs.archive.indices(root)
+	return mask
+}
+
+// Clear deletes all files on the filesystem, including the archive.
 func (bs *BlobStorage) Clear() error {
 	dirs, err := listDir(bs.fs, ".")
 	if err != nil {
@@ -278,6 +357,9 @@ func (bs *BlobStorage) Clear() error {
 			return err
 		}
 	}
+	if bs.archive != nil {
+		return bs.archive.load()
+	}
 	return nil
 }
 
@@ -294,12 +376,17 @@ func (p blobNamer) dir() string {
 	return rootString(p.root)
 }
 
-func (p blobNamer) partPath(entropy string) string {
-	return path.Join(p.dir(), fmt.Sprintf("%s-%d.%s", entropy, p.index, partExt))
+func (p blobNamer) partFname(entropy string) string {
+	return fmt.Sprintf("%s-%d.%s", entropy, p.index, partExt)
+}
+
+func (p blobNamer) fname() string {
+	return fmt.Sprintf("%d.%s", p.index, sszExt)
 }
 
+// path returns the path of the blob file in the flat layout.
 func (p blobNamer) path() string {
-	return path.Join(p.dir(), fmt.Sprintf("%d.%s", p.index, sszExt))
+	return path.Join(p.dir(), p.fname())
 }
 
 func rootString(root [32]byte) string {
@@ -247,4 +247,29 @@ func TestNewBlobStorage(t *testing.T) {
 	require.ErrorIs(t, err, errNoBasePath)
 	_, err = NewBlobStorage(WithBasePath(path.Join(t.TempDir(), "good")))
 	require.NoError(t, err)
+	_, err = NewBlobStorage(WithBasePath(t.TempDir()), WithLayout("by-root"))
+	require.ErrorIs(t, err, errInvalidLayoutName)
+}
+
+func TestNewBlobStorage_LayoutMismatch(t *testing.T) {
+	base := t.TempDir()
+	bs, err := NewBlobStorage(WithBasePath(base))
+	require.NoError(t, err)
+	_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	sc, err := verification.BlobSidecarNoop(sidecars[0])
+	require.NoError(t, err)
+	require.NoError(t, bs.Save(sc))
+
+	_, err = NewBlobStorage(WithBasePath(base), WithLayout(LayoutNameByEpoch))
+	require.ErrorIs(t, err, errLayoutMismatch)
+
+	moved, err := MigrateLayout(base, LayoutNameByEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	bs, err = NewBlobStorage(WithBasePath(base), WithLayout(LayoutNameByEpoch))
+	require.NoError(t, err)
+	require.NoError(t, bs.pruner.prune(0))
+	actual, err := bs.Get(sc.BlockRoot(), sc.Index)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, sc, actual)
 }
@@ -0,0 +1,187 @@
+package filesystem
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// exportMagic starts every blob export stream, followed by the little endian uint32 exportVersion.
+var exportMagic = []byte("PRYSMBLB")
+
+const exportVersion uint32 = 1
+
+var sidecarSSZSize = (&ethpb.BlobSidecar{}).SizeSSZ()
+
+var (
+	errNotBlobExport    = errors.New("not a blob sidecar export")
+	errExportVersion    = errors.New("unsupported blob sidecar export version")
+	errExportFrameSize  = errors.New("unexpected blob sidecar export frame size")
+	errInvalidSlotRange = errors.New("from slot is after to slot")
+)
+
+// ExportWriter writes blob sidecars to a portable stream that can be read back with an ExportReader.
+// The stream is snappy framed, and holds a header followed by a frame per sidecar, made of the little endian
+// uint32 size of the sidecar and its ssz encoding.
+type ExportWriter struct {
+	w *snappy.Writer
+}
+
+// NewExportWriter writes the stream header to w and returns an ExportWriter for the sidecars.
+func NewExportWriter(w io.Writer) (*ExportWriter, error) {
+	ew := &ExportWriter{w: snappy.NewBufferedWriter(w)}
+	header := binary.LittleEndian.AppendUint32(bytes.Clone(exportMagic), exportVersion)
+	if _, err := ew.w.Write(header); err != nil {
+		return nil, errors.Wrap(err, "could not write blob export header")
+	}
+	return ew, nil
+}
+
+// Write appends a sidecar to the stream.
+func (ew *ExportWriter) Write(sc blocks.VerifiedROBlob) error {
+	b, err := sc.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize sidecar data")
+	}
+	frame := binary.LittleEndian.AppendUint32(make([]byte, 0, 4+len(b)), uint32(len(b)))
+	if _, err := ew.w.Write(append(frame, b...)); err != nil {
+		return errors.Wrapf(err, "could not write sidecar with root=%#x index=%d", sc.BlockRoot(), sc.Index)
+	}
+	return nil
+}
+
+// Close flushes the stream. It does not close the underlying writer.
+func (ew *ExportWriter) Close() error {
+	return ew.w.Close()
+}
+
+// ExportReader reads the blob sidecars of a stream written by an ExportWriter.
+type ExportReader struct {
+	r *snappy.Reader
+}
+
+// NewExportReader checks the stream header and returns an ExportReader for the sidecars.
+func NewExportReader(r io.Reader) (*ExportReader, error) {
+	er := &ExportReader{r: snappy.NewReader(r)}
+	header := make([]byte, len(exportMagic)+4)
+	if _, err := io.ReadFull(er.r, header); err != nil {
+		return nil, errors.Wrap(errNotBlobExport, err.Error())
+	}
+	if !bytes.Equal(header[:len(exportMagic)], exportMagic) {
+		return nil, errNotBlobExport
+	}
+	if v := binary.LittleEndian.Uint32(header[len(exportMagic):]); v != exportVersion {
+		return nil, errors.Wrapf(errExportVersion, "version %d", v)
+	}
+	return er, nil
+}
+
+// Next returns the next sidecar of the stream, or io.EOF once all the sidecars have been read.
+// The sidecar has not been verified.
+func (er *ExportReader) Next() (blocks.ROBlob, error) {
+	size := make([]byte, 4)
+	if _, err := io.ReadFull(er.r, size); err != nil {
+		return blocks.ROBlob{}, err
+	}
+	if n := binary.LittleEndian.Uint32(size); int(n) != sidecarSSZSize {
+		return blocks.ROBlob{}, errors.Wrapf(errExportFrameSize, "got %d, want %d", n, sidecarSSZSize)
+	}
+	b := make([]byte, sidecarSSZSize)
+	if _, err := io.ReadFull(er.r, b); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return blocks.ROBlob{}, err
+	}
+	s := &ethpb.BlobSidecar{}
+	if err := s.UnmarshalSSZ(b); err != nil {
+		return blocks.ROBlob{}, errors.Wrap(err, "could not unmarshal exported sidecar")
+	}
+	return blocks.NewROBlob(s)
+}
+
+// Roots returns the roots of the blocks from the from slot to the to slot, inclusive, that have blobs in the
+// storage, ordered by slot. Archived blobs are included. The slot of each root is read from its first blob, so this
+// is meant for offline use, like exporting blobs.
+func (bs *BlobStorage) Roots(from, to primitives.Slot) ([][32]byte, error) {
+	if from > to {
+		return nil, errors.Wrapf(errInvalidSlotRange, "from=%d, to=%d", from, to)
+	}
+	type slotRoot struct {
+		slot primitives.Slot
+		root [32]byte
+	}
+	var found []slotRoot
+	seen := make(map[[32]byte]bool)
+	dirs, err := bs.layout.rootDirs()
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range dirs {
+		scFiles, err := listDir(bs.fs, dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+		}
+		scFiles = filter(scFiles, filterSsz)
+		if len(scFiles) == 0 {
+			continue
+		}
+		slot, err := slotFromFile(path.Join(dir, scFiles[0]), bs.fs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
+		}
+		root, err := rootFromString(rootFromDir(dir))
+		if err != nil {
+			return nil, err
+		}
+		seen[root] = true
+		if slot >= from && slot <= to {
+			found = append(found, slotRoot{slot: slot, root: root})
+		}
+	}
+	if bs.archive != nil {
+		for _, root := range bs.archive.rootsInEpochs(slots.ToEpoch(from), slots.ToEpoch(to)) {
+			if seen[root] {
+				continue
+			}
+			mask, _ := bs.archive.indices(root)
+			for idx := range mask {
+				if !mask[idx] {
+					continue
+				}
+				b, err := bs.archive.get(root, uint64(idx))
+				if err != nil {
+					return nil, err
+				}
+				slot, err := slotFromBlob(bytes.NewReader(b))
+				if err != nil {
+					return nil, errors.Wrapf(err, "slot could not be read from archived blob of root %#x", root)
+				}
+				if slot >= from && slot <= to {
+					found = append(found, slotRoot{slot: slot, root: root})
+				}
+				break
+			}
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].slot == found[j].slot {
+			return bytes.Compare(found[i].root[:], found[j].root[:]) < 0
+		}
+		return found[i].slot < found[j].slot
+	})
+	roots := make([][32]byte, len(found))
+	for i := range found {
+		roots[i] = found[i].root
+	}
+	return roots, nil
+}
@@ -0,0 +1,67 @@
+package filesystem
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/spf13/afero"
+)
+
+func TestExportReaderWriter(t *testing.T) {
+	var saved []blocks.VerifiedROBlob
+	for _, slot := range []primitives.Slot{1, 2} {
+		saved = append(saved, testSidecarsAtSlot(t, slot, 2)...)
+	}
+	buf := &bytes.Buffer{}
+	w, err := NewExportWriter(buf)
+	require.NoError(t, err)
+	for _, sc := range saved {
+		require.NoError(t, w.Write(sc))
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewExportReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	for _, sc := range saved {
+		actual, err := r.Next()
+		require.NoError(t, err)
+		require.Equal(t, sc.BlockRoot(), actual.BlockRoot())
+		require.DeepSSZEqual(t, sc.BlobSidecar, actual.BlobSidecar)
+	}
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	_, err = NewExportReader(bytes.NewReader([]byte("not an export")))
+	require.ErrorIs(t, err, errNotBlobExport)
+}
+
+func TestBlobStorage_Roots(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	fs := afero.NewMemMapFs()
+	bs := ephemeralArchivalStorage(t, fs, LayoutNameByEpoch)
+	var roots [][32]byte
+	for _, slot := range []primitives.Slot{3*slotsPerEpoch + 1, 1, slotsPerEpoch + 5, 2} {
+		sc := testSidecarsAtSlot(t, slot, 1)[0]
+		require.NoError(t, bs.Save(sc))
+		roots = append(roots, sc.BlockRoot())
+	}
+	// Archive the blobs of epoch 0.
+	require.NoError(t, bs.pruner.prune(slotsPerEpoch))
+
+	found, err := bs.Roots(0, 3*slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][32]byte{roots[1], roots[3], roots[2], roots[0]}, found)
+	found, err = bs.Roots(2, slotsPerEpoch+5)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][32]byte{roots[3], roots[2]}, found)
+	found, err = bs.Roots(slotsPerEpoch+6, 3*slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(found))
+	_, err = bs.Roots(2, 1)
+	require.ErrorIs(t, err, errInvalidSlotRange)
+}
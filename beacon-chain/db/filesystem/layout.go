@@ -0,0 +1,98 @@
+package filesystem
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/spf13/afero"
+)
+
+const (
+	// LayoutNameFlat stores the blobs of each block root in a directory at the top of the blob storage path,
+	// ie <root>/<index>.ssz. Pruning needs to know the slot of every root directory.
+	LayoutNameFlat = "flat"
+	// LayoutNameByEpoch groups the block root directories by the epoch of the block, ie <epoch>/<root>/<index>.ssz,
+	// so that pruning an epoch is a single directory removal.
+	LayoutNameByEpoch = "by-epoch"
+)
+
+// LayoutNames lists the supported blob storage layouts.
+var LayoutNames = []string{LayoutNameFlat, LayoutNameByEpoch}
+
+var (
+	errInvalidLayoutName = errors.New("unknown blob storage layout")
+	errLayoutMismatch    = errors.New("blob storage directory uses a different layout")
+)
+
+// fsLayout is the backend of BlobStorage, it determines how the blob sidecar files are organized on the filesystem.
+// The layouts share the root->slot cache of the pruner, which lets them find the directory of a root without
+// touching the filesystem.
+type fsLayout interface {
+	// name returns the name the layout is selected with, one of LayoutNames.
+	name() string
+	// sidecarDir returns the directory where the sidecars of the block with the given root and slot are saved.
+	sidecarDir(root [32]byte, slot primitives.Slot) string
+	// rootDir returns the directory holding the saved sidecars of the given root. The returned error wraps
+	// os.ErrNotExist when the layout does not know about any sidecar for the root.
+	rootDir(root [32]byte) (string, error)
+	// rootDirs lists the directories of all the roots present in the storage.
+	rootDirs() ([]string, error)
+	// prune removes the sidecars of the blocks older than pruneBefore and returns the number of sidecar files
+	// removed. A call with pruneBefore == 0 removes nothing and populates the cache from the filesystem.
+	prune(pruneBefore primitives.Slot) (int, error)
+}
+
+// newLayout returns the layout with the given name. When archive is not nil, the layout moves the blobs to the
+// archive instead of deleting them when they are pruned.
+func newLayout(name string, fs afero.Fs, cache *slotForRoot, archive *blobArchive) (fsLayout, error) {
+	switch name {
+	case LayoutNameFlat, "":
+		return &flatLayout{fs: fs, cache: cache, archive: archive}, nil
+	case LayoutNameByEpoch:
+		return &epochLayout{fs: fs, cache: cache, archive: archive}, nil
+	default:
+		return nil, errors.Wrapf(errInvalidLayoutName, "%s, valid layouts are %v", name, LayoutNames)
+	}
+}
+
+// detectLayout returns the name of the layout of the blob storage by looking at the top level directories.
+// An empty name is returned when the storage is empty.
+func detectLayout(fs afero.Fs) (string, error) {
+	entries, err := listDir(fs, ".")
+	if err != nil {
+		return "", errors.Wrap(err, "unable to list root blobs directory")
+	}
+	for _, e := range entries {
+		if filterRoot(e) {
+			return LayoutNameFlat, nil
+		}
+		if filterEpoch(e) {
+			return LayoutNameByEpoch, nil
+		}
+	}
+	return "", nil
+}
+
+// checkLayout returns an error if the storage already contains blobs organized with another layout.
+func checkLayout(fs afero.Fs, l fsLayout) error {
+	detected, err := detectLayout(fs)
+	if err != nil {
+		return err
+	}
+	if detected != "" && detected != l.name() {
+		return errors.Wrapf(errLayoutMismatch, "found %s layout but %s is configured, "+
+			"the blobs can be converted with `prysmctl db migrate-blobs`", detected, l.name())
+	}
+	return nil
+}
+
+func epochString(epoch primitives.Epoch) string {
+	return fmt.Sprintf("%d", epoch)
+}
+
+func filterEpoch(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
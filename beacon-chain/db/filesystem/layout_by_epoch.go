@@ -0,0 +1,209 @@
+package filesystem
+
+import (
+	"os"
+	"path"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/spf13/afero"
+)
+
+// epochLayout stores the blobs of a root in the <epoch>/<root>/<index>.ssz files, where epoch is the epoch of the block.
+// The epoch of a root is looked up in the cache, which is populated when the cache is warmed up and when blobs are saved.
+// Until the cache is warm, the roots missing from the cache are searched in the epoch directories.
+type epochLayout struct {
+	fs      afero.Fs
+	cache   *slotForRoot
+	archive *blobArchive
+	warm    atomic.Bool
+}
+
+var _ fsLayout = &epochLayout{}
+
+func (*epochLayout) name() string {
+	return LayoutNameByEpoch
+}
+
+func (*epochLayout) sidecarDir(root [32]byte, slot primitives.Slot) string {
+	return path.Join(epochString(slots.ToEpoch(slot)), rootString(root))
+}
+
+func (l *epochLayout) rootDir(root [32]byte) (string, error) {
+	if slot, ok := l.cache.slot(rootString(root)); ok {
+		return l.sidecarDir(root, slot), nil
+	}
+	if l.warm.Load() {
+		return "", &os.PathError{Op: "lookup", Path: rootString(root), Err: os.ErrNotExist}
+	}
+	return l.findRootDir(root)
+}
+
+// findRootDir searches the epoch directories for the directory of a root missing from the cache, and caches the
+// root when it is found.
+func (l *epochLayout) findRootDir(root [32]byte) (string, error) {
+	epochs, err := l.epochDirs()
+	if err != nil {
+		return "", err
+	}
+	for _, e := range epochs {
+		dir := path.Join(e, rootString(root))
+		exists, err := afero.DirExists(l.fs, dir)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not check blob directory %s", dir)
+		}
+		if !exists {
+			continue
+		}
+		epoch, err := strconv.ParseUint(e, 10, 64)
+		if err != nil {
+			return "", errors.Wrapf(err, "unexpected epoch directory %s", e)
+		}
+		slot, err := slots.EpochStart(primitives.Epoch(epoch))
+		if err != nil {
+			return "", errors.Wrapf(err, "could not compute start slot of epoch directory %s", e)
+		}
+		if err := l.cacheRoot(dir, slot); err != nil {
+			return "", err
+		}
+		return dir, nil
+	}
+	return "", &os.PathError{Op: "lookup", Path: rootString(root), Err: os.ErrNotExist}
+}
+
+func (l *epochLayout) rootDirs() ([]string, error) {
+	epochs, err := l.epochDirs()
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	for _, e := range epochs {
+		entries, err := listDir(l.fs, e)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list blobs in directory %s", e)
+		}
+		for _, r := range filter(entries, filterRoot) {
+			dirs = append(dirs, path.Join(e, r))
+		}
+	}
+	return dirs, nil
+}
+
+// prune removes whole epoch directories, without having to look at the blob files they contain.
+func (l *epochLayout) prune(pruneBefore primitives.Slot) (int, error) {
+	epochs, err := l.epochDirs()
+	if err != nil {
+		return 0, err
+	}
+	if pruneBefore == 0 {
+		if err := l.warmCache(epochs); err != nil {
+			return 0, err
+		}
+		l.warm.Store(true)
+		return 0, nil
+	}
+	before := slots.ToEpoch(pruneBefore)
+	totalPruned, totalErr := 0, 0
+	for _, dir := range epochs {
+		epoch, err := strconv.ParseUint(dir, 10, 64)
+		if err != nil {
+			return totalPruned, errors.Wrapf(err, "unexpected epoch directory %s", dir)
+		}
+		if primitives.Epoch(epoch) >= before {
+			continue
+		}
+		pruned, err := l.pruneEpochDir(dir, primitives.Epoch(epoch))
+		if err != nil {
+			totalErr += 1
+			log.WithError(err).WithField("directory", dir).Error("Unable to prune directory")
+		}
+		totalPruned += pruned
+	}
+	if totalErr > 0 {
+		return totalPruned, errors.Wrapf(errPruningFailures, "pruning failed for %d epoch directories", totalErr)
+	}
+	return totalPruned, nil
+}
+
+// pruneEpochDir removes an epoch directory, after moving its blobs to the archive in archival mode, and returns
+// the number of blobs that were cached for its roots.
+func (l *epochLayout) pruneEpochDir(dir string, epoch primitives.Epoch) (int, error) {
+	roots, err := listDir(l.fs, dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+	}
+	if l.archive != nil {
+		for _, root := range filter(roots, filterRoot) {
+			if _, err := l.archive.addDir(path.Join(dir, root), epoch); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := l.fs.RemoveAll(dir); err != nil {
+		return 0, errors.Wrapf(err, "unable to remove blob directory %s", dir)
+	}
+	pruned := 0
+	for _, root := range filter(roots, filterRoot) {
+		pruned += l.cache.evict(root)
+	}
+	return pruned, nil
+}
+
+// warmCache records the epoch and the indices of every root in the cache. The slot of a root is recorded
+// as the first slot of its epoch, which is all the layout needs to find the root directory.
+func (l *epochLayout) warmCache(epochs []string) error {
+	totalErr := 0
+	for _, dir := range epochs {
+		epoch, err := strconv.ParseUint(dir, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "unexpected epoch directory %s", dir)
+		}
+		slot, err := slots.EpochStart(primitives.Epoch(epoch))
+		if err != nil {
+			return errors.Wrapf(err, "could not compute start slot of epoch directory %s", dir)
+		}
+		roots, err := listDir(l.fs, dir)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+		}
+		for _, root := range filter(roots, filterRoot) {
+			if err := l.cacheRoot(path.Join(dir, root), slot); err != nil {
+				totalErr += 1
+				log.WithError(err).WithField("directory", path.Join(dir, root)).Error("Unable to cache blob directory")
+			}
+		}
+	}
+	if totalErr > 0 {
+		return errors.Errorf("could not cache %d root directories", totalErr)
+	}
+	return nil
+}
+
+func (l *epochLayout) cacheRoot(dir string, slot primitives.Slot) error {
+	entries, err := listDir(l.fs, dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+	}
+	root := rootFromDir(dir)
+	for _, fname := range filter(entries, filterSsz) {
+		idx, err := idxFromPath(fname)
+		if err != nil {
+			return errors.Wrapf(err, "index could not be determined for blob file %s", fname)
+		}
+		if err := l.cache.ensure(root, slot, idx); err != nil {
+			return errors.Wrapf(err, "could not update prune cache for blob file %s", fname)
+		}
+	}
+	return nil
+}
+
+func (l *epochLayout) epochDirs() ([]string, error) {
+	entries, err := listDir(l.fs, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list root blobs directory")
+	}
+	return filter(entries, filterEpoch), nil
+}
@@ -0,0 +1,136 @@
+package filesystem
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/spf13/afero"
+)
+
+// flatLayout stores the blobs of a root in the <root>/<index>.ssz files, directly under the storage base path.
+type flatLayout struct {
+	fs      afero.Fs
+	cache   *slotForRoot
+	archive *blobArchive
+}
+
+var _ fsLayout = &flatLayout{}
+
+func (*flatLayout) name() string {
+	return LayoutNameFlat
+}
+
+func (*flatLayout) sidecarDir(root [32]byte, _ primitives.Slot) string {
+	return rootString(root)
+}
+
+func (*flatLayout) rootDir(root [32]byte) (string, error) {
+	return rootString(root), nil
+}
+
+func (l *flatLayout) rootDirs() ([]string, error) {
+	entries, err := listDir(l.fs, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list root blobs directory")
+	}
+	return filter(entries, filterRoot), nil
+}
+
+// prune walks all the root directories, as the slot of a root is only known from its blob files.
+func (l *flatLayout) prune(pruneBefore primitives.Slot) (int, error) {
+	dirs, err := l.rootDirs()
+	if err != nil {
+		return 0, err
+	}
+	totalPruned, totalErr := 0, 0
+	for _, dir := range dirs {
+		pruned, err := l.tryPruneDir(dir, pruneBefore)
+		if err != nil {
+			totalErr += 1
+			log.WithError(err).WithField("directory", dir).Error("Unable to prune directory")
+		}
+		totalPruned += pruned
+	}
+
+	if totalErr > 0 {
+		return totalPruned, errors.Wrapf(errPruningFailures, "pruning failed for %d root directories", totalErr)
+	}
+	return totalPruned, nil
+}
+
+func shouldRetain(slot, pruneBefore primitives.Slot) bool {
+	return slot >= pruneBefore
+}
+
+// tryPruneDir removes the directory of a root if its blobs are older than pruneBefore, after moving them to the
+// archive in archival mode. The slot of the root is read from the first blob file when it is not cached yet.
+func (l *flatLayout) tryPruneDir(dir string, pruneBefore primitives.Slot) (int, error) {
+	root := rootFromDir(dir)
+	slot, slotCached := l.cache.slot(root)
+	// Return early if the slot is cached and doesn't need pruning.
+	if slotCached && shouldRetain(slot, pruneBefore) {
+		return 0, nil
+	}
+
+	// entries will include things that aren't ssz files, like dangling .part files. We need these to
+	// completely clean up the directory.
+	entries, err := listDir(l.fs, dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+	}
+	// scFiles filters the dir listing down to the ssz encoded BlobSidecar files. This allows us to peek
+	// at the first one in the list to figure out the slot.
+	scFiles := filter(entries, filterSsz)
+	if len(scFiles) == 0 {
+		log.WithField("dir", dir).Warn("Pruner ignoring directory with no blob files")
+		return 0, nil
+	}
+	if !slotCached {
+		slot, err = slotFromFile(path.Join(dir, scFiles[0]), l.fs)
+		if err != nil {
+			return 0, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
+		}
+		for i := range scFiles {
+			idx, err := idxFromPath(scFiles[i])
+			if err != nil {
+				return 0, errors.Wrapf(err, "index could not be determined for blob file %s", scFiles[i])
+			}
+			if err := l.cache.ensure(root, slot, idx); err != nil {
+				return 0, errors.Wrapf(err, "could not update prune cache for blob file %s", scFiles[i])
+			}
+		}
+		if shouldRetain(slot, pruneBefore) {
+			return 0, nil
+		}
+	}
+
+	if l.archive != nil {
+		if _, err := l.archive.addDir(dir, slots.ToEpoch(slot)); err != nil {
+			return 0, err
+		}
+	}
+
+	removed := 0
+	for _, fname := range entries {
+		fullName := path.Join(dir, fname)
+		if err := l.fs.Remove(fullName); err != nil {
+			return removed, errors.Wrapf(err, "unable to remove %s", fullName)
+		}
+		// Don't count other files that happen to be in the dir, like dangling .part files.
+		if filterSsz(fname) {
+			removed += 1
+		}
+		// Log a warning whenever we clean up a .part file
+		if filterPart(fullName) {
+			log.WithField("file", fullName).Warn("Deleting abandoned blob .part file")
+		}
+	}
+	if err := l.fs.Remove(dir); err != nil {
+		return removed, errors.Wrapf(err, "unable to remove blob directory %s", dir)
+	}
+
+	l.cache.evict(rootFromDir(dir))
+	return len(scFiles), nil
+}
@@ -0,0 +1,172 @@
+package filesystem
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/spf13/afero"
+)
+
+func ephemeralLayoutStorage(t *testing.T, fs afero.Fs, name string) *BlobStorage {
+	pruner, err := newBlobPruner(fs, params.BeaconConfig().MinEpochsForBlobsSidecarsRequest, name, nil)
+	require.NoError(t, err)
+	return &BlobStorage{fs: fs, pruner: pruner, layout: pruner.layout}
+}
+
+func testSidecarsAtSlot(t *testing.T, slot primitives.Slot, count int) []blocks.VerifiedROBlob {
+	root := bytesutil.ToBytes32(bytesutil.ToBytes(uint64(slot), 32))
+	_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, root, slot, count)
+	scs, err := verification.BlobSidecarSliceNoop(sidecars)
+	require.NoError(t, err)
+	return scs
+}
+
+func TestNewLayout(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	l, err := newLayout("", fs, newSlotForRoot(), nil)
+	require.NoError(t, err)
+	require.Equal(t, LayoutNameFlat, l.name())
+	for _, name := range LayoutNames {
+		l, err := newLayout(name, fs, newSlotForRoot(), nil)
+		require.NoError(t, err)
+		require.Equal(t, name, l.name())
+	}
+	_, err = newLayout("by-root", fs, newSlotForRoot(), nil)
+	require.ErrorIs(t, err, errInvalidLayoutName)
+}
+
+func TestEpochLayout_SaveGetRemove(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	bs := ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	scs := testSidecarsAtSlot(t, 300, 3)
+	for _, sc := range scs {
+		require.NoError(t, bs.Save(sc))
+	}
+	root := scs[0].BlockRoot()
+	dir := path.Join("9", rootString(root))
+	for _, sc := range scs {
+		exists, err := afero.Exists(fs, path.Join(dir, blobNamer{root: root, index: sc.Index}.fname()))
+		require.NoError(t, err)
+		require.Equal(t, true, exists)
+
+		actual, err := bs.Get(root, sc.Index)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, sc, actual)
+	}
+	indices, err := bs.Indices(root)
+	require.NoError(t, err)
+	for i := range indices {
+		require.Equal(t, i < len(scs), indices[i])
+	}
+
+	require.NoError(t, bs.Remove(root))
+	_, err = bs.Get(root, 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	indices, err = bs.Indices(root)
+	require.NoError(t, err)
+	require.Equal(t, [fieldparams.MaxBlobsPerBlock]bool{}, indices)
+	exists, err := afero.Exists(fs, dir)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	// Removing an unknown root is not an error.
+	require.NoError(t, bs.Remove(root))
+}
+
+func TestEpochLayout_Prune(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	bs := ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var saved []blocks.VerifiedROBlob
+	for _, epoch := range []primitives.Slot{1, 2, 5} {
+		scs := testSidecarsAtSlot(t, epoch*slotsPerEpoch+1, 2)
+		for _, sc := range scs {
+			require.NoError(t, bs.Save(sc))
+		}
+		saved = append(saved, scs[0])
+	}
+
+	pruned, err := bs.layout.prune(5 * slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 4, pruned)
+
+	epochs, err := listDir(fs, ".")
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{"5"}, epochs)
+	for _, sc := range saved[:2] {
+		_, ok := bs.pruner.slotMap.slot(rootString(sc.BlockRoot()))
+		require.Equal(t, false, ok)
+		_, err := bs.Get(sc.BlockRoot(), sc.Index)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+	_, err = bs.Get(saved[2].BlockRoot(), saved[2].Index)
+	require.NoError(t, err)
+}
+
+func TestEpochLayout_WarmCache(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	bs := ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	scs := testSidecarsAtSlot(t, 100, 2)
+	for _, sc := range scs {
+		require.NoError(t, bs.Save(sc))
+	}
+
+	// A new storage over the same directory finds the blobs before the cache is warm.
+	restarted := ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	actual, err := restarted.Get(scs[0].BlockRoot(), scs[0].Index)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, scs[0], actual)
+	_, ok := restarted.pruner.slotMap.slot(rootString(scs[0].BlockRoot()))
+	require.Equal(t, true, ok)
+	unknown := [32]byte{0xff}
+	_, err = restarted.Get(unknown, 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	restarted = ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	require.NoError(t, restarted.pruner.prune(0))
+	_, err = restarted.Get(unknown, 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	for _, sc := range scs {
+		actual, err := restarted.Get(sc.BlockRoot(), sc.Index)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, sc, actual)
+	}
+	indices, err := restarted.Indices(scs[0].BlockRoot())
+	require.NoError(t, err)
+	require.Equal(t, true, indices[0])
+	require.Equal(t, true, indices[1])
+	require.Equal(t, false, indices[2])
+}
+
+func TestCheckLayout(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	flat := ephemeralLayoutStorage(t, fs, LayoutNameFlat)
+	byEpoch := ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	detected, err := detectLayout(fs)
+	require.NoError(t, err)
+	require.Equal(t, "", detected)
+	require.NoError(t, checkLayout(fs, flat.layout))
+	require.NoError(t, checkLayout(fs, byEpoch.layout))
+
+	require.NoError(t, flat.Save(testSidecarsAtSlot(t, 1, 1)[0]))
+	detected, err = detectLayout(fs)
+	require.NoError(t, err)
+	require.Equal(t, LayoutNameFlat, detected)
+	require.NoError(t, checkLayout(fs, flat.layout))
+	require.ErrorIs(t, checkLayout(fs, byEpoch.layout), errLayoutMismatch)
+
+	require.NoError(t, flat.Clear())
+	require.NoError(t, byEpoch.Save(testSidecarsAtSlot(t, 1, 1)[0]))
+	detected, err = detectLayout(fs)
+	require.NoError(t, err)
+	require.Equal(t, LayoutNameByEpoch, detected)
+	require.ErrorIs(t, checkLayout(fs, flat.layout), errLayoutMismatch)
+}
@@ -21,6 +21,10 @@ var (
 		Name: "blob_pruned",
 		Help: "Number of BlobSidecar files pruned.",
 	})
+	blobsArchivedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blob_archived",
+		Help: "Number of BlobSidecar files moved to the archive when pruned.",
+	})
 	blobsWrittenCounter = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "blob_written",
 		Help: "Number of BlobSidecar files written",
@@ -0,0 +1,112 @@
+package filesystem
+
+import (
+	"path"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/spf13/afero"
+)
+
+// MigrateLayout moves the blobs stored under base into the given layout, one of LayoutNames, and returns the
+// number of block roots that were moved. Root directories found in any other layout are moved, so an interrupted
+// migration can be resumed by running it again. It must not be used while a beacon node is using the blob storage.
+func MigrateLayout(base, to string) (int, error) {
+	if base == "" {
+		return 0, errNoBasePath
+	}
+	return migrateLayout(afero.NewBasePathFs(afero.NewOsFs(), path.Clean(base)), to)
+}
+
+func migrateLayout(fs afero.Fs, to string) (int, error) {
+	cache := newSlotForRoot()
+	dst, err := newLayout(to, fs, cache, nil)
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	for _, name := range LayoutNames {
+		if name == dst.name() {
+			continue
+		}
+		src, err := newLayout(name, fs, cache, nil)
+		if err != nil {
+			return moved, err
+		}
+		dirs, err := src.rootDirs()
+		if err != nil {
+			return moved, errors.Wrapf(err, "could not list the root directories of the %s layout", name)
+		}
+		for _, dir := range dirs {
+			ok, err := migrateRootDir(fs, dir, dst)
+			if err != nil {
+				return moved, errors.Wrapf(err, "could not migrate blob directory %s", dir)
+			}
+			if ok {
+				moved += 1
+			}
+			if err := removeIfEmpty(fs, path.Dir(dir)); err != nil {
+				return moved, err
+			}
+		}
+	}
+	return moved, nil
+}
+
+// migrateRootDir moves the blob files of a root directory to their place in the dst layout, and removes the
+// directory. Leftover .part files are deleted. It returns false when the directory did not hold any blob.
+func migrateRootDir(fs afero.Fs, dir string, dst fsLayout) (bool, error) {
+	entries, err := listDir(fs, dir)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+	}
+	scFiles := filter(entries, filterSsz)
+	if len(scFiles) == 0 {
+		return false, fs.RemoveAll(dir)
+	}
+	root, err := rootFromString(rootFromDir(dir))
+	if err != nil {
+		return false, err
+	}
+	slot, err := slotFromFile(path.Join(dir, scFiles[0]), fs)
+	if err != nil {
+		return false, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
+	}
+	target := dst.sidecarDir(root, slot)
+	if err := fs.MkdirAll(target, directoryPermissions); err != nil {
+		return false, err
+	}
+	for _, fname := range scFiles {
+		if err := fs.Rename(path.Join(dir, fname), path.Join(target, fname)); err != nil {
+			return false, errors.Wrapf(err, "unable to move blob file %s", fname)
+		}
+	}
+	return true, fs.RemoveAll(dir)
+}
+
+func removeIfEmpty(fs afero.Fs, dir string) error {
+	if dir == "." {
+		return nil
+	}
+	entries, err := listDir(fs, dir)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return nil
+	}
+	return fs.Remove(dir)
+}
+
+func rootFromString(s string) ([32]byte, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "invalid root directory name %s", s)
+	}
+	if len(b) != fieldparams.RootLength {
+		return [32]byte{}, errors.Errorf("invalid root directory name %s, want %d bytes", s, fieldparams.RootLength)
+	}
+	return bytesutil.ToBytes32(b), nil
+}
@@ -0,0 +1,68 @@
+package filesystem
+
+import (
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/spf13/afero"
+)
+
+func TestMigrateLayout(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	flat := ephemeralLayoutStorage(t, fs, LayoutNameFlat)
+	var saved []blocks.VerifiedROBlob
+	for _, slot := range []primitives.Slot{1, 33, 34, 100} {
+		scs := testSidecarsAtSlot(t, slot, 2)
+		for _, sc := range scs {
+			require.NoError(t, flat.Save(sc))
+		}
+		saved = append(saved, scs...)
+	}
+	// Directories without blobs, like those left behind by an interrupted save, are dropped.
+	dangling := rootString([32]byte{'d'})
+	require.NoError(t, fs.MkdirAll(dangling, directoryPermissions))
+	require.NoError(t, afero.WriteFile(fs, path.Join(dangling, "0xdeadbeef-0.part"), []byte("derp"), 0600))
+
+	moved, err := migrateLayout(fs, LayoutNameByEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 4, moved)
+	entries, err := listDir(fs, ".")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+	require.Equal(t, 3, len(filter(entries, filterEpoch)))
+
+	byEpoch := ephemeralLayoutStorage(t, fs, LayoutNameByEpoch)
+	require.NoError(t, checkLayout(fs, byEpoch.layout))
+	require.NoError(t, byEpoch.pruner.prune(0))
+	for _, sc := range saved {
+		actual, err := byEpoch.Get(sc.BlockRoot(), sc.Index)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, sc, actual)
+	}
+
+	// Migrating to the current layout is a no-op.
+	moved, err = migrateLayout(fs, LayoutNameByEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 0, moved)
+
+	moved, err = migrateLayout(fs, LayoutNameFlat)
+	require.NoError(t, err)
+	require.Equal(t, 4, moved)
+	entries, err = listDir(fs, ".")
+	require.NoError(t, err)
+	require.Equal(t, 4, len(filter(entries, filterRoot)))
+	require.Equal(t, 0, len(filter(entries, filterEpoch)))
+
+	flat = ephemeralLayoutStorage(t, fs, LayoutNameFlat)
+	for _, sc := range saved {
+		actual, err := flat.Get(sc.BlockRoot(), sc.Index)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, sc, actual)
+	}
+
+	_, err = migrateLayout(fs, "by-root")
+	require.ErrorIs(t, err, errInvalidLayoutName)
+}
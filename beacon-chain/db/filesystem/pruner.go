@@ -33,15 +33,20 @@ type blobPruner struct {
 	prunedBefore atomic.Uint64
 	windowSize   primitives.Slot
 	slotMap      *slotForRoot
-	fs           afero.Fs
+	layout       fsLayout
 }
 
-func newBlobPruner(fs afero.Fs, retain primitives.Epoch) (*blobPruner, error) {
+func newBlobPruner(fs afero.Fs, retain primitives.Epoch, layoutName string, archive *blobArchive) (*blobPruner, error) {
 	r, err := slots.EpochStart(retain + retentionBuffer)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not set retentionSlots")
 	}
-	return &blobPruner{fs: fs, windowSize: r, slotMap: newSlotForRoot()}, nil
+	slotMap := newSlotForRoot()
+	layout, err := newLayout(layoutName, fs, slotMap, archive)
+	if err != nil {
+		return nil, err
+	}
+	return &blobPruner{windowSize: r, slotMap: slotMap, layout: layout}, nil
 }
 
 // notify updates the pruner's view of root->blob mappings. This allows the pruner to build a cache
@@ -78,7 +83,7 @@ func (p *blobPruner) prune(pruneBefore primitives.Slot) error {
 	p.Lock()
 	defer p.Unlock()
 	start := time.Now()
-	totalPruned, totalErr := 0, 0
+	totalPruned := 0
 	// Customize logging/metrics behavior for the initial cache warmup when slot=0.
 	// We'll never see a prune request for slot 0, unless this is the initial call to warm up the cache.
 	if pruneBefore == 0 {
@@ -96,91 +101,9 @@ func (p *blobPruner) prune(pruneBefore primitives.Slot) error {
 		}()
 	}
 
-	entries, err := listDir(p.fs, ".")
-	if err != nil {
-		return errors.Wrap(err, "unable to list root blobs directory")
-	}
-	dirs := filter(entries, filterRoot)
-	for _, dir := range dirs {
-		pruned, err := p.tryPruneDir(dir, pruneBefore)
-		if err != nil {
-			totalErr += 1
-			log.WithError(err).WithField("directory", dir).Error("Unable to prune directory")
-		}
-		totalPruned += pruned
-	}
-
-	if totalErr > 0 {
-		return errors.Wrapf(errPruningFailures, "pruning failed for %d root directories", totalErr)
-	}
-	return nil
-}
-
-func shouldRetain(slot, pruneBefore primitives.Slot) bool {
-	return slot >= pruneBefore
-}
-
-func (p *blobPruner) tryPruneDir(dir string, pruneBefore primitives.Slot) (int, error) {
-	root := rootFromDir(dir)
-	slot, slotCached := p.slotMap.slot(root)
-	// Return early if the slot is cached and doesn't need pruning.
-	if slotCached && shouldRetain(slot, pruneBefore) {
-		return 0, nil
-	}
-
-	// entries will include things that aren't ssz files, like dangling .part files. We need these to
-	// completely clean up the directory.
-	entries, err := listDir(p.fs, dir)
-	if err != nil {
-		return 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
-	}
-	// scFiles filters the dir listing down to the ssz encoded BlobSidecar files. This allows us to peek
-	// at the first one in the list to figure out the slot.
-	scFiles := filter(entries, filterSsz)
-	if len(scFiles) == 0 {
-		log.WithField("dir", dir).Warn("Pruner ignoring directory with no blob files")
-		return 0, nil
-	}
-	if !slotCached {
-		slot, err = slotFromFile(path.Join(dir, scFiles[0]), p.fs)
-		if err != nil {
-			return 0, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
-		}
-		for i := range scFiles {
-			idx, err := idxFromPath(scFiles[i])
-			if err != nil {
-				return 0, errors.Wrapf(err, "index could not be determined for blob file %s", scFiles[i])
-			}
-			if err := p.slotMap.ensure(root, slot, idx); err != nil {
-				return 0, errors.Wrapf(err, "could not update prune cache for blob file %s", scFiles[i])
-			}
-		}
-		if shouldRetain(slot, pruneBefore) {
-			return 0, nil
-		}
-	}
-
-	removed := 0
-	for _, fname := range entries {
-		fullName := path.Join(dir, fname)
-		if err := p.fs.Remove(fullName); err != nil {
-			return removed, errors.Wrapf(err, "unable to remove %s", fullName)
-		}
-		// Don't count other files that happen to be in the dir, like dangling .part files.
-		if filterSsz(fname) {
-			removed += 1
-		}
-		// Log a warning whenever we clean up a .part file
-		if filterPart(fullName) {
-			log.WithField("file", fullName).Warn("Deleting abandoned blob .part file")
-		}
-	}
-	if err := p.fs.Remove(dir); err != nil {
-		return removed, errors.Wrapf(err, "unable to remove blob directory %s", dir)
-	}
-
-	p.slotMap.evict(rootFromDir(dir))
-	return len(scFiles), nil
+	var err error
+	totalPruned, err = p.layout.prune(pruneBefore)
+	return err
 }
 
 func idxFromPath(fname string) (uint64, error) {
@@ -322,7 +245,8 @@ func (s *slotForRoot) slot(key string) (primitives.Slot, bool) {
 	return v.slot, ok
 }
 
-func (s *slotForRoot) evict(key string) {
+// evict removes the root from the cache, and returns the number of blobs that were cached for it.
+func (s *slotForRoot) evict(key string) int {
 	s.Lock()
 	defer s.Unlock()
 	v, ok := s.cache[key]
@@ -336,4 +260,5 @@ func (s *slotForRoot) evict(key string) {
 		s.updateMetrics(-deleted)
 	}
 	delete(s.cache, key)
+	return int(deleted)
 }
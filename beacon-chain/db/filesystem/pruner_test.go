@@ -19,7 +19,7 @@ import (
 
 func TestTryPruneDir_CachedNotExpired(t *testing.T) {
 	fs := afero.NewMemMapFs()
-	pr, err := newBlobPruner(fs, 0)
+	pr, err := newBlobPruner(fs, 0, LayoutNameFlat, nil)
 	require.NoError(t, err)
 	slot := pr.windowSize
 	_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, slot, fieldparams.MaxBlobsPerBlock)
@@ -29,7 +29,7 @@ func TestTryPruneDir_CachedNotExpired(t *testing.T) {
 	// This slot is right on the edge of what would need to be pruned, so by adding it to the cache and
 	// skipping any other test setup, we can be certain the hot cache path never touches the filesystem.
 	require.NoError(t, pr.slotMap.ensure(root, sc.Slot(), 0))
-	pruned, err := pr.tryPruneDir(root, pr.windowSize)
+	pruned, err := pr.layout.(*flatLayout).tryPruneDir(root, pr.windowSize)
 	require.NoError(t, err)
 	require.Equal(t, 0, pruned)
 }
@@ -37,7 +37,7 @@ func TestTryPruneDir_CachedNotExpired(t *testing.T) {
 func TestTryPruneDir_CachedExpired(t *testing.T) {
 	t.Run("empty directory", func(t *testing.T) {
 		fs := afero.NewMemMapFs()
-		pr, err := newBlobPruner(fs, 0)
+		pr, err := newBlobPruner(fs, 0, LayoutNameFlat, nil)
 		require.NoError(t, err)
 		var slot primitives.Slot = 0
 		_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, slot, 1)
@@ -46,7 +46,7 @@ func TestTryPruneDir_CachedExpired(t *testing.T) {
 		root := fmt.Sprintf("%#x", sc.BlockRoot())
 		require.NoError(t, fs.Mkdir(root, directoryPermissions)) // make empty directory
 		require.NoError(t, pr.slotMap.ensure(root, sc.Slot(), 0))
-		pruned, err := pr.tryPruneDir(root, slot+1)
+		pruned, err := pr.layout.(*flatLayout).tryPruneDir(root, slot+1)
 		require.NoError(t, err)
 		require.Equal(t, 0, pruned)
 	})
@@ -72,7 +72,7 @@ func TestTryPruneDir_CachedExpired(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 2, len(files))
 
-		pruned, err := bs.pruner.tryPruneDir(root, slot+1)
+		pruned, err := bs.pruner.layout.(*flatLayout).tryPruneDir(root, slot+1)
 		require.NoError(t, err)
 		require.Equal(t, 2, pruned)
 		files, err = listDir(fs, root)
@@ -108,7 +108,7 @@ func TestTryPruneDir_SlotFromFile(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 2, len(files))
 
-		pruned, err := bs.pruner.tryPruneDir(root, slot+1)
+		pruned, err := bs.pruner.layout.(*flatLayout).tryPruneDir(root, slot+1)
 		require.NoError(t, err)
 		require.Equal(t, 2, pruned)
 		files, err = listDir(fs, root)
@@ -140,7 +140,7 @@ func TestTryPruneDir_SlotFromFile(t *testing.T) {
 
 		// This should use the slotFromFile code (simulating restart).
 		// Setting pruneBefore == slot, so that the slot will be outside the window (at the boundary).
-		pruned, err := bs.pruner.tryPruneDir(root, slot)
+		pruned, err := bs.pruner.layout.(*flatLayout).tryPruneDir(root, slot)
 		require.NoError(t, err)
 		require.Equal(t, 0, pruned)
 
@@ -14,8 +14,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
@@ -41,6 +43,7 @@ type ReadOnlyDatabase interface {
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot primitives.Slot) ([]state.ReadOnlyBeaconState, error)
+	StateDiffInterval() primitives.Slot
 	// Checkpoint operations.
 	JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
 	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
@@ -60,6 +63,17 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillStatus(context.Context) (*dbval.BackfillStatus, error)
+
+	// Light client operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, int, error)
+	LightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*ethpbv2.LightClientBootstrap, int, error)
+
+	// Validator rewards history operations.
+	ValidatorRewards(ctx context.Context, indices []primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch) ([]*validator.EpochRewards, error)
+	LastIndexedRewardsEpoch(ctx context.Context) (primitives.Epoch, error)
+
+	// Builder bid audit operations.
+	BuilderBidAudit(ctx context.Context, slot primitives.Slot) (*ethpb.BuilderBidAudit, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -77,6 +91,7 @@ type NoHeadAccessDatabase interface {
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
+	SaveStateDiff(ctx context.Context, slot primitives.Slot, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*ethpb.StateSummary) error
 	// Checkpoint operations.
@@ -92,6 +107,16 @@ type NoHeadAccessDatabase interface {
 	// Fee recipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, v int, update *ethpbv2.LightClientUpdate) error
+	DeleteLightClientUpdatesBefore(ctx context.Context, period uint64) error
+	SaveLightClientBootstrap(ctx context.Context, blockRoot [32]byte, v int, bootstrap *ethpbv2.LightClientBootstrap) error
+	DeleteLightClientBootstrapsBefore(ctx context.Context, slot primitives.Slot) error
+	// Validator rewards history operations.
+	SaveValidatorRewards(ctx context.Context, epoch primitives.Epoch, rewards []*validator.EpochRewards) error
+	DeleteValidatorRewardsBefore(ctx context.Context, epoch primitives.Epoch) error
+	// Builder bid audit operations.
+	SaveBuilderBidAudit(ctx context.Context, audit *ethpb.BuilderBidAudit) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
 }
@@ -134,6 +159,7 @@ type SlasherDatabase interface {
 	LastEpochWrittenForValidators(
 		ctx context.Context, validatorIndices []primitives.ValidatorIndex,
 	) ([]*slashertypes.AttestedEpochForValidator, error)
+	HighestLastEpochWritten(ctx context.Context) (primitives.Epoch, bool, error)
 	AttestationRecordForValidator(
 		ctx context.Context, validatorIdx primitives.ValidatorIndex, targetEpoch primitives.Epoch,
 	) (*slashertypes.IndexedAttestationWrapper, error)
@@ -159,6 +185,10 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SaveAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error
+	AttesterSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.AttesterSlashing, error)
+	SaveProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error
+	ProposerSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.ProposerSlashing, error)
 	DatabasePath() string
 	ClearDB() error
 }
@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ErrNotFoundBuilderBidAudit is a not found error specifically for the builder bid audit getter.
+var ErrNotFoundBuilderBidAudit = errors.Wrap(ErrNotFound, "builder bid audit")
+
+// SaveBuilderBidAudit saves the record of the builder bids of a proposal slot, replacing any record previously
+// stored for that slot.
+func (s *Store) SaveBuilderBidAudit(ctx context.Context, audit *ethpb.BuilderBidAudit) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBuilderBidAudit")
+	defer span.End()
+
+	enc, err := encode(ctx, audit)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(builderBidAuditsBucket).Put(bytesutil.SlotToBytesBigEndian(primitives.Slot(audit.Slot)), enc)
+	})
+}
+
+// BuilderBidAudit returns the record of the builder bids of the proposal slot.
+func (s *Store) BuilderBidAudit(ctx context.Context, slot primitives.Slot) (*ethpb.BuilderBidAudit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BuilderBidAudit")
+	defer span.End()
+
+	audit := &ethpb.BuilderBidAudit{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(builderBidAuditsBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		if enc == nil {
+			return errors.Wrapf(ErrNotFoundBuilderBidAudit, "slot %d", slot)
+		}
+		return decode(ctx, enc, audit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return audit, nil
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_BuilderBidAudit(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, err := db.BuilderBidAudit(ctx, 10)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	audit := &ethpb.BuilderBidAudit{
+		Slot:          10,
+		ProposerIndex: 3,
+		Bids: []*ethpb.BuilderBidRecord{
+			{Relay: "https://relay-a.example.com", Value: []byte{1}, BlockHash: []byte{'a'}, GasLimit: 30_000_000},
+			{Relay: "https://relay-b.example.com", Error: "no bid"},
+		},
+	}
+	require.NoError(t, db.SaveBuilderBidAudit(ctx, audit))
+	got, err := db.BuilderBidAudit(ctx, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, audit, got)
+
+	audit.BuilderChosen = true
+	audit.BlindedBlockSubmitted = true
+	require.NoError(t, db.SaveBuilderBidAudit(ctx, audit))
+	got, err = db.BuilderBidAudit(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, true, got.BlindedBlockSubmitted)
+}
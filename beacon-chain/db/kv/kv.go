@@ -89,6 +89,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	stateDiffExponents  []uint64
 	ctx                 context.Context
 }
 
@@ -118,6 +119,17 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+
+	lightClientUpdatesBucket,
+	lightClientBootstrapsBucket,
+	lightClientBootstrapSlotIndicesBucket,
+
+	validatorRewardsBucket,
+
+	stateDiffBucket,
+	stateDiffRootsBucket,
+
+	builderBidAuditsBucket,
 }
 
 // KVStoreOption is a functional option that modifies a kv.Store.
@@ -182,6 +194,12 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 	for _, o := range opts {
 		o(kv)
 	}
+	if err := validateStateDiffExponents(kv.stateDiffExponents); err != nil {
+		if closeErr := boltDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close database")
+		}
+		return nil, err
+	}
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(tx, Buckets...)
 	}); err != nil {
@@ -0,0 +1,192 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SaveLightClientUpdate saves the best known light client update for the given sync committee period,
+// replacing any update previously stored for that period. Choosing which update is the best one is
+// the responsibility of the caller.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, v int, update *ethpbv2.LightClientUpdate) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	enc, err := encodeLightClientObject(v, update)
+	if err != nil {
+		return errors.Wrap(err, "could not encode light client update")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(lightClientUpdatesBucket).Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+}
+
+// LightClientUpdate returns the light client update stored for the given sync committee period along with
+// the fork version of the update. A nil update is returned if there is no update stored for the period.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(lightClientUpdatesBucket).Get(bytesutil.Uint64ToBytesBigEndian(period)))
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	if enc == nil {
+		return nil, 0, nil
+	}
+	update := &ethpbv2.LightClientUpdate{}
+	v, err := decodeLightClientObject(enc, update)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not decode light client update for period %d", period)
+	}
+	return update, v, nil
+}
+
+// DeleteLightClientUpdatesBefore deletes all light client updates for sync committee periods
+// strictly lower than the given period.
+func (s *Store) DeleteLightClientUpdatesBefore(ctx context.Context, period uint64) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteLightClientUpdatesBefore")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) < period; k, _ = c.Next() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SaveLightClientBootstrap saves the light client bootstrap object for the given block root.
+func (s *Store) SaveLightClientBootstrap(ctx context.Context, blockRoot [32]byte, v int, bootstrap *ethpbv2.LightClientBootstrap) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientBootstrap")
+	defer span.End()
+
+	if bootstrap.Header == nil {
+		return errors.New("light client bootstrap has no header")
+	}
+	enc, err := encodeLightClientObject(v, bootstrap)
+	if err != nil {
+		return errors.Wrap(err, "could not encode light client bootstrap")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(lightClientBootstrapsBucket).Put(blockRoot[:], enc); err != nil {
+			return err
+		}
+		return tx.Bucket(lightClientBootstrapSlotIndicesBucket).Put(bytesutil.SlotToBytesBigEndian(bootstrap.Header.Slot), blockRoot[:])
+	})
+}
+
+// LightClientBootstrap returns the light client bootstrap object stored for the given block root along with
+// its fork version. A nil bootstrap is returned if there is no bootstrap stored for the block root.
+func (s *Store) LightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*ethpbv2.LightClientBootstrap, int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientBootstrap")
+	defer span.End()
+
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(lightClientBootstrapsBucket).Get(blockRoot[:]))
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	if enc == nil {
+		return nil, 0, nil
+	}
+	bootstrap := &ethpbv2.LightClientBootstrap{}
+	v, err := decodeLightClientObject(enc, bootstrap)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not decode light client bootstrap for root %#x", blockRoot)
+	}
+	return bootstrap, v, nil
+}
+
+// DeleteLightClientBootstrapsBefore deletes all light client bootstraps whose header slot
+// is strictly lower than the given slot.
+func (s *Store) DeleteLightClientBootstrapsBefore(ctx context.Context, slot primitives.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteLightClientBootstrapsBefore")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(lightClientBootstrapsBucket)
+		c := tx.Bucket(lightClientBootstrapSlotIndicesBucket).Cursor()
+		for k, root := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k) < slot; k, root = c.Next() {
+			if err := bkt.Delete(root); err != nil {
+				return err
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// encodeLightClientObject prefixes the proto encoding of a light client object with the key of
+// the fork it belongs to, so that the fork version can be recovered when serving the object.
+func encodeLightClientObject(v int, msg proto.Message) ([]byte, error) {
+	key, err := keyForLightClientObject(v)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	dbfmt := make([]byte, len(key)+len(enc))
+	copy(dbfmt, key)
+	copy(dbfmt[len(key):], enc)
+	return snappy.Encode(nil, dbfmt), nil
+}
+
+func decodeLightClientObject(enc []byte, dst proto.Message) (int, error) {
+	enc, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not snappy decode light client object")
+	}
+	var v int
+	var key []byte
+	switch {
+	case hasAltairKey(enc):
+		v, key = version.Altair, altairKey
+	case hasBellatrixKey(enc):
+		v, key = version.Bellatrix, bellatrixKey
+	case hasCapellaKey(enc):
+		v, key = version.Capella, capellaKey
+	case hasDenebKey(enc):
+		v, key = version.Deneb, denebKey
+	default:
+		return 0, errors.New("light client object has no fork key")
+	}
+	return v, proto.Unmarshal(enc[len(key):], dst)
+}
+
+func keyForLightClientObject(v int) ([]byte, error) {
+	switch v {
+	case version.Altair:
+		return altairKey, nil
+	case version.Bellatrix:
+		return bellatrixKey, nil
+	case version.Capella:
+		return capellaKey, nil
+	case version.Deneb:
+		return denebKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported light client version: %s", version.String(v))
+	}
+}
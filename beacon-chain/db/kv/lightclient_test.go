@@ -0,0 +1,111 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testLightClientHeader(slot primitives.Slot) *ethpbv1.BeaconBlockHeader {
+	return &ethpbv1.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: 1,
+		ParentRoot:    bytesutil.PadTo([]byte("parent"), 32),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		BodyRoot:      bytesutil.PadTo([]byte("body"), 32),
+	}
+}
+
+func TestStore_LightClientUpdate_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	update := &ethpbv2.LightClientUpdate{
+		AttestedHeader: testLightClientHeader(100),
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      []byte{0xFF},
+			SyncCommitteeSignature: bytesutil.PadTo([]byte("sig"), 96),
+		},
+		SignatureSlot: 101,
+	}
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, version.Capella, update))
+
+	retrieved, v, err := db.LightClientUpdate(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, version.Capella, v)
+	assert.Equal(t, true, proto.Equal(update, retrieved), "Wanted %v, received %v", update, retrieved)
+
+	// Saving a new update for the same period replaces the old one.
+	update.SignatureSlot = 102
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, version.Deneb, update))
+	retrieved, v, err = db.LightClientUpdate(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, version.Deneb, v)
+	assert.Equal(t, primitives.Slot(102), retrieved.SignatureSlot)
+
+	retrieved, _, err = db.LightClientUpdate(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpbv2.LightClientUpdate)(nil), retrieved)
+}
+
+func TestStore_LightClientUpdate_UnsupportedVersion(t *testing.T) {
+	db := setupDB(t)
+	err := db.SaveLightClientUpdate(context.Background(), 1, version.Phase0, &ethpbv2.LightClientUpdate{})
+	require.ErrorContains(t, "unsupported light client version", err)
+}
+
+func TestStore_DeleteLightClientUpdatesBefore(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for period := uint64(1); period <= 5; period++ {
+		update := &ethpbv2.LightClientUpdate{AttestedHeader: testLightClientHeader(primitives.Slot(period))}
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, version.Altair, update))
+	}
+	require.NoError(t, db.DeleteLightClientUpdatesBefore(ctx, 3))
+
+	for period := uint64(1); period <= 5; period++ {
+		update, _, err := db.LightClientUpdate(ctx, period)
+		require.NoError(t, err)
+		assert.Equal(t, period >= 3, update != nil, "unexpected presence of update for period %d", period)
+	}
+}
+
+func TestStore_LightClientBootstrap_CanSaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	roots := [][32]byte{bytesutil.ToBytes32([]byte{'A'}), bytesutil.ToBytes32([]byte{'B'})}
+	for i, root := range roots {
+		bootstrap := &ethpbv2.LightClientBootstrap{
+			Header: testLightClientHeader(primitives.Slot(32 * (i + 1))),
+			CurrentSyncCommittee: &ethpbv2.SyncCommittee{
+				Pubkeys:         [][]byte{bytesutil.PadTo([]byte{byte(i)}, 48)},
+				AggregatePubkey: bytesutil.PadTo([]byte("agg"), 48),
+			},
+			CurrentSyncCommitteeBranch: [][]byte{bytesutil.PadTo([]byte("branch"), 32)},
+		}
+		require.NoError(t, db.SaveLightClientBootstrap(ctx, root, version.Bellatrix, bootstrap))
+
+		retrieved, v, err := db.LightClientBootstrap(ctx, root)
+		require.NoError(t, err)
+		assert.Equal(t, version.Bellatrix, v)
+		assert.Equal(t, true, proto.Equal(bootstrap, retrieved), "Wanted %v, received %v", bootstrap, retrieved)
+	}
+
+	require.NoError(t, db.DeleteLightClientBootstrapsBefore(ctx, 64))
+	retrieved, _, err := db.LightClientBootstrap(ctx, roots[0])
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpbv2.LightClientBootstrap)(nil), retrieved)
+	retrieved, _, err = db.LightClientBootstrap(ctx, roots[1])
+	require.NoError(t, err)
+	assert.NotNil(t, retrieved)
+}
@@ -0,0 +1,151 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Rewards are stored under epoch + validator index keys, so that an epoch is written sequentially
+// and old epochs are pruned by deleting a contiguous range of keys.
+const validatorRewardsEncodedLength = 6 * 8
+
+// SaveValidatorRewards saves the rewards validators received for the given epoch, and marks the epoch
+// as indexed if it is the most recent one.
+func (s *Store) SaveValidatorRewards(ctx context.Context, epoch primitives.Epoch, rewards []*validator.EpochRewards) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorRewards")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorRewardsBucket)
+		for _, r := range rewards {
+			if r.Epoch != epoch {
+				return errors.Errorf("rewards of validator %d are for epoch %d, expected %d", r.ValidatorIndex, r.Epoch, epoch)
+			}
+			if err := bkt.Put(validatorRewardsKey(epoch, r.ValidatorIndex), encodeValidatorRewards(r)); err != nil {
+				return err
+			}
+		}
+		metadataBkt := tx.Bucket(chainMetadataBucket)
+		if last := metadataBkt.Get(lastIndexedRewardsEpochKey); last != nil && bytesutil.BytesToEpochBigEndian(last) >= epoch {
+			return nil
+		}
+		return metadataBkt.Put(lastIndexedRewardsEpochKey, bytesutil.EpochToBytesBigEndian(epoch))
+	})
+}
+
+// ValidatorRewards returns the rewards stored for the given validators between the start and end epochs, inclusive.
+// Results are sorted by epoch, then by validator index. Epochs or validators which were not indexed are omitted.
+func (s *Store) ValidatorRewards(
+	ctx context.Context,
+	indices []primitives.ValidatorIndex,
+	startEpoch, endEpoch primitives.Epoch,
+) ([]*validator.EpochRewards, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ValidatorRewards")
+	defer span.End()
+
+	if startEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is greater than end epoch %d", startEpoch, endEpoch)
+	}
+	sorted := make([]primitives.ValidatorIndex, len(indices))
+	copy(sorted, indices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rewards := make([]*validator.EpochRewards, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorRewardsBucket)
+		for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+			for i, idx := range sorted {
+				if i > 0 && sorted[i-1] == idx {
+					continue
+				}
+				enc := bkt.Get(validatorRewardsKey(epoch, idx))
+				if enc == nil {
+					continue
+				}
+				r, err := decodeValidatorRewards(enc)
+				if err != nil {
+					return errors.Wrapf(err, "could not decode rewards of validator %d at epoch %d", idx, epoch)
+				}
+				r.Epoch = epoch
+				r.ValidatorIndex = idx
+				rewards = append(rewards, r)
+			}
+			// Avoid overflowing when the end epoch is the maximum epoch.
+			if epoch == endEpoch {
+				break
+			}
+		}
+		return nil
+	})
+	return rewards, err
+}
+
+// LastIndexedRewardsEpoch returns the most recent epoch for which validator rewards were saved.
+func (s *Store) LastIndexedRewardsEpoch(ctx context.Context) (primitives.Epoch, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastIndexedRewardsEpoch")
+	defer span.End()
+
+	var epoch primitives.Epoch
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(lastIndexedRewardsEpochKey)
+		if enc == nil {
+			return errors.Wrap(ErrNotFound, "no indexed rewards epoch")
+		}
+		epoch = bytesutil.BytesToEpochBigEndian(enc)
+		return nil
+	})
+	return epoch, err
+}
+
+// DeleteValidatorRewardsBefore deletes the validator rewards of all epochs strictly lower than the given epoch.
+func (s *Store) DeleteValidatorRewardsBefore(ctx context.Context, epoch primitives.Epoch) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteValidatorRewardsBefore")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(validatorRewardsBucket).Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToEpochBigEndian(k[:8]) < epoch; k, _ = c.Next() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func validatorRewardsKey(epoch primitives.Epoch, idx primitives.ValidatorIndex) []byte {
+	return append(bytesutil.EpochToBytesBigEndian(epoch), bytesutil.Uint64ToBytesBigEndian(uint64(idx))...)
+}
+
+func encodeValidatorRewards(r *validator.EpochRewards) []byte {
+	enc := make([]byte, validatorRewardsEncodedLength)
+	for i, v := range []int64{r.Head, r.Source, r.Target, r.Inactivity, r.Proposer, r.SyncCommittee} {
+		binary.BigEndian.PutUint64(enc[i*8:], uint64(v)) // lint:ignore uintcast -- Two's complement encoding of signed values.
+	}
+	return enc
+}
+
+func decodeValidatorRewards(enc []byte) (*validator.EpochRewards, error) {
+	if len(enc) != validatorRewardsEncodedLength {
+		return nil, errors.Errorf("invalid encoded rewards length %d", len(enc))
+	}
+	field := func(i int) int64 {
+		return int64(binary.BigEndian.Uint64(enc[i*8:])) // lint:ignore uintcast -- Two's complement encoding of signed values.
+	}
+	return &validator.EpochRewards{
+		Head:          field(0),
+		Source:        field(1),
+		Target:        field(2),
+		Inactivity:    field(3),
+		Proposer:      field(4),
+		SyncCommittee: field(5),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func testEpochRewards(epoch primitives.Epoch, idx primitives.ValidatorIndex) *validator.EpochRewards {
+	return &validator.EpochRewards{
+		ValidatorIndex: idx,
+		Epoch:          epoch,
+		Head:           int64(idx) * 10,
+		Source:         -int64(epoch),
+		Target:         15,
+		Inactivity:     -3,
+		Proposer:       1000,
+		SyncCommittee:  -42,
+	}
+}
+
+func TestStore_ValidatorRewards_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.LastIndexedRewardsEpoch(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		require.NoError(t, db.SaveValidatorRewards(ctx, epoch, []*validator.EpochRewards{
+			testEpochRewards(epoch, 1),
+			testEpochRewards(epoch, 2),
+			testEpochRewards(epoch, 5),
+		}))
+	}
+	last, err := db.LastIndexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(3), last)
+
+	// Re-indexing an older epoch does not move the last indexed epoch backwards.
+	require.NoError(t, db.SaveValidatorRewards(ctx, 1, []*validator.EpochRewards{testEpochRewards(1, 1)}))
+	last, err = db.LastIndexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(3), last)
+
+	rewards, err := db.ValidatorRewards(ctx, []primitives.ValidatorIndex{5, 1, 3, 1}, 2, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*validator.EpochRewards{
+		testEpochRewards(2, 1),
+		testEpochRewards(2, 5),
+		testEpochRewards(3, 1),
+		testEpochRewards(3, 5),
+	}, rewards)
+
+	_, err = db.ValidatorRewards(ctx, []primitives.ValidatorIndex{1}, 3, 2)
+	require.ErrorContains(t, "start epoch 3 is greater than end epoch 2", err)
+}
+
+func TestStore_SaveValidatorRewards_WrongEpoch(t *testing.T) {
+	db := setupDB(t)
+	err := db.SaveValidatorRewards(context.Background(), 2, []*validator.EpochRewards{testEpochRewards(3, 1)})
+	require.ErrorContains(t, "rewards of validator 1 are for epoch 3, expected 2", err)
+}
+
+func TestStore_DeleteValidatorRewardsBefore(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		require.NoError(t, db.SaveValidatorRewards(ctx, epoch, []*validator.EpochRewards{testEpochRewards(epoch, 1)}))
+	}
+	require.NoError(t, db.DeleteValidatorRewardsBefore(ctx, 3))
+	rewards, err := db.ValidatorRewards(ctx, []primitives.ValidatorIndex{1}, 0, 3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*validator.EpochRewards{testEpochRewards(3, 1)}, rewards)
+}
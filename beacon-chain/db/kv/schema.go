@@ -17,6 +17,21 @@ var (
 	feeRecipientBucket    = []byte("fee-recipient")
 	registrationBucket    = []byte("registration")
 
+	// Light client buckets.
+	lightClientUpdatesBucket              = []byte("light-client-updates")
+	lightClientBootstrapsBucket           = []byte("light-client-bootstraps")
+	lightClientBootstrapSlotIndicesBucket = []byte("light-client-bootstrap-slot-indices")
+
+	// Validator rewards history, indexed by epoch and validator index.
+	validatorRewardsBucket = []byte("validator-rewards")
+
+	// Hierarchical state diffs, indexed by slot, and the slot of the state diff of a block root.
+	stateDiffBucket      = []byte("state-diffs")
+	stateDiffRootsBucket = []byte("state-diff-roots")
+
+	// Builder bids received for the proposals of the node, indexed by slot.
+	builderBidAuditsBucket = []byte("builder-bid-audits")
+
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
@@ -37,6 +52,7 @@ var (
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	lastIndexedRewardsEpochKey = []byte("last-indexed-rewards-epoch")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -0,0 +1,394 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The state diff storage keeps historical states in a hierarchy of slot intervals, configured as powers of two from
+// the coarsest level to the finest one. The states at the points of the coarsest level are stored as full snapshots,
+// and the state at a point of a finer level is stored as a diff against the state of the nearest point of a coarser
+// level, so that any state is rebuilt from a snapshot and at most one diff per level.
+//
+// A state is split in the ssz encoding of the fields that do not grow with the validator registry, and one part per
+// validators, balances, participation and inactivity scores list. A diff holds, for each part, its length and the
+// snappy compressed xor of the part with the same part of the base state. The values that did not change between the
+// two states xor to runs of zeros, which compress to almost nothing.
+
+const (
+	stateDiffSnapshot byte = iota
+	stateDiffXor
+)
+
+const (
+	statePartRest = iota
+	statePartValidators
+	statePartBalances
+	statePartPreviousParticipation
+	statePartCurrentParticipation
+	statePartInactivityScores
+	numStateParts
+)
+
+// stateDiffHeaderSize is the size of the kind, base slot and state version that start a state diff entry.
+const stateDiffHeaderSize = 1 + 8 + 1
+
+var validatorSSZSize = (&ethpb.Validator{}).SizeSSZ()
+
+var (
+	errStateDiffDisabled     = errors.New("state diff storage is disabled")
+	errNotStateDiffSlot      = errors.New("slot is not a point of the state diff hierarchy")
+	errInvalidStateDiffLevel = errors.New("state diff exponents must be strictly decreasing and below 64")
+	errStateDiffCorrupt      = errors.New("state diff entry is corrupt")
+)
+
+type stateParts [numStateParts][]byte
+
+// WithStateDiffExponents enables the hierarchical state diff storage, with the slot intervals of its levels given as
+// powers of two, from the coarsest to the finest.
+func WithStateDiffExponents(exponents []uint64) KVStoreOption {
+	return func(s *Store) {
+		s.stateDiffExponents = exponents
+	}
+}
+
+func validateStateDiffExponents(exponents []uint64) error {
+	for i, e := range exponents {
+		if e >= 64 || (i > 0 && e >= exponents[i-1]) {
+			return errors.Wrapf(errInvalidStateDiffLevel, "got %v", exponents)
+		}
+	}
+	return nil
+}
+
+// StateDiffInterval returns the number of slots between the points of the finest level of the state diff
+// hierarchy, or 0 when the state diff storage is disabled.
+func (s *Store) StateDiffInterval() primitives.Slot {
+	if len(s.stateDiffExponents) == 0 {
+		return 0
+	}
+	return primitives.Slot(1) << s.stateDiffExponents[len(s.stateDiffExponents)-1]
+}
+
+// stateDiffLevel returns the coarsest level of the hierarchy the slot is a point of, or -1 if it is not a point.
+func (s *Store) stateDiffLevel(slot primitives.Slot) int {
+	for i, e := range s.stateDiffExponents {
+		if slot%(primitives.Slot(1)<<e) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// SaveStateDiff saves the state of the block root at the given point of the state diff hierarchy. The state is
+// stored as a diff against the nearest point of a coarser level, or as a snapshot when the slot is a point of the
+// coarsest level or when no coarser point is stored. The state is then returned by State and HasState for the block
+// root.
+func (s *Store) SaveStateDiff(ctx context.Context, slot primitives.Slot, st state.ReadOnlyBeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+	if len(s.stateDiffExponents) == 0 {
+		return errStateDiffDisabled
+	}
+	level := s.stateDiffLevel(slot)
+	if level < 0 {
+		return errors.Wrapf(errNotStateDiffSlot, "slot %d", slot)
+	}
+	parts, err := splitState(st)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		kind, baseSlot := stateDiffSnapshot, slot
+		var base stateParts
+		for l := level - 1; l >= 0; l-- {
+			bs := slot - slot%(primitives.Slot(1)<<s.stateDiffExponents[l])
+			if bkt.Get(bytesutil.SlotToBytesBigEndian(bs)) == nil {
+				continue
+			}
+			_, base, err = stateDiffParts(ctx, bkt, bs)
+			if err != nil {
+				return err
+			}
+			kind, baseSlot = stateDiffXor, bs
+			break
+		}
+		enc := encodeStateDiff(kind, baseSlot, st.Version(), parts, base)
+		key := bytesutil.SlotToBytesBigEndian(slot)
+		if err := bkt.Put(key, enc); err != nil {
+			return err
+		}
+		return tx.Bucket(stateDiffRootsBucket).Put(blockRoot[:], key)
+	})
+}
+
+// hasStateDiff returns true if a state diff is stored for the block root.
+func hasStateDiff(tx *bolt.Tx, blockRoot [32]byte) bool {
+	return len(tx.Bucket(stateDiffRootsBucket).Get(blockRoot[:])) > 0
+}
+
+// stateDiffState rebuilds the state of the block root from the state diff storage. It returns nil if no state diff
+// is stored for the block root.
+func (s *Store) stateDiffState(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateDiffState")
+	defer span.End()
+	var v int
+	var parts stateParts
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		key := tx.Bucket(stateDiffRootsBucket).Get(blockRoot[:])
+		if len(key) == 0 {
+			return nil
+		}
+		found = true
+		var err error
+		v, parts, err = stateDiffParts(ctx, tx.Bucket(stateDiffBucket), bytesutil.BytesToSlotBigEndian(key))
+		return err
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return joinState(v, parts)
+}
+
+// stateDiffParts returns the state version and the parts of the state at the slot, by applying the diffs of its
+// entry and of its bases down to the snapshot.
+func stateDiffParts(ctx context.Context, bkt *bolt.Bucket, slot primitives.Slot) (int, stateParts, error) {
+	if ctx.Err() != nil {
+		return 0, stateParts{}, ctx.Err()
+	}
+	enc := bkt.Get(bytesutil.SlotToBytesBigEndian(slot))
+	if len(enc) == 0 {
+		return 0, stateParts{}, errors.Wrap(ErrNotFoundState, fmt.Sprintf("no state diff at slot %d", slot))
+	}
+	if len(enc) < stateDiffHeaderSize {
+		return 0, stateParts{}, errors.Wrapf(errStateDiffCorrupt, "slot %d", slot)
+	}
+	kind, baseSlot, v := enc[0], primitives.Slot(binary.LittleEndian.Uint64(enc[1:9])), int(enc[9])
+	var base stateParts
+	switch kind {
+	case stateDiffSnapshot:
+	case stateDiffXor:
+		// Bases are always at an earlier slot, which also rules out reference cycles in a corrupt database.
+		if baseSlot >= slot {
+			return 0, stateParts{}, errors.Wrapf(errStateDiffCorrupt, "slot %d has base slot %d", slot, baseSlot)
+		}
+		var err error
+		_, base, err = stateDiffParts(ctx, bkt, baseSlot)
+		if err != nil {
+			return 0, stateParts{}, errors.Wrapf(err, "could not read base of state diff at slot %d", slot)
+		}
+	default:
+		return 0, stateParts{}, errors.Wrapf(errStateDiffCorrupt, "slot %d has unknown kind %d", slot, kind)
+	}
+	parts, err := decodeStateDiff(enc[stateDiffHeaderSize:], base)
+	if err != nil {
+		return 0, stateParts{}, errors.Wrapf(err, "slot %d", slot)
+	}
+	return v, parts, nil
+}
+
+// encodeStateDiff encodes the header of a state diff entry followed by, for each part, the little endian uint32
+// length of the part, the uint32 length of the compressed xor of the part with its base, and the compressed xor.
+func encodeStateDiff(kind byte, baseSlot primitives.Slot, v int, parts, base stateParts) []byte {
+	enc := make([]byte, 0, stateDiffHeaderSize)
+	enc = append(enc, kind)
+	enc = binary.LittleEndian.AppendUint64(enc, uint64(baseSlot))
+	enc = append(enc, byte(v))
+	for i := range parts {
+		c := snappy.Encode(nil, xorBytes(parts[i], base[i], len(parts[i])))
+		enc = binary.LittleEndian.AppendUint32(enc, uint32(len(parts[i])))
+		enc = binary.LittleEndian.AppendUint32(enc, uint32(len(c)))
+		enc = append(enc, c...)
+	}
+	return enc
+}
+
+func decodeStateDiff(enc []byte, base stateParts) (stateParts, error) {
+	var parts stateParts
+	for i := range parts {
+		if len(enc) < 8 {
+			return stateParts{}, errStateDiffCorrupt
+		}
+		size, clen := binary.LittleEndian.Uint32(enc), binary.LittleEndian.Uint32(enc[4:])
+		enc = enc[8:]
+		if uint64(len(enc)) < uint64(clen) {
+			return stateParts{}, errStateDiffCorrupt
+		}
+		x, err := snappy.Decode(nil, enc[:clen])
+		if err != nil {
+			return stateParts{}, errors.Wrap(errStateDiffCorrupt, err.Error())
+		}
+		if len(x) != int(size) {
+			return stateParts{}, errors.Wrapf(errStateDiffCorrupt, "part %d has size %d, want %d", i, len(x), size)
+		}
+		parts[i] = xorBytes(x, base[i], len(x))
+		enc = enc[clen:]
+	}
+	return parts, nil
+}
+
+// xorBytes returns a new slice of the given size, holding the xor of a and b, with the missing bytes of the shorter
+// slices taken as zeros.
+func xorBytes(a, b []byte, size int) []byte {
+	out := make([]byte, size)
+	copy(out, a)
+	for i := 0; i < size && i < len(b); i++ {
+		out[i] ^= b[i]
+	}
+	return out
+}
+
+// splitFields are the fields of a state that are stored in their own part.
+type splitFields struct {
+	validators            []*ethpb.Validator
+	balances              []uint64
+	previousParticipation []byte
+	currentParticipation  []byte
+	inactivityScores      []uint64
+}
+
+func splitState(st state.ReadOnlyBeaconState) (stateParts, error) {
+	var parts stateParts
+	var f splitFields
+	var err error
+	switch p := st.ToProto().(type) {
+	case *ethpb.BeaconState:
+		f = splitFields{validators: p.Validators, balances: p.Balances}
+		p.Validators, p.Balances = nil, nil
+		parts[statePartRest], err = p.MarshalSSZ()
+	case *ethpb.BeaconStateAltair:
+		f = splitFields{p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores = nil, nil, nil, nil, nil
+		parts[statePartRest], err = p.MarshalSSZ()
+	case *ethpb.BeaconStateBellatrix:
+		f = splitFields{p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores = nil, nil, nil, nil, nil
+		parts[statePartRest], err = p.MarshalSSZ()
+	case *ethpb.BeaconStateCapella:
+		f = splitFields{p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores = nil, nil, nil, nil, nil
+		parts[statePartRest], err = p.MarshalSSZ()
+	case *ethpb.BeaconStateDeneb:
+		f = splitFields{p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores = nil, nil, nil, nil, nil
+		parts[statePartRest], err = p.MarshalSSZ()
+	default:
+		return stateParts{}, fmt.Errorf("unsupported state version %s", version.String(st.Version()))
+	}
+	if err != nil {
+		return stateParts{}, errors.Wrap(err, "could not marshal state")
+	}
+	parts[statePartValidators] = make([]byte, 0, len(f.validators)*validatorSSZSize)
+	for _, v := range f.validators {
+		parts[statePartValidators], err = v.MarshalSSZTo(parts[statePartValidators])
+		if err != nil {
+			return stateParts{}, errors.Wrap(err, "could not marshal validator")
+		}
+	}
+	parts[statePartBalances] = encodeUint64s(f.balances)
+	parts[statePartPreviousParticipation] = f.previousParticipation
+	parts[statePartCurrentParticipation] = f.currentParticipation
+	parts[statePartInactivityScores] = encodeUint64s(f.inactivityScores)
+	return parts, nil
+}
+
+func joinState(v int, parts stateParts) (state.BeaconState, error) {
+	enc := parts[statePartValidators]
+	if len(enc)%validatorSSZSize != 0 {
+		return nil, errors.Wrap(errStateDiffCorrupt, "validators part is not a list of validators")
+	}
+	f := splitFields{validators: make([]*ethpb.Validator, len(enc)/validatorSSZSize)}
+	for i := range f.validators {
+		f.validators[i] = &ethpb.Validator{}
+		if err := f.validators[i].UnmarshalSSZ(enc[i*validatorSSZSize : (i+1)*validatorSSZSize]); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal validator")
+		}
+	}
+	var err error
+	if f.balances, err = decodeUint64s(parts[statePartBalances]); err != nil {
+		return nil, err
+	}
+	if f.inactivityScores, err = decodeUint64s(parts[statePartInactivityScores]); err != nil {
+		return nil, err
+	}
+	f.previousParticipation = parts[statePartPreviousParticipation]
+	f.currentParticipation = parts[statePartCurrentParticipation]
+
+	rest := parts[statePartRest]
+	switch v {
+	case version.Phase0:
+		p := &ethpb.BeaconState{}
+		if err := p.UnmarshalSSZ(rest); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding")
+		}
+		p.Validators, p.Balances = f.validators, f.balances
+		return statenative.InitializeFromProtoUnsafePhase0(p)
+	case version.Altair:
+		p := &ethpb.BeaconStateAltair{}
+		if err := p.UnmarshalSSZ(rest); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
+		}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores =
+			f.validators, f.balances, f.previousParticipation, f.currentParticipation, f.inactivityScores
+		return statenative.InitializeFromProtoUnsafeAltair(p)
+	case version.Bellatrix:
+		p := &ethpb.BeaconStateBellatrix{}
+		if err := p.UnmarshalSSZ(rest); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for bellatrix")
+		}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores =
+			f.validators, f.balances, f.previousParticipation, f.currentParticipation, f.inactivityScores
+		return statenative.InitializeFromProtoUnsafeBellatrix(p)
+	case version.Capella:
+		p := &ethpb.BeaconStateCapella{}
+		if err := p.UnmarshalSSZ(rest); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for capella")
+		}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores =
+			f.validators, f.balances, f.previousParticipation, f.currentParticipation, f.inactivityScores
+		return statenative.InitializeFromProtoUnsafeCapella(p)
+	case version.Deneb:
+		p := &ethpb.BeaconStateDeneb{}
+		if err := p.UnmarshalSSZ(rest); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for deneb")
+		}
+		p.Validators, p.Balances, p.PreviousEpochParticipation, p.CurrentEpochParticipation, p.InactivityScores =
+			f.validators, f.balances, f.previousParticipation, f.currentParticipation, f.inactivityScores
+		return statenative.InitializeFromProtoUnsafeDeneb(p)
+	default:
+		return nil, errors.Wrapf(errStateDiffCorrupt, "unknown state version %d", v)
+	}
+}
+
+func encodeUint64s(values []uint64) []byte {
+	enc := make([]byte, 0, len(values)*8)
+	for _, v := range values {
+		enc = binary.LittleEndian.AppendUint64(enc, v)
+	}
+	return enc
+}
+
+func decodeUint64s(enc []byte) ([]uint64, error) {
+	if len(enc)%8 != 0 {
+		return nil, errors.Wrap(errStateDiffCorrupt, "list of uint64 has an incomplete value")
+	}
+	values := make([]uint64, len(enc)/8)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint64(enc[i*8:])
+	}
+	return values, nil
+}
@@ -0,0 +1,142 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupStateDiffDB(t testing.TB, exponents []uint64) *Store {
+	db, err := NewKVStore(context.Background(), t.TempDir(), WithStateDiffExponents(exponents))
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+// stateDiffEntryHeader returns the kind and base slot of the state diff entry at the slot.
+func stateDiffEntryHeader(t *testing.T, db *Store, slot primitives.Slot) (byte, primitives.Slot) {
+	var kind byte
+	var base primitives.Slot
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		require.Equal(t, true, len(enc) >= stateDiffHeaderSize)
+		kind, base = enc[0], primitives.Slot(binary.LittleEndian.Uint64(enc[1:9]))
+		return nil
+	}))
+	return kind, base
+}
+
+func TestStateDiff_SaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		genesis func() state.BeaconState
+	}{
+		{
+			name: "phase0",
+			genesis: func() state.BeaconState {
+				st, _ := util.DeterministicGenesisState(t, 64)
+				return st
+			},
+		},
+		{
+			name: "deneb",
+			genesis: func() state.BeaconState {
+				st, _ := util.DeterministicGenesisStateDeneb(t, 64)
+				return st
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			db := setupStateDiffDB(t, []uint64{6, 4})
+			assert.Equal(t, primitives.Slot(16), db.StateDiffInterval())
+
+			st := c.genesis()
+			saved := make(map[[32]byte]state.BeaconState)
+			for i, slot := range []primitives.Slot{0, 16, 32, 48, 64, 80} {
+				st = st.Copy()
+				require.NoError(t, st.SetSlot(slot))
+				require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(i), uint64(i)))
+				require.NoError(t, st.AppendValidator(&ethpb.Validator{
+					PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+					EffectiveBalance:      uint64(i),
+				}))
+				require.NoError(t, st.AppendBalance(uint64(i)))
+				if st.Version() >= version.Altair {
+					require.NoError(t, st.AppendInactivityScore(uint64(i)))
+					require.NoError(t, st.AppendCurrentParticipationBits(byte(i)))
+					require.NoError(t, st.AppendPreviousParticipationBits(byte(i)))
+				}
+				root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+				require.NoError(t, db.SaveStateDiff(ctx, slot, st, root))
+				saved[root] = st
+			}
+
+			for root, want := range saved {
+				assert.Equal(t, true, db.HasState(ctx, root))
+				got, err := db.State(ctx, root)
+				require.NoError(t, err)
+				require.DeepSSZEqual(t, want.ToProtoUnsafe(), got.ToProtoUnsafe())
+			}
+
+			for slot, wantBase := range map[primitives.Slot]primitives.Slot{0: 0, 16: 0, 48: 0, 64: 64, 80: 64} {
+				kind, base := stateDiffEntryHeader(t, db, slot)
+				if slot%64 == 0 {
+					assert.Equal(t, stateDiffSnapshot, kind)
+				} else {
+					assert.Equal(t, stateDiffXor, kind)
+					assert.Equal(t, wantBase, base)
+				}
+			}
+		})
+	}
+}
+
+func TestStateDiff_MissingBase(t *testing.T) {
+	ctx := context.Background()
+	db := setupStateDiffDB(t, []uint64{6, 4})
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	require.NoError(t, st.SetSlot(80))
+	root := [32]byte{'a'}
+	require.NoError(t, db.SaveStateDiff(ctx, 80, st, root))
+
+	kind, _ := stateDiffEntryHeader(t, db, 80)
+	assert.Equal(t, stateDiffSnapshot, kind)
+	got, err := db.StateOrError(ctx, root)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, st.ToProtoUnsafe(), got.ToProtoUnsafe())
+}
+
+func TestStateDiff_Errors(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 4)
+
+	t.Run("disabled", func(t *testing.T) {
+		db := setupDB(t)
+		assert.Equal(t, primitives.Slot(0), db.StateDiffInterval())
+		require.ErrorIs(t, db.SaveStateDiff(ctx, 16, st, [32]byte{}), errStateDiffDisabled)
+	})
+	t.Run("not a point", func(t *testing.T) {
+		db := setupStateDiffDB(t, []uint64{6, 4})
+		require.ErrorIs(t, db.SaveStateDiff(ctx, 8, st, [32]byte{}), errNotStateDiffSlot)
+		assert.Equal(t, false, db.HasState(ctx, [32]byte{}))
+	})
+	t.Run("invalid exponents", func(t *testing.T) {
+		_, err := NewKVStore(ctx, t.TempDir(), WithStateDiffExponents([]uint64{4, 6}))
+		require.ErrorIs(t, err, errInvalidStateDiffLevel)
+	})
+}
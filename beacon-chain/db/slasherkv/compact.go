@@ -0,0 +1,84 @@
+package slasherkv
+
+import (
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	bolt "go.etcd.io/bbolt"
+)
+
+// compactTxMaxSize is the size of the data copied in a single transaction when compacting the database.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// Compact rewrites the slasher database of the directory without the free pages left by the pruned data, then
+// replaces the database file with the rewritten one. Bolt never shrinks its file, so this is the only way to reclaim
+// the disk space of a slasher database. The database must not be in use by another process.
+func Compact(dirPath string) (sizeBefore, sizeAfter int64, err error) {
+	srcPath := path.Join(dirPath, DatabaseFileName)
+	exists, err := file.Exists(srcPath, file.Regular)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !exists {
+		return 0, 0, errors.Errorf("no slasher database at %s", srcPath)
+	}
+	dstPath := srcPath + ".compact"
+	if err := os.RemoveAll(dstPath); err != nil {
+		return 0, 0, errors.Wrap(err, "could not remove previous compaction file")
+	}
+
+	opts := &bolt.Options{Timeout: 1 * time.Second}
+	src, err := bolt.Open(srcPath, params.BeaconIoConfig().ReadWritePermissions, opts)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+	defer func() {
+		if src != nil {
+			if closeErr := src.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+	}()
+	dst, err := bolt.Open(dstPath, params.BeaconIoConfig().ReadWritePermissions, opts)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not create compaction file")
+	}
+
+	log.WithField("path", srcPath).Info("Compacting slasher database")
+	start := time.Now()
+	if err := bolt.Compact(dst, src, compactTxMaxSize); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(dstPath)
+		return 0, 0, errors.Wrap(err, "could not compact database")
+	}
+	if err := dst.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	before, err := os.Stat(srcPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	after, err := os.Stat(dstPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	// The source database is closed before being replaced, its lock being held until then.
+	err = src.Close()
+	src = nil
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(dstPath, srcPath); err != nil {
+		return 0, 0, errors.Wrap(err, "could not replace database with compacted one")
+	}
+	log.WithField("elapsed", time.Since(start)).Info("Compacted slasher database")
+	return before.Size(), after.Size(), nil
+}
@@ -0,0 +1,42 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	epochs := make(map[primitives.ValidatorIndex]primitives.Epoch)
+	for i := primitives.ValidatorIndex(0); i < 100_000; i++ {
+		epochs[i] = 2
+	}
+	require.NoError(t, db.SaveLastEpochWrittenForValidators(ctx, epochs))
+
+	_, _, err = Compact(dir)
+	require.ErrorContains(t, "database may be in use", err)
+	require.NoError(t, db.Close())
+
+	before, after, err := Compact(dir)
+	require.NoError(t, err)
+	require.Equal(t, true, after < before, "Database was not compacted")
+
+	db, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	got, err := db.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{0, 99_999})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	require.Equal(t, primitives.Epoch(2), got[1].Epoch)
+
+	_, _, err = Compact(t.TempDir())
+	require.ErrorContains(t, "no slasher database", err)
+}
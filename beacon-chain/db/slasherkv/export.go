@@ -0,0 +1,207 @@
+package slasherkv
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// exportVersion is the version of the export format, increased on any change of the format
+// or of the encoding of the exported records.
+const exportVersion = 1
+
+// exportEnd marks the end of the exported records.
+const exportEnd = byte(0xff)
+
+// exportMaxLength bounds the length of the exported keys and values, well above the largest min-max span chunk and
+// the largest attestation record or slashing, so a corrupted length is rejected before it is allocated.
+const exportMaxLength = 16 * 1024 * 1024
+
+// exportMagic starts every export, to recognize the files which are not slasher exports.
+var exportMagic = []byte("prysm-slasher")
+
+// exportedBuckets are the buckets an export is made of. The position of a bucket in the list identifies the bucket
+// of the exported records, so buckets may only be appended.
+var exportedBuckets = [][]byte{
+	attestedEpochsByValidator,
+	attestationRecordsBucket,
+	attestationDataRootsBucket,
+	proposalRecordsBucket,
+	slasherChunksBucket,
+	attesterSlashingsBucket,
+	proposerSlashingsBucket,
+}
+
+// ErrImportIntoNonEmptyDB is returned when importing into a database which already holds slasher data, whose
+// min-max spans could not be merged with the imported ones.
+var ErrImportIntoNonEmptyDB = errors.New("cannot import into a slasher database which is not empty")
+
+// Export writes the min-max span chunks, attestation records, proposal records and slashings of the database to
+// the writer, from a consistent view of the database.
+//
+// The export is made of a header followed by the records, each record being
+// (bucket id ++ uvarint key length ++ key ++ uvarint value length ++ value). The records are kept in their database
+// encoding, which does not depend on the platform. The chunks are only meaningful to slashers running with the same
+// slasher parameters.
+func (s *Store) Export(ctx context.Context, w io.Writer) (numRecords int, err error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.Export")
+	defer span.End()
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(exportMagic); err != nil {
+		return 0, err
+	}
+	if err := bw.WriteByte(exportVersion); err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	writeBytes := func(b []byte) error {
+		n := binary.PutUvarint(buf, uint64(len(b)))
+		if _, err := bw.Write(buf[:n]); err != nil {
+			return err
+		}
+		_, err := bw.Write(b)
+		return err
+	}
+
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		for id, name := range exportedBuckets {
+			if err := tx.Bucket(name).ForEach(func(k, v []byte) error {
+				if err := bw.WriteByte(byte(id)); err != nil {
+					return err
+				}
+				if err := writeBytes(k); err != nil {
+					return err
+				}
+				if err := writeBytes(v); err != nil {
+					return err
+				}
+				numRecords++
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "could not export bucket %s", name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := bw.WriteByte(exportEnd); err != nil {
+		return 0, err
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return numRecords, nil
+}
+
+// Import reads the records of an export into the database, which must not hold slasher data yet.
+func (s *Store) Import(ctx context.Context, r io.Reader) (numRecords int, err error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.Import")
+	defer span.End()
+
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		for _, name := range exportedBuckets {
+			if k, _ := tx.Bucket(name).Cursor().First(); k != nil {
+				return ErrImportIntoNonEmptyDB
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	br := bufio.NewReader(r)
+	header := make([]byte, len(exportMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return 0, errors.Wrap(err, "could not read export header")
+	}
+	if !bytes.Equal(header[:len(exportMagic)], exportMagic) {
+		return 0, errors.New("not a slasher export")
+	}
+	if header[len(exportMagic)] != exportVersion {
+		return 0, errors.Errorf("unsupported export version %d, expected %d", header[len(exportMagic)], exportVersion)
+	}
+
+	readBytes := func() ([]byte, error) {
+		l, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		if l > exportMaxLength {
+			return nil, errors.Errorf("length %d exceeds the maximum of %d bytes", l, exportMaxLength)
+		}
+		b := make([]byte, l)
+		if _, err := io.ReadFull(br, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+
+	// The records are written by batch, an export being too large to be imported in a single transaction.
+	type record struct {
+		bucket     []byte
+		key, value []byte
+	}
+	batch := make([]record, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			for _, rec := range batch {
+				if err := tx.Bucket(rec.bucket).Put(rec.key, rec.value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "could not save imported records")
+		}
+		numRecords += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return numRecords, ctx.Err()
+		}
+		id, err := br.ReadByte()
+		if err != nil {
+			return numRecords, errors.Wrap(err, "could not read record, the export may be truncated")
+		}
+		if id == exportEnd {
+			break
+		}
+		if int(id) >= len(exportedBuckets) {
+			return numRecords, errors.Errorf("unknown bucket id %d", id)
+		}
+		key, err := readBytes()
+		if err != nil {
+			return numRecords, errors.Wrap(err, "could not read record key")
+		}
+		value, err := readBytes()
+		if err != nil {
+			return numRecords, errors.Wrap(err, "could not read record value")
+		}
+		batch = append(batch, record{bucket: exportedBuckets[id], key: key, value: value})
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return numRecords, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return numRecords, err
+	}
+	return numRecords, nil
+}
@@ -0,0 +1,84 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	src := setupDB(t)
+
+	att := createAttestationWrapper(1, 2, []uint64{3}, []byte{1})
+	require.NoError(t, src.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{att}))
+	proposal := createProposalWrapper(t, 4, 5, []byte{2})
+	require.NoError(t, src.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{proposal}))
+	chunkKeys := [][]byte{bytesutil.Uint64ToBytesLittleEndian(1), bytesutil.Uint64ToBytesLittleEndian(2)}
+	chunks := [][]uint16{{1, 2, 3}, {4, 5, 6}}
+	require.NoError(t, src.SaveSlasherChunks(ctx, slashertypes.MinSpan, chunkKeys, chunks))
+	require.NoError(t, src.SaveLastEpochWrittenForValidators(ctx, map[primitives.ValidatorIndex]primitives.Epoch{3: 2}))
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: att.IndexedAttestation,
+		Attestation_2: createAttestationWrapper(1, 2, []uint64{3}, []byte{2}).IndexedAttestation,
+	}
+	require.NoError(t, src.SaveAttesterSlashings(ctx, []*ethpb.AttesterSlashing{slashing}))
+
+	buf := new(bytes.Buffer)
+	exported, err := src.Export(ctx, buf)
+	require.NoError(t, err)
+	// 1 attested epoch, 1 attestation record, 1 attestation data root, 1 proposal, 2 chunks and 1 slashing.
+	require.Equal(t, 7, exported)
+
+	dst := setupDB(t)
+	imported, err := dst.Import(ctx, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, exported, imported)
+
+	gotAtt, err := dst.AttestationRecordForValidator(ctx, 3, 2)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, att.IndexedAttestation, gotAtt.IndexedAttestation)
+	gotProposal, err := dst.BlockProposalForValidator(ctx, 5, 4)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, proposal.SignedBeaconBlockHeader, gotProposal.SignedBeaconBlockHeader)
+	gotChunks, exists, err := dst.LoadSlasherChunks(ctx, slashertypes.MinSpan, chunkKeys)
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{true, true}, exists)
+	require.DeepEqual(t, chunks, gotChunks)
+	epochs, err := dst.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{3})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(epochs))
+	require.Equal(t, primitives.Epoch(2), epochs[0].Epoch)
+	gotSlashings, err := dst.AttesterSlashings(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(gotSlashings))
+	require.DeepSSZEqual(t, slashing, gotSlashings[0])
+
+	t.Run("non empty database", func(t *testing.T) {
+		_, err := dst.Import(ctx, bytes.NewReader(buf.Bytes()))
+		require.ErrorIs(t, err, ErrImportIntoNonEmptyDB)
+	})
+	t.Run("not an export", func(t *testing.T) {
+		_, err := setupDB(t).Import(ctx, bytes.NewReader([]byte("not-a-slasher-export")))
+		require.ErrorContains(t, "not a slasher export", err)
+	})
+	t.Run("truncated export", func(t *testing.T) {
+		_, err := setupDB(t).Import(ctx, bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+		require.ErrorContains(t, "the export may be truncated", err)
+	})
+	t.Run("oversized length", func(t *testing.T) {
+		corrupted := append([]byte{}, buf.Bytes()[:len(exportMagic)+1]...)
+		corrupted = append(corrupted, 0)
+		corrupted = binary.AppendUvarint(corrupted, math.MaxUint64)
+		_, err := setupDB(t).Import(ctx, bytes.NewReader(corrupted))
+		require.ErrorContains(t, "exceeds the maximum", err)
+	})
+}
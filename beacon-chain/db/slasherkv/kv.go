@@ -78,6 +78,8 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			attesterSlashingsBucket,
+			proposerSlashingsBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -24,4 +24,9 @@ var (
 	// value: (encoded) SignedBlockHeaderWrapper
 	proposalRecordsBucket = []byte("proposal-records")
 	slasherChunksBucket   = []byte("slasher-chunks")
+
+	// key: (big endian encoded) Epoch + slashing root
+	// value: (encoded + compressed) slashing
+	attesterSlashingsBucket = []byte("attester-slashings")
+	proposerSlashingsBucket = []byte("proposer-slashings")
 )
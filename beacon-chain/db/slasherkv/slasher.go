@@ -70,6 +70,29 @@ func (s *Store) LastEpochWrittenForValidators(
 	return attestedEpochs, err
 }
 
+// HighestLastEpochWritten returns the highest epoch recorded for any validator writing data,
+// and false if no epoch was ever recorded.
+func (s *Store) HighestLastEpochWritten(ctx context.Context) (primitives.Epoch, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HighestLastEpochWritten")
+	defer span.End()
+
+	var highest primitives.Epoch
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attestedEpochsByValidator).ForEach(func(_, epochBytes []byte) error {
+			var epoch primitives.Epoch
+			if err := epoch.UnmarshalSSZ(epochBytes); err != nil {
+				return err
+			}
+			if !found || epoch > highest {
+				highest, found = epoch, true
+			}
+			return nil
+		})
+	})
+	return highest, found, err
+}
+
 // SaveLastEpochWrittenForValidators saves the latest epoch
 // that each validator has attested to in the provided map.
 func (s *Store) SaveLastEpochWrittenForValidators(
@@ -88,10 +88,18 @@ func TestStore_LastEpochWrittenForValidators(t *testing.T) {
 	attestedEpochs, err := beaconDB.LastEpochWrittenForValidators(ctx, indices)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(attestedEpochs))
+	_, ok, err := beaconDB.HighestLastEpochWritten(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
 
 	err = beaconDB.SaveLastEpochWrittenForValidators(ctx, epochsByValidator)
 	require.NoError(t, err)
 
+	highest, ok, err := beaconDB.HighestLastEpochWritten(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.Equal(t, primitives.Epoch(validatorsCount-1), highest)
+
 	retrievedEpochs, err := beaconDB.LastEpochWrittenForValidators(ctx, indices)
 	require.NoError(t, err)
 	require.Equal(t, len(indices), len(retrievedEpochs))
@@ -0,0 +1,148 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveAttesterSlashings saves the attester slashings detected by the slasher. A slashing is stored under the
+// target epoch of the most recent of its two attestations.
+func (s *Store) SaveAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveAttesterSlashings")
+	defer span.End()
+
+	keys := make([][]byte, len(slashings))
+	values := make([][]byte, len(slashings))
+	for i, slashing := range slashings {
+		if slashing == nil || slashing.Attestation_1.GetData().GetTarget() == nil || slashing.Attestation_2.GetData().GetTarget() == nil {
+			return errors.New("nil attester slashing")
+		}
+		epoch := slashing.Attestation_1.Data.Target.Epoch
+		if e := slashing.Attestation_2.Data.Target.Epoch; e > epoch {
+			epoch = e
+		}
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash attester slashing")
+		}
+		enc, err := slashing.MarshalSSZ()
+		if err != nil {
+			return errors.Wrap(err, "could not encode attester slashing")
+		}
+		keys[i] = slashingKey(epoch, root)
+		values[i] = snappy.Encode(nil, enc)
+	}
+	return s.saveSlashings(attesterSlashingsBucket, keys, values)
+}
+
+// AttesterSlashings returns the attester slashings detected by the slasher between the start and end epochs,
+// both included.
+func (s *Store) AttesterSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.AttesterSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashings")
+	defer span.End()
+
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	err := s.slashingsInRange(attesterSlashingsBucket, startEpoch, endEpoch, func(enc []byte) error {
+		slashing := &ethpb.AttesterSlashing{}
+		if err := slashing.UnmarshalSSZ(enc); err != nil {
+			return errors.Wrap(err, "could not decode attester slashing")
+		}
+		slashings = append(slashings, slashing)
+		return nil
+	})
+	return slashings, err
+}
+
+// SaveProposerSlashings saves the proposer slashings detected by the slasher. A slashing is stored under the epoch
+// of the slot of its proposals.
+func (s *Store) SaveProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveProposerSlashings")
+	defer span.End()
+
+	keys := make([][]byte, len(slashings))
+	values := make([][]byte, len(slashings))
+	for i, slashing := range slashings {
+		if slashing == nil || slashing.Header_1.GetHeader() == nil {
+			return errors.New("nil proposer slashing")
+		}
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash proposer slashing")
+		}
+		enc, err := slashing.MarshalSSZ()
+		if err != nil {
+			return errors.Wrap(err, "could not encode proposer slashing")
+		}
+		keys[i] = slashingKey(slots.ToEpoch(slashing.Header_1.Header.Slot), root)
+		values[i] = snappy.Encode(nil, enc)
+	}
+	return s.saveSlashings(proposerSlashingsBucket, keys, values)
+}
+
+// ProposerSlashings returns the proposer slashings detected by the slasher between the start and end epochs,
+// both included.
+func (s *Store) ProposerSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.ProposerSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashings")
+	defer span.End()
+
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	err := s.slashingsInRange(proposerSlashingsBucket, startEpoch, endEpoch, func(enc []byte) error {
+		slashing := &ethpb.ProposerSlashing{}
+		if err := slashing.UnmarshalSSZ(enc); err != nil {
+			return errors.Wrap(err, "could not decode proposer slashing")
+		}
+		slashings = append(slashings, slashing)
+		return nil
+	})
+	return slashings, err
+}
+
+func (s *Store) saveSlashings(bucket []byte, keys, values [][]byte) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(bucket)
+		for i := range keys {
+			if err := bkt.Put(keys[i], values[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// slashingsInRange calls f with the decompressed slashings of the bucket between the start and end epochs, ordered
+// by epoch.
+func (s *Store) slashingsInRange(bucket []byte, startEpoch, endEpoch primitives.Epoch, f func(enc []byte) error) error {
+	if startEpoch > endEpoch {
+		return errors.Errorf("start epoch %d is after end epoch %d", startEpoch, endEpoch)
+	}
+	end := bytesutil.Uint64ToBytesBigEndian(uint64(endEpoch))
+	return s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucket).Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(uint64(startEpoch))); k != nil && bytes.Compare(k[:8], end) <= 0; k, v = c.Next() {
+			enc, err := snappy.Decode(nil, v)
+			if err != nil {
+				return err
+			}
+			if err := f(enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func slashingKey(epoch primitives.Epoch, root [32]byte) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(epoch)), root[:]...)
+}
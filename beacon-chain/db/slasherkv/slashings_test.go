@@ -0,0 +1,79 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_AttesterSlashings_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for epoch := primitives.Epoch(1); epoch <= 5; epoch++ {
+		slashings = append(slashings, &ethpb.AttesterSlashing{
+			// The slashing is stored under the most recent target epoch.
+			Attestation_1: createAttestationWrapper(epoch, epoch+1, []uint64{1}, []byte{1}).IndexedAttestation,
+			Attestation_2: createAttestationWrapper(epoch-1, epoch, []uint64{1}, []byte{2}).IndexedAttestation,
+		})
+	}
+	require.NoError(t, beaconDB.SaveAttesterSlashings(ctx, slashings))
+
+	got, err := beaconDB.AttesterSlashings(ctx, 3, 4)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	require.DeepSSZEqual(t, slashings[1], got[0])
+	require.DeepSSZEqual(t, slashings[2], got[1])
+
+	got, err = beaconDB.AttesterSlashings(ctx, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(got))
+
+	got, err = beaconDB.AttesterSlashings(ctx, 10, 100)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(got))
+
+	_, err = beaconDB.AttesterSlashings(ctx, 4, 3)
+	require.ErrorContains(t, "start epoch 4 is after end epoch 3", err)
+
+	// Saving a slashing again does not duplicate it.
+	require.NoError(t, beaconDB.SaveAttesterSlashings(ctx, slashings[:1]))
+	got, err = beaconDB.AttesterSlashings(ctx, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(got))
+}
+
+func TestStore_ProposerSlashings_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	for _, slot := range []primitives.Slot{1, slotsPerEpoch, slotsPerEpoch + 1, 3 * slotsPerEpoch} {
+		slashings = append(slashings, &ethpb.ProposerSlashing{
+			Header_1: createProposalWrapper(t, slot, 1, []byte{1}).SignedBeaconBlockHeader,
+			Header_2: createProposalWrapper(t, slot, 1, []byte{2}).SignedBeaconBlockHeader,
+		})
+	}
+	require.NoError(t, beaconDB.SaveProposerSlashings(ctx, slashings))
+
+	got, err := beaconDB.ProposerSlashings(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	for _, s := range got {
+		require.Equal(t, primitives.Epoch(1), primitives.Epoch(s.Header_1.Header.Slot/slotsPerEpoch))
+	}
+
+	got, err = beaconDB.ProposerSlashings(ctx, 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(got))
+	require.DeepSSZEqual(t, slashings[0], got[0])
+	require.DeepSSZEqual(t, slashings[3], got[3])
+
+	require.ErrorContains(t, "nil proposer slashing", beaconDB.SaveProposerSlashings(ctx, []*ethpb.ProposerSlashing{{}}))
+}
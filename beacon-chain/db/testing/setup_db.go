@@ -13,8 +13,8 @@ import (
 )
 
 // SetupDB instantiates and returns database backed by key value store.
-func SetupDB(t testing.TB) db.Database {
-	s, err := kv.NewKVStore(context.Background(), t.TempDir())
+func SetupDB(t testing.TB, opts ...kv.KVStoreOption) db.Database {
+	s, err := kv.NewKVStore(context.Background(), t.TempDir(), opts...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -120,39 +120,39 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
-	result := &pb.PayloadStatus{}
 
+	var method string
+	var args []interface{}
 	switch payload.Proto().(type) {
 	case *pb.ExecutionPayload:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayload)
 		if !ok {
 			return nil, errors.New("execution data must be a Bellatrix or Capella execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethod, []interface{}{payloadPb}
 	case *pb.ExecutionPayloadCapella:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadCapella)
 		if !ok {
 			return nil, errors.New("execution data must be a Capella execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethodV2, []interface{}{payloadPb}
 	case *pb.ExecutionPayloadDeneb:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadDeneb)
 		if !ok {
 			return nil, errors.New("execution data must be a Deneb execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, parentBlockRoot)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethodV3, []interface{}{payloadPb, versionedHashes, parentBlockRoot}
 	default:
 		return nil, errors.New("unknown execution data type")
 	}
+	// The payload is sent to all the execution engines, whose quorum decides on its validity.
+	newResult := func() interface{} { return &pb.PayloadStatus{} }
+	status := func(r interface{}) *pb.PayloadStatus { return r.(*pb.PayloadStatus) }
+	quorumResult, err := s.engineQuorum(s.callEngines(ctx, newResult, status, nil, method, args...), status)
+	if err != nil {
+		return nil, err
+	}
+	result := quorumResult.(*pb.PayloadStatus)
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in newPayload")
 	}
@@ -184,42 +184,41 @@ func (s *Service) ForkchoiceUpdated(
 	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
-	result := &ForkchoiceUpdatedResponse{}
 
 	if attrs == nil {
 		return nil, nil, errors.New("nil payload attributer")
 	}
+	var method string
+	var a interface{}
+	var err error
 	switch attrs.Version() {
 	case version.Bellatrix:
-		a, err := attrs.PbV1()
-		if err != nil {
-			return nil, nil, err
-		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
-		if err != nil {
-			return nil, nil, handleRPCError(err)
-		}
+		method = ForkchoiceUpdatedMethod
+		a, err = attrs.PbV1()
 	case version.Capella:
-		a, err := attrs.PbV2()
-		if err != nil {
-			return nil, nil, err
-		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
-		if err != nil {
-			return nil, nil, handleRPCError(err)
-		}
+		method = ForkchoiceUpdatedMethodV2
+		a, err = attrs.PbV2()
 	case version.Deneb:
-		a, err := attrs.PbV3()
-		if err != nil {
-			return nil, nil, err
-		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
-		if err != nil {
-			return nil, nil, handleRPCError(err)
-		}
+		method = ForkchoiceUpdatedMethodV3
+		a, err = attrs.PbV3()
 	default:
 		return nil, nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
 	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// The forkchoice update is sent to all the execution engines, whose quorum decides on the validity of the head.
+	newResult := func() interface{} { return &ForkchoiceUpdatedResponse{} }
+	status := func(r interface{}) *pb.PayloadStatus { return r.(*ForkchoiceUpdatedResponse).Status }
+	payloadIDs := make(map[*engineEndpoint]pb.PayloadIDBytes)
+	onCall := func(c *engineCall) { s.recordPayloadID(payloadIDs, c) }
+	calls := s.callEngines(ctx, newResult, status, onCall, method, state, a)
+	quorumResult, err := s.engineQuorum(calls, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := quorumResult.(*ForkchoiceUpdatedResponse)
+	s.trackPayloadIDs(result.PayloadId, payloadIDs)
 
 	if result.Status == nil {
 		return nil, nil, ErrNilResponse
@@ -254,9 +253,33 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 
+	// The payload is taken from the healthiest execution engine which built a payload for the payload ID.
+	engines, ids := s.payloadEngines(payloadId)
+	var err error
+	for i, e := range engines {
+		start := time.Now()
+		ed, bundle, overrideBuilder, getErr := s.getPayloadFrom(ctx, s.engineClient(e), ids[i], slot)
+		e.observeLatency(getPayloadLabel, time.Since(start))
+		if getErr == nil {
+			return ed, bundle, overrideBuilder, nil
+		}
+		if err == nil {
+			err = getErr
+		}
+		if len(engines) > 1 {
+			log.WithError(getErr).WithField("endpoint", e.endpoint).Warn("Could not get payload from execution engine")
+		}
+	}
+	if err == nil {
+		err = errors.New("no execution engine built a payload for the payload ID")
+	}
+	return nil, nil, false, err
+}
+
+func (s *Service) getPayloadFrom(ctx context.Context, client RPCClient, payloadId [8]byte, slot primitives.Slot) (interfaces.ExecutionData, *pb.BlobsBundle, bool, error) {
 	if slots.ToEpoch(slot) >= params.BeaconConfig().DenebForkEpoch {
 		result := &pb.ExecutionPayloadDenebWithValueAndBlobsBundle{}
-		err := s.rpcClient.CallContext(ctx, result, GetPayloadMethodV3, pb.PayloadIDBytes(payloadId))
+		err := client.CallContext(ctx, result, GetPayloadMethodV3, pb.PayloadIDBytes(payloadId))
 		if err != nil {
 			return nil, nil, false, handleRPCError(err)
 		}
@@ -269,7 +292,7 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 
 	if slots.ToEpoch(slot) >= params.BeaconConfig().CapellaForkEpoch {
 		result := &pb.ExecutionPayloadCapellaWithValue{}
-		err := s.rpcClient.CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
+		err := client.CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
 		if err != nil {
 			return nil, nil, false, handleRPCError(err)
 		}
@@ -281,7 +304,7 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	}
 
 	result := &pb.ExecutionPayload{}
-	err := s.rpcClient.CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
+	err := client.CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
 	if err != nil {
 		return nil, nil, false, handleRPCError(err)
 	}
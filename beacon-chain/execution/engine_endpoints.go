@@ -0,0 +1,454 @@
+package execution
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// maxTrackedPayloadIDs is the number of payload IDs for which the payload IDs returned by each execution engine are
+// remembered, so that a payload is fetched from any engine which builds it.
+const maxTrackedPayloadIDs = 32
+
+// getPayloadLabel is the method label of the engine_getPayloadVX latency metrics.
+const getPayloadLabel = "engine_getPayload"
+
+// EngineSyncStatus is the status of an execution engine endpoint, from its last engine API call.
+type EngineSyncStatus string
+
+const (
+	// EngineStatusUnknown is the status of an engine no engine API call was made to yet.
+	EngineStatusUnknown EngineSyncStatus = "unknown"
+	// EngineStatusSynced is the status of an engine which validated the last payload or forkchoice update.
+	EngineStatusSynced EngineSyncStatus = "synced"
+	// EngineStatusSyncing is the status of an engine which could not validate the last payload or forkchoice update.
+	EngineStatusSyncing EngineSyncStatus = "syncing"
+	// EngineStatusOffline is the status of an engine whose last engine API call failed.
+	EngineStatusOffline EngineSyncStatus = "offline"
+)
+
+// EngineStatus is the status of an execution engine endpoint.
+type EngineStatus struct {
+	Endpoint    string
+	Primary     bool
+	Status      EngineSyncStatus
+	Latency     time.Duration
+	LastUpdated time.Time
+	LastError   string
+}
+
+// EngineStatusFetcher retrieves the status of the execution engine endpoints.
+type EngineStatusFetcher interface {
+	EngineStatuses() []*EngineStatus
+}
+
+// engineEndpoint is an execution engine endpoint engine API calls are made to.
+type engineEndpoint struct {
+	sync.RWMutex
+	endpoint string
+	// client is nil for the primary engine, which uses the RPC client of the service.
+	client      RPCClient
+	status      EngineSyncStatus
+	latency     time.Duration
+	lastUpdated time.Time
+	lastErr     error
+}
+
+func newEngineEndpoint(endpoint string, client RPCClient) *engineEndpoint {
+	return &engineEndpoint{endpoint: endpoint, client: client, status: EngineStatusUnknown}
+}
+
+func (e *engineEndpoint) update(method string, status EngineSyncStatus, latency time.Duration, err error) {
+	e.Lock()
+	defer e.Unlock()
+	e.status = status
+	e.latency = latency
+	e.lastUpdated = time.Now()
+	e.lastErr = err
+	e.observeLatency(method, latency)
+	switch status {
+	case EngineStatusSynced:
+		engineEndpointStatus.WithLabelValues(e.endpoint).Set(2)
+	case EngineStatusSyncing:
+		engineEndpointStatus.WithLabelValues(e.endpoint).Set(1)
+	default:
+		engineEndpointStatus.WithLabelValues(e.endpoint).Set(0)
+	}
+}
+
+func (e *engineEndpoint) observeLatency(method string, latency time.Duration) {
+	engineEndpointLatency.WithLabelValues(e.endpoint, method).Observe(float64(latency.Milliseconds()))
+}
+
+func (e *engineEndpoint) engineStatus() *EngineStatus {
+	e.RLock()
+	defer e.RUnlock()
+	s := &EngineStatus{
+		Endpoint:    e.endpoint,
+		Primary:     e.client == nil,
+		Status:      e.status,
+		Latency:     e.latency,
+		LastUpdated: e.lastUpdated,
+	}
+	if e.lastErr != nil {
+		s.LastError = e.lastErr.Error()
+	}
+	return s
+}
+
+// engineCall is the result of an engine API call made to an execution engine.
+type engineCall struct {
+	engine *engineEndpoint
+	result interface{}
+	err    error
+}
+
+// EngineStatuses returns the status of the execution engine endpoints, the primary engine first.
+func (s *Service) EngineStatuses() []*EngineStatus {
+	engines := s.engines()
+	statuses := make([]*EngineStatus, len(engines))
+	for i, e := range engines {
+		statuses[i] = e.engineStatus()
+	}
+	return statuses
+}
+
+// engines returns the execution engine endpoints, the primary engine first. A service without backup engines has
+// its primary engine endpoint created on first use.
+func (s *Service) engines() []*engineEndpoint {
+	s.engineEndpointsOnce.Do(func() {
+		if len(s.engineEndpoints) == 0 {
+			s.engineEndpoints = []*engineEndpoint{s.newPrimaryEngineEndpoint()}
+		}
+	})
+	return s.engineEndpoints
+}
+
+func (s *Service) newPrimaryEngineEndpoint() *engineEndpoint {
+	endpoint := ""
+	if s.cfg != nil {
+		endpoint = logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url)
+	}
+	return newEngineEndpoint(endpoint, nil)
+}
+
+func (s *Service) engineClient(e *engineEndpoint) RPCClient {
+	if e.client == nil {
+		return s.rpcClient
+	}
+	return e.client
+}
+
+// callEngines makes the engine API call to all the execution engines at once. It returns the results of the calls
+// answered, in the order of the engines, as soon as a payload status reaches the quorum, the primary engine answers
+// without a quorum configured, or all the engines which are not offline answered. The calls still in flight then
+// complete in the background, within the deadline of the context, for the status of their engine to be updated.
+// Offline engines are not waited for, unless all the engines are offline. When set, onCall is called as each call
+// completes, including the calls completing in the background.
+func (s *Service) callEngines(
+	ctx context.Context,
+	newResult func() interface{},
+	status func(result interface{}) *pb.PayloadStatus,
+	onCall func(c *engineCall),
+	method string,
+	args ...interface{},
+) []*engineCall {
+	engines := s.engines()
+	awaited := make([]bool, len(engines))
+	numAwaited := 0
+	for i, e := range engines {
+		e.RLock()
+		awaited[i] = e.status != EngineStatusOffline
+		e.RUnlock()
+		if awaited[i] {
+			numAwaited++
+		}
+	}
+	if numAwaited == 0 {
+		for i := range awaited {
+			awaited[i] = true
+		}
+		numAwaited = len(engines)
+	}
+
+	// The calls are not cancelled when callEngines returns, but still end with the deadline of the context.
+	callCtx := context.WithoutCancel(ctx)
+	cancel := func() {}
+	if deadline, ok := ctx.Deadline(); ok {
+		callCtx, cancel = context.WithDeadline(callCtx, deadline)
+	}
+	type answer struct {
+		i    int
+		call *engineCall
+	}
+	// The channel is buffered for the calls completing after callEngines returned not to block.
+	results := make(chan answer, len(engines))
+	var wg sync.WaitGroup
+	for i, e := range engines {
+		wg.Add(1)
+		go func(i int, e *engineEndpoint) {
+			defer wg.Done()
+			start := time.Now()
+			res := newResult()
+			err := handleRPCError(s.engineClient(e).CallContext(callCtx, res, method, args...))
+			engineStatus := EngineStatusOffline
+			if err == nil {
+				engineStatus = EngineStatusSyncing
+				if st := status(res); st != nil && isDefinitivePayloadStatus(st.Status) {
+					engineStatus = EngineStatusSynced
+				}
+			}
+			call := &engineCall{engine: e, result: res, err: err}
+			if onCall != nil {
+				onCall(call)
+			}
+			e.update(method, engineStatus, time.Since(start), err)
+			results <- answer{i: i, call: call}
+		}(i, e)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	quorum := s.engineQuorumSize()
+	counts := make(map[pb.PayloadStatus_Status]uint64)
+	answered := make([]*engineCall, len(engines))
+	for numAwaited > 0 {
+		r := <-results
+		answered[r.i] = r.call
+		if awaited[r.i] {
+			numAwaited--
+		}
+		if r.call.err != nil {
+			continue
+		}
+		if r.i == 0 && quorum <= 1 {
+			break
+		}
+		if st := status(r.call.result); st != nil && isDefinitivePayloadStatus(st.Status) {
+			counts[st.Status]++
+			if counts[st.Status] >= quorum {
+				break
+			}
+		}
+	}
+	calls := make([]*engineCall, 0, len(engines))
+	for _, c := range answered {
+		if c != nil {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// engineQuorum returns the result of the first engine, in the order of the engines, whose payload status is VALID,
+// INVALID or INVALID_BLOCK_HASH, and on which at least the quorum of engines agree. Without a quorum, the result of
+// the first engine which is syncing is returned, or ErrAcceptedSyncingPayloadStatus when the engines disagree. When
+// all the calls failed, the error of the primary engine is returned.
+func (s *Service) engineQuorum(calls []*engineCall, status func(result interface{}) *pb.PayloadStatus) (interface{}, error) {
+	counts := make(map[pb.PayloadStatus_Status]uint64)
+	var firstAnswer, firstSyncing *engineCall
+	definitive := false
+	var firstErr error
+	for _, c := range calls {
+		if c.err != nil {
+			if firstErr == nil {
+				firstErr = c.err
+			}
+			continue
+		}
+		if firstAnswer == nil {
+			firstAnswer = c
+		}
+		st := status(c.result)
+		if st == nil {
+			continue
+		}
+		counts[st.Status]++
+		if isDefinitivePayloadStatus(st.Status) {
+			definitive = true
+		} else if firstSyncing == nil && (st.Status == pb.PayloadStatus_SYNCING || st.Status == pb.PayloadStatus_ACCEPTED) {
+			firstSyncing = c
+		}
+	}
+	quorum := s.engineQuorumSize()
+	for _, c := range calls {
+		if c.err != nil {
+			continue
+		}
+		if st := status(c.result); st != nil && isDefinitivePayloadStatus(st.Status) && counts[st.Status] >= quorum {
+			if len(counts) > 1 {
+				logEngineDisagreement(calls, status).Debug("Execution engines disagree on payload status")
+			}
+			return c.result, nil
+		}
+	}
+	if definitive {
+		logEngineDisagreement(calls, status).Warn("Execution engines did not reach a quorum on payload status")
+	}
+	switch {
+	case firstSyncing != nil:
+		return firstSyncing.result, nil
+	case definitive:
+		return nil, ErrAcceptedSyncingPayloadStatus
+	case firstAnswer != nil:
+		return firstAnswer.result, nil
+	default:
+		return nil, firstErr
+	}
+}
+
+func (s *Service) engineQuorumSize() uint64 {
+	if s.cfg == nil || s.cfg.engineQuorum == 0 {
+		return 1
+	}
+	return s.cfg.engineQuorum
+}
+
+func isDefinitivePayloadStatus(status pb.PayloadStatus_Status) bool {
+	return status == pb.PayloadStatus_VALID ||
+		status == pb.PayloadStatus_INVALID ||
+		status == pb.PayloadStatus_INVALID_BLOCK_HASH
+}
+
+func logEngineDisagreement(calls []*engineCall, status func(result interface{}) *pb.PayloadStatus) *logrus.Entry {
+	fields := logrus.Fields{}
+	for _, c := range calls {
+		if c.err != nil {
+			fields[c.engine.endpoint] = c.err.Error()
+		} else if st := status(c.result); st != nil {
+			fields[c.engine.endpoint] = st.Status.String()
+		}
+	}
+	return log.WithFields(fields)
+}
+
+// recordPayloadID adds the payload ID an engine returned for a forkchoice update to the payload IDs of the update.
+// It is called as each forkchoice update call completes, so that the engines answering after the forkchoice update
+// returned are also recorded.
+func (s *Service) recordPayloadID(ids map[*engineEndpoint]pb.PayloadIDBytes, c *engineCall) {
+	if c.err != nil {
+		return
+	}
+	resp, ok := c.result.(*ForkchoiceUpdatedResponse)
+	if !ok || resp.PayloadId == nil {
+		return
+	}
+	s.payloadIDsLock.Lock()
+	defer s.payloadIDsLock.Unlock()
+	ids[c.engine] = *resp.PayloadId
+}
+
+// trackPayloadIDs remembers the payload IDs returned by each engine for a forkchoice update, keyed by the payload ID
+// returned to the caller. The payload IDs of the engines still answering are added by recordPayloadID.
+func (s *Service) trackPayloadIDs(id *pb.PayloadIDBytes, ids map[*engineEndpoint]pb.PayloadIDBytes) {
+	if id == nil || len(s.engines()) < 2 {
+		return
+	}
+	s.payloadIDsLock.Lock()
+	defer s.payloadIDsLock.Unlock()
+	if s.payloadIDs == nil {
+		s.payloadIDs = make(map[pb.PayloadIDBytes]map[*engineEndpoint]pb.PayloadIDBytes)
+	}
+	if _, ok := s.payloadIDs[*id]; !ok {
+		s.payloadIDsOrder = append(s.payloadIDsOrder, *id)
+	}
+	s.payloadIDs[*id] = ids
+	for len(s.payloadIDsOrder) > maxTrackedPayloadIDs {
+		delete(s.payloadIDs, s.payloadIDsOrder[0])
+		s.payloadIDsOrder = s.payloadIDsOrder[1:]
+	}
+}
+
+// payloadEngines returns the engines to fetch the payload with the given ID from, along with the payload ID each
+// engine returned, the healthiest engines first. Without a known payload ID for each engine, the payload is fetched
+// with the given ID from all the engines.
+func (s *Service) payloadEngines(id [8]byte) ([]*engineEndpoint, []pb.PayloadIDBytes) {
+	// The payload IDs are copied, the engines answering late still adding theirs.
+	s.payloadIDsLock.RLock()
+	engineIDs, ok := s.payloadIDs[id]
+	tracked := make(map[*engineEndpoint]pb.PayloadIDBytes, len(engineIDs))
+	for e, engineID := range engineIDs {
+		tracked[e] = engineID
+	}
+	s.payloadIDsLock.RUnlock()
+
+	var engines []*engineEndpoint
+	for _, e := range s.engines() {
+		if _, hasID := tracked[e]; ok && !hasID {
+			continue
+		}
+		engines = append(engines, e)
+	}
+	rank := func(e *engineEndpoint) int {
+		e.RLock()
+		defer e.RUnlock()
+		switch e.status {
+		case EngineStatusSynced:
+			return 0
+		case EngineStatusOffline:
+			return 2
+		default:
+			return 1
+		}
+	}
+	sort.SliceStable(engines, func(i, j int) bool {
+		return rank(engines[i]) < rank(engines[j])
+	})
+	ids := make([]pb.PayloadIDBytes, len(engines))
+	for i, e := range engines {
+		ids[i] = id
+		if ok {
+			ids[i] = tracked[e]
+		}
+	}
+	return engines, ids
+}
+
+// closeBackupEngines closes the RPC clients of the backup execution engines.
+func (s *Service) closeBackupEngines() {
+	for _, e := range s.engineEndpoints {
+		if e.client != nil {
+			e.client.Close()
+		}
+	}
+}
+
+// setupBackupEngines creates the endpoint of the primary execution engine, and dials the backup execution engine
+// endpoints.
+func (s *Service) setupBackupEngines(ctx context.Context) error {
+	numEngines := len(s.cfg.backupEngineEndpoints) + 1
+	if s.engineQuorumSize() > uint64(numEngines) {
+		return errors.Errorf("execution engine quorum %d is larger than the %d execution engines", s.engineQuorumSize(), numEngines)
+	}
+	engines := []*engineEndpoint{s.newPrimaryEngineEndpoint()}
+	if len(s.cfg.backupEngineEndpoints) == 0 {
+		s.engineEndpoints = engines
+		return nil
+	}
+	for _, endpoint := range s.cfg.backupEngineEndpoints {
+		client, err := s.newRPCClientWithAuth(ctx, endpoint)
+		if err != nil {
+			for _, e := range engines {
+				if e.client != nil {
+					e.client.Close()
+				}
+			}
+			return errors.Wrapf(err, "could not dial backup execution engine %s", logs.MaskCredentialsLogging(endpoint.Url))
+		}
+		engines = append(engines, newEngineEndpoint(logs.MaskCredentialsLogging(endpoint.Url), s.recordEngineCalls(endpoint, client, false)))
+	}
+	s.engineEndpoints = engines
+	log.WithFields(logrus.Fields{
+		"numEngines": len(engines),
+		"quorum":     s.engineQuorumSize(),
+	}).Info("Sending engine API calls to several execution engines")
+	return nil
+}
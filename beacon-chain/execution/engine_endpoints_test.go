@@ -0,0 +1,267 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeEngineClient is an execution engine answering engine API calls with a fixed payload status.
+type fakeEngineClient struct {
+	sync.Mutex
+	status     pb.PayloadStatus_Status
+	payloadID  *pb.PayloadIDBytes
+	err        error
+	getPayload map[pb.PayloadIDBytes]bool
+	// block, when set, delays the answers until it is closed.
+	block chan struct{}
+}
+
+func (*fakeEngineClient) Close() {}
+
+func (*fakeEngineClient) BatchCall([]gethRPC.BatchElem) error {
+	return nil
+}
+
+func (c *fakeEngineClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if c.block != nil {
+		select {
+		case <-c.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	c.Lock()
+	defer c.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	status := &pb.PayloadStatus{Status: c.status, LatestValidHash: bytesutil.PadTo([]byte{'a'}, 32)}
+	switch method {
+	case NewPayloadMethod:
+		proto.Merge(result.(*pb.PayloadStatus), status)
+	case ForkchoiceUpdatedMethod:
+		resp := result.(*ForkchoiceUpdatedResponse)
+		resp.Status = status
+		resp.PayloadId = c.payloadID
+	case GetPayloadMethod:
+		id := args[0].(pb.PayloadIDBytes)
+		if !c.getPayload[id] {
+			return errors.New("unknown payload")
+		}
+		result.(*pb.ExecutionPayload).BlockNumber = 1
+	}
+	return nil
+}
+
+func newMultiEngineService(quorum uint64, clients ...*fakeEngineClient) *Service {
+	s := &Service{rpcClient: clients[0], cfg: &config{engineQuorum: quorum}}
+	s.engineEndpoints = []*engineEndpoint{newEngineEndpoint("primary", nil)}
+	for i, c := range clients[1:] {
+		s.engineEndpoints = append(s.engineEndpoints, newEngineEndpoint(fmt.Sprintf("backup%d", i), c))
+	}
+	return s
+}
+
+func TestService_NewPayload_Quorum(t *testing.T) {
+	ctx := context.Background()
+	payload, err := blocks.WrappedExecutionPayload(&pb.ExecutionPayload{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		quorum  uint64
+		primary *fakeEngineClient
+		backups []*fakeEngineClient
+		wantErr error
+	}{
+		{
+			name:    "primary valid",
+			quorum:  1,
+			primary: &fakeEngineClient{status: pb.PayloadStatus_VALID},
+			backups: []*fakeEngineClient{{status: pb.PayloadStatus_SYNCING}},
+		},
+		{
+			name:    "primary offline, backup valid",
+			quorum:  1,
+			primary: &fakeEngineClient{err: errors.New("connection refused")},
+			backups: []*fakeEngineClient{{status: pb.PayloadStatus_VALID}},
+		},
+		{
+			name:    "no quorum",
+			quorum:  2,
+			primary: &fakeEngineClient{status: pb.PayloadStatus_VALID},
+			backups: []*fakeEngineClient{{status: pb.PayloadStatus_SYNCING}, {err: errors.New("timeout")}},
+			wantErr: ErrAcceptedSyncingPayloadStatus,
+		},
+		{
+			name:    "disagreement without quorum",
+			quorum:  2,
+			primary: &fakeEngineClient{status: pb.PayloadStatus_VALID},
+			backups: []*fakeEngineClient{{status: pb.PayloadStatus_INVALID}},
+			wantErr: ErrAcceptedSyncingPayloadStatus,
+		},
+		{
+			name:    "invalid quorum",
+			quorum:  2,
+			primary: &fakeEngineClient{status: pb.PayloadStatus_VALID},
+			backups: []*fakeEngineClient{{status: pb.PayloadStatus_INVALID}, {status: pb.PayloadStatus_INVALID}},
+			wantErr: ErrInvalidPayloadStatus,
+		},
+		{
+			name:    "all offline",
+			quorum:  1,
+			primary: &fakeEngineClient{err: errors.New("connection refused")},
+			backups: []*fakeEngineClient{{err: errors.New("timeout")}},
+			wantErr: errors.New("connection refused"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newMultiEngineService(tt.quorum, append([]*fakeEngineClient{tt.primary}, tt.backups...)...)
+			_, err := s.NewPayload(ctx, payload, nil, nil)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, tt.wantErr.Error(), err)
+			}
+		})
+	}
+}
+
+func TestService_ForkchoiceUpdated_GetPayload(t *testing.T) {
+	ctx := context.Background()
+	primaryID, backupID := pb.PayloadIDBytes{1}, pb.PayloadIDBytes{2}
+	primary := &fakeEngineClient{status: pb.PayloadStatus_VALID, payloadID: &primaryID}
+	backup := &fakeEngineClient{
+		status:     pb.PayloadStatus_VALID,
+		payloadID:  &backupID,
+		getPayload: map[pb.PayloadIDBytes]bool{backupID: true},
+	}
+	s := newMultiEngineService(2, primary, backup)
+
+	attrs, err := payloadattribute.New(&pb.PayloadAttributes{})
+	require.NoError(t, err)
+	id, _, err := s.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{}, attrs)
+	require.NoError(t, err)
+	assert.DeepEqual(t, primaryID, *id)
+
+	// The primary engine lost the payload, which is then taken from the backup engine by its own payload ID.
+	ed, _, _, err := s.GetPayload(ctx, *id, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), ed.BlockNumber())
+
+	_, _, _, err = s.GetPayload(ctx, [8]byte{3}, 0)
+	require.ErrorContains(t, "unknown payload", err)
+
+	statuses := s.EngineStatuses()
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, true, statuses[0].Primary)
+	assert.Equal(t, EngineStatusSynced, statuses[0].Status)
+	assert.Equal(t, "backup0", statuses[1].Endpoint)
+	assert.Equal(t, false, statuses[1].Primary)
+}
+
+func TestService_ForkchoiceUpdated_GetPayloadFromLateEngine(t *testing.T) {
+	ctx := context.Background()
+	primaryID, backupID := pb.PayloadIDBytes{1}, pb.PayloadIDBytes{2}
+	primary := &fakeEngineClient{status: pb.PayloadStatus_VALID, payloadID: &primaryID}
+	backup := &fakeEngineClient{
+		status:     pb.PayloadStatus_VALID,
+		payloadID:  &backupID,
+		getPayload: map[pb.PayloadIDBytes]bool{backupID: true},
+		block:      make(chan struct{}),
+	}
+	s := newMultiEngineService(1, primary, backup)
+
+	attrs, err := payloadattribute.New(&pb.PayloadAttributes{})
+	require.NoError(t, err)
+	id, _, err := s.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{}, attrs)
+	require.NoError(t, err)
+	assert.DeepEqual(t, primaryID, *id)
+
+	// The backup engine answers the forkchoice update after it returned.
+	close(backup.block)
+	for i := 0; i < 100 && s.EngineStatuses()[1].Status != EngineStatusSynced; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, EngineStatusSynced, s.EngineStatuses()[1].Status)
+
+	// The primary engine lost the payload, which is then taken from the backup engine by its own payload ID.
+	ed, _, _, err := s.GetPayload(ctx, *id, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), ed.BlockNumber())
+}
+
+func TestService_NewPayload_DoesNotWaitForAllEngines(t *testing.T) {
+	ctx := context.Background()
+	payload, err := blocks.WrappedExecutionPayload(&pb.ExecutionPayload{})
+	require.NoError(t, err)
+
+	t.Run("quorum reached", func(t *testing.T) {
+		slow := &fakeEngineClient{status: pb.PayloadStatus_VALID, block: make(chan struct{})}
+		s := newMultiEngineService(2,
+			&fakeEngineClient{status: pb.PayloadStatus_VALID},
+			slow,
+			&fakeEngineClient{status: pb.PayloadStatus_VALID},
+		)
+		_, err := s.NewPayload(ctx, payload, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, EngineStatusUnknown, s.EngineStatuses()[1].Status)
+
+		// The call to the slow engine completes in the background.
+		close(slow.block)
+		for i := 0; i < 100 && s.EngineStatuses()[1].Status != EngineStatusSynced; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.Equal(t, EngineStatusSynced, s.EngineStatuses()[1].Status)
+	})
+	t.Run("primary answered without a quorum configured", func(t *testing.T) {
+		slow := &fakeEngineClient{status: pb.PayloadStatus_VALID, block: make(chan struct{})}
+		defer close(slow.block)
+		s := newMultiEngineService(0, &fakeEngineClient{status: pb.PayloadStatus_SYNCING}, slow)
+		_, err := s.NewPayload(ctx, payload, nil, nil)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	})
+	t.Run("offline engines are not waited for", func(t *testing.T) {
+		offline := &fakeEngineClient{status: pb.PayloadStatus_VALID, block: make(chan struct{})}
+		defer close(offline.block)
+		s := newMultiEngineService(2, &fakeEngineClient{status: pb.PayloadStatus_VALID}, offline)
+		s.engineEndpoints[1].update(NewPayloadMethod, EngineStatusOffline, 0, errors.New("timeout"))
+		_, err := s.NewPayload(ctx, payload, nil, nil)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	})
+}
+
+func TestService_SetupBackupEngines(t *testing.T) {
+	s := &Service{cfg: &config{engineQuorum: 3}}
+	require.ErrorContains(t, "quorum 3 is larger than the 1 execution engines", s.setupBackupEngines(context.Background()))
+
+	s = &Service{cfg: &config{}, rpcClient: &fakeEngineClient{status: pb.PayloadStatus_SYNCING}}
+	require.NoError(t, s.setupBackupEngines(context.Background()))
+	require.Equal(t, 1, len(s.EngineStatuses()))
+	assert.Equal(t, EngineStatusUnknown, s.EngineStatuses()[0].Status)
+
+	// The status of the primary engine is kept between engine API calls.
+	payload, err := blocks.WrappedExecutionPayload(&pb.ExecutionPayload{})
+	require.NoError(t, err)
+	_, err = s.NewPayload(context.Background(), payload, nil, nil)
+	require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	statuses := s.EngineStatuses()
+	require.Equal(t, 1, len(statuses))
+	assert.Equal(t, EngineStatusSyncing, statuses[0].Status)
+	assert.Equal(t, true, statuses[0].Primary)
+	assert.Equal(t, false, statuses[0].LastUpdated.IsZero())
+}
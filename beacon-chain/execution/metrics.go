@@ -71,4 +71,16 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	engineEndpointLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "execution_engine_endpoint_latency_milliseconds",
+			Help:    "Captures the engine API latency of each execution engine endpoint in milliseconds",
+			Buckets: []float64{25, 50, 100, 200, 500, 1000, 2000, 4000},
+		},
+		[]string{"endpoint", "method"},
+	)
+	engineEndpointStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "execution_engine_endpoint_status",
+		Help: "The status of each execution engine endpoint from its last engine API call: 0 offline, 1 syncing, 2 synced",
+	}, []string{"endpoint"})
 )
@@ -0,0 +1,265 @@
+// Package mockengine is an in-process stand-in for an execution client, answering the engine API calls of the
+// beacon node with empty payloads so that a beacon-chain-only devnet can run without an execution client.
+package mockengine
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gasLimit is the gas limit of all the blocks.
+	gasLimit = 30_000_000
+	// initialBaseFee is the base fee of the first post-merge block.
+	initialBaseFee = 1_000_000_000
+	// baseFeeChangeDenominator bounds the base fee change between blocks, as in EIP-1559.
+	baseFeeChangeDenominator = 8
+	// maxPayloads is the number of built payloads kept for engine_getPayload.
+	maxPayloads = 32
+)
+
+var extraData = []byte("prysm mock engine")
+
+// block is an execution block with its total difficulty.
+type block struct {
+	*gethTypes.Block
+	td *big.Int
+}
+
+// Engine is an in-process execution engine. Its chain starts with a proof-of-work genesis block and a terminal
+// block reaching the terminal total difficulty, after which it builds empty payloads with correct block hashes
+// and withdrawals roots. Payloads are not executed: a payload is valid when its block hash is correct and it
+// extends a known block.
+type Engine struct {
+	lock       sync.RWMutex
+	chainID    *big.Int
+	blocks     map[common.Hash]*block
+	canonical  map[uint64]common.Hash
+	head       common.Hash
+	safe       common.Hash
+	finalized  common.Hash
+	payloads   map[engine.PayloadID]*engine.ExecutionPayloadEnvelope
+	payloadIDs []engine.PayloadID
+}
+
+// New returns an execution engine for the chain ID and terminal total difficulty of the beacon chain config.
+func New() (*Engine, error) {
+	cfg := params.BeaconConfig()
+	ttd, ok := new(big.Int).SetString(cfg.TerminalTotalDifficulty, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid terminal total difficulty %s", cfg.TerminalTotalDifficulty)
+	}
+	// The terminal block's parent must be below the terminal total difficulty.
+	if ttd.Sign() == 0 {
+		ttd = big.NewInt(1)
+	}
+	e := &Engine{
+		chainID:   new(big.Int).SetUint64(cfg.DepositChainID),
+		blocks:    make(map[common.Hash]*block),
+		canonical: make(map[uint64]common.Hash),
+		payloads:  make(map[engine.PayloadID]*engine.ExecutionPayloadEnvelope),
+	}
+	genesis := gethTypes.NewBlockWithHeader(powHeader(common.Hash{}, 0, common.Big0))
+	terminal := gethTypes.NewBlockWithHeader(powHeader(genesis.Hash(), 1, ttd))
+	e.blocks[genesis.Hash()] = &block{Block: genesis, td: common.Big0}
+	e.blocks[terminal.Hash()] = &block{Block: terminal, td: ttd}
+	e.setHead(terminal.Hash())
+	log.WithFields(logrus.Fields{
+		"chainID":                 e.chainID,
+		"terminalBlockHash":       terminal.Hash(),
+		"terminalTotalDifficulty": ttd,
+	}).Warn("Using a mock execution engine building empty payloads, for testing only")
+	return e, nil
+}
+
+func powHeader(parent common.Hash, number uint64, difficulty *big.Int) *gethTypes.Header {
+	return &gethTypes.Header{
+		ParentHash:  parent,
+		UncleHash:   gethTypes.EmptyUncleHash,
+		Root:        gethTypes.EmptyRootHash,
+		TxHash:      gethTypes.EmptyTxsHash,
+		ReceiptHash: gethTypes.EmptyReceiptsHash,
+		Difficulty:  difficulty,
+		Number:      new(big.Int).SetUint64(number),
+		GasLimit:    gasLimit,
+		Time:        number,
+		Extra:       extraData,
+	}
+}
+
+// Server returns a JSON-RPC server answering the engine API calls, and the eth calls made by the beacon node.
+func (e *Engine) Server() (*gethRPC.Server, error) {
+	srv := gethRPC.NewServer()
+	if err := srv.RegisterName("engine", &engineAPI{e: e}); err != nil {
+		return nil, errors.Wrap(err, "could not register engine API")
+	}
+	if err := srv.RegisterName("eth", &ethAPI{e: e}); err != nil {
+		return nil, errors.Wrap(err, "could not register eth API")
+	}
+	return srv, nil
+}
+
+// setHead makes the block with the given hash the head of the canonical chain. The lock must be held.
+func (e *Engine) setHead(hash common.Hash) {
+	b := e.blocks[hash]
+	for n := b.NumberU64() + 1; ; n++ {
+		if _, ok := e.canonical[n]; !ok {
+			break
+		}
+		delete(e.canonical, n)
+	}
+	for b != nil && e.canonical[b.NumberU64()] != b.Hash() {
+		e.canonical[b.NumberU64()] = b.Hash()
+		b = e.blocks[b.ParentHash()]
+	}
+	e.head = hash
+}
+
+// insert validates a payload against its parent and inserts it, returning the status of the payload.
+func (e *Engine) insert(data engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash) engine.PayloadStatusV1 {
+	b, err := engine.ExecutableDataToBlock(data, versionedHashes, beaconRoot)
+	if err != nil {
+		return invalidStatus(nil, err)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if _, ok := e.blocks[b.Hash()]; ok {
+		return validStatus(b.Hash())
+	}
+	parent, ok := e.blocks[b.ParentHash()]
+	if !ok {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}
+	}
+	parentHash := parent.Hash()
+	if b.NumberU64() != parent.NumberU64()+1 {
+		return invalidStatus(&parentHash, errors.Errorf("block number %d does not follow parent number %d", b.NumberU64(), parent.NumberU64()))
+	}
+	if b.Time() <= parent.Time() {
+		return invalidStatus(&parentHash, errors.Errorf("block timestamp %d is not after parent timestamp %d", b.Time(), parent.Time()))
+	}
+	e.blocks[b.Hash()] = &block{Block: b, td: parent.td}
+	return validStatus(b.Hash())
+}
+
+// forkchoiceUpdated updates the forkchoice and builds a payload on the head when attributes are given.
+func (e *Engine) forkchoiceUpdated(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	head, ok := e.blocks[state.HeadBlockHash]
+	if !ok {
+		return engine.STATUS_SYNCING, nil
+	}
+	for _, hash := range []common.Hash{state.SafeBlockHash, state.FinalizedBlockHash} {
+		if _, ok := e.blocks[hash]; hash != (common.Hash{}) && !ok {
+			return engine.STATUS_INVALID, engine.InvalidForkChoiceState.With(errors.Errorf("unknown block %#x", hash))
+		}
+	}
+	e.setHead(head.Hash())
+	e.safe = state.SafeBlockHash
+	e.finalized = state.FinalizedBlockHash
+
+	resp := engine.ForkChoiceResponse{PayloadStatus: validStatus(head.Hash())}
+	if attrs == nil {
+		return resp, nil
+	}
+	if attrs.Timestamp <= head.Time() {
+		return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(
+			errors.Errorf("timestamp %d is not after head timestamp %d", attrs.Timestamp, head.Time()))
+	}
+	id, envelope := buildPayload(head, attrs)
+	if _, ok := e.payloads[id]; !ok {
+		e.payloadIDs = append(e.payloadIDs, id)
+		if len(e.payloadIDs) > maxPayloads {
+			delete(e.payloads, e.payloadIDs[0])
+			e.payloadIDs = e.payloadIDs[1:]
+		}
+	}
+	e.payloads[id] = envelope
+	resp.PayloadID = &id
+	return resp, nil
+}
+
+// buildPayload builds an empty payload on the parent, with the withdrawals of the attributes.
+func buildPayload(parent *block, attrs *engine.PayloadAttributes) (engine.PayloadID, *engine.ExecutionPayloadEnvelope) {
+	header := &gethTypes.Header{
+		ParentHash:       parent.Hash(),
+		UncleHash:        gethTypes.EmptyUncleHash,
+		Coinbase:         attrs.SuggestedFeeRecipient,
+		Root:             parent.Root(),
+		TxHash:           gethTypes.EmptyTxsHash,
+		ReceiptHash:      gethTypes.EmptyReceiptsHash,
+		Difficulty:       common.Big0,
+		Number:           new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:         parent.GasLimit(),
+		Time:             attrs.Timestamp,
+		Extra:            extraData,
+		MixDigest:        attrs.Random,
+		BaseFee:          nextBaseFee(parent.Header()),
+		ParentBeaconRoot: attrs.BeaconRoot,
+	}
+	if attrs.Withdrawals != nil {
+		root := gethTypes.DeriveSha(gethTypes.Withdrawals(attrs.Withdrawals), trie.NewStackTrie(nil))
+		header.WithdrawalsHash = &root
+	}
+	if attrs.BeaconRoot != nil {
+		var blobGasUsed, excessBlobGas uint64
+		header.BlobGasUsed = &blobGasUsed
+		header.ExcessBlobGas = &excessBlobGas
+	}
+	b := gethTypes.NewBlockWithHeader(header).WithWithdrawals(attrs.Withdrawals)
+	envelope := engine.BlockToExecutableData(b, common.Big0, nil /* no blobs */)
+
+	// The same payload being built for the same parent and attributes, the payload ID is derived from its hash.
+	var id engine.PayloadID
+	copy(id[:], b.Hash().Bytes())
+	return id, envelope
+}
+
+// nextBaseFee returns the EIP-1559 base fee of the child of the parent block.
+func nextBaseFee(parent *gethTypes.Header) *big.Int {
+	if parent.BaseFee == nil {
+		return big.NewInt(initialBaseFee)
+	}
+	target := new(big.Int).SetUint64(parent.GasLimit / 2)
+	used := new(big.Int).SetUint64(parent.GasUsed)
+	delta := new(big.Int).Sub(used, target)
+	delta.Mul(delta, parent.BaseFee)
+	delta.Quo(delta, target)
+	delta.Quo(delta, big.NewInt(baseFeeChangeDenominator))
+	if delta.Sign() > 0 && delta.Cmp(common.Big1) < 0 {
+		delta = common.Big1
+	}
+	fee := new(big.Int).Add(parent.BaseFee, delta)
+	if fee.Sign() < 0 {
+		return common.Big0
+	}
+	return fee
+}
+
+func (e *Engine) payload(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	envelope, ok := e.payloads[id]
+	if !ok {
+		return nil, engine.UnknownPayload
+	}
+	return envelope, nil
+}
+
+func validStatus(hash common.Hash) engine.PayloadStatusV1 {
+	return engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}
+}
+
+func invalidStatus(latestValid *common.Hash, err error) engine.PayloadStatusV1 {
+	msg := err.Error()
+	return engine.PayloadStatusV1{Status: engine.INVALID, LatestValidHash: latestValid, ValidationError: &msg}
+}
@@ -0,0 +1,165 @@
+package mockengine
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// maxPayloadBodies is the maximum number of payload bodies requested at once.
+const maxPayloadBodies = 1024
+
+// capabilities are the engine API methods of the engine.
+var capabilities = []string{
+	"engine_newPayloadV1",
+	"engine_newPayloadV2",
+	"engine_newPayloadV3",
+	"engine_forkchoiceUpdatedV1",
+	"engine_forkchoiceUpdatedV2",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1",
+	"engine_getPayloadV2",
+	"engine_getPayloadV3",
+	"engine_getPayloadBodiesByHashV1",
+	"engine_getPayloadBodiesByRangeV1",
+}
+
+// engineAPI is the engine namespace of the JSON-RPC server.
+type engineAPI struct {
+	e *Engine
+}
+
+// NewPayloadV1 answers engine_newPayloadV1.
+func (api *engineAPI) NewPayloadV1(data engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	if data.Withdrawals != nil {
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("withdrawals not supported in V1"))
+	}
+	return api.e.insert(data, nil, nil), nil
+}
+
+// NewPayloadV2 answers engine_newPayloadV2.
+func (api *engineAPI) NewPayloadV2(data engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	if data.BlobGasUsed != nil || data.ExcessBlobGas != nil {
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("blob gas not supported in V2"))
+	}
+	return api.e.insert(data, nil, nil), nil
+}
+
+// NewPayloadV3 answers engine_newPayloadV3.
+func (api *engineAPI) NewPayloadV3(data engine.ExecutableData, versionedHashes []common.Hash, beaconRoot *common.Hash) (engine.PayloadStatusV1, error) {
+	switch {
+	case data.Withdrawals == nil:
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("nil withdrawals post-shanghai"))
+	case data.BlobGasUsed == nil || data.ExcessBlobGas == nil:
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("nil blob gas post-cancun"))
+	case versionedHashes == nil:
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("nil versioned hashes post-cancun"))
+	case beaconRoot == nil:
+		return engine.PayloadStatusV1{}, engine.InvalidParams.With(errors.New("nil parent beacon block root post-cancun"))
+	}
+	return api.e.insert(data, versionedHashes, beaconRoot), nil
+}
+
+// ForkchoiceUpdatedV1 answers engine_forkchoiceUpdatedV1.
+func (api *engineAPI) ForkchoiceUpdatedV1(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	if attrs != nil && (attrs.Withdrawals != nil || attrs.BeaconRoot != nil) {
+		return engine.STATUS_INVALID, engine.InvalidParams.With(errors.New("withdrawals and beacon root not supported in V1"))
+	}
+	return api.e.forkchoiceUpdated(state, attrs)
+}
+
+// ForkchoiceUpdatedV2 answers engine_forkchoiceUpdatedV2.
+func (api *engineAPI) ForkchoiceUpdatedV2(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	if attrs != nil && attrs.BeaconRoot != nil {
+		return engine.STATUS_INVALID, engine.InvalidParams.With(errors.New("beacon root not supported in V2"))
+	}
+	return api.e.forkchoiceUpdated(state, attrs)
+}
+
+// ForkchoiceUpdatedV3 answers engine_forkchoiceUpdatedV3.
+func (api *engineAPI) ForkchoiceUpdatedV3(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	if attrs != nil && (attrs.Withdrawals == nil || attrs.BeaconRoot == nil) {
+		return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(errors.New("missing withdrawals or beacon root"))
+	}
+	return api.e.forkchoiceUpdated(state, attrs)
+}
+
+// GetPayloadV1 answers engine_getPayloadV1.
+func (api *engineAPI) GetPayloadV1(id engine.PayloadID) (*engine.ExecutableData, error) {
+	envelope, err := api.e.payload(id)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.ExecutionPayload, nil
+}
+
+// GetPayloadV2 answers engine_getPayloadV2.
+func (api *engineAPI) GetPayloadV2(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	envelope, err := api.e.payload(id)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ExecutionPayloadEnvelope{ExecutionPayload: envelope.ExecutionPayload, BlockValue: envelope.BlockValue}, nil
+}
+
+// GetPayloadV3 answers engine_getPayloadV3.
+func (api *engineAPI) GetPayloadV3(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	return api.e.payload(id)
+}
+
+// ExchangeCapabilities answers engine_exchangeCapabilities.
+func (*engineAPI) ExchangeCapabilities([]string) []string {
+	return capabilities
+}
+
+// GetPayloadBodiesByHashV1 answers engine_getPayloadBodiesByHashV1.
+func (api *engineAPI) GetPayloadBodiesByHashV1(hashes []common.Hash) ([]*engine.ExecutionPayloadBodyV1, error) {
+	if len(hashes) > maxPayloadBodies {
+		return nil, engine.TooLargeRequest.With(errors.New("too many payload bodies requested"))
+	}
+	api.e.lock.RLock()
+	defer api.e.lock.RUnlock()
+	bodies := make([]*engine.ExecutionPayloadBodyV1, len(hashes))
+	for i, hash := range hashes {
+		bodies[i] = payloadBody(api.e.blocks[hash])
+	}
+	return bodies, nil
+}
+
+// GetPayloadBodiesByRangeV1 answers engine_getPayloadBodiesByRangeV1.
+func (api *engineAPI) GetPayloadBodiesByRangeV1(start, count hexutil.Uint64) ([]*engine.ExecutionPayloadBodyV1, error) {
+	if start == 0 || count == 0 {
+		return nil, engine.InvalidParams.With(errors.New("start and count must be positive"))
+	}
+	if count > maxPayloadBodies {
+		return nil, engine.TooLargeRequest.With(errors.New("too many payload bodies requested"))
+	}
+	api.e.lock.RLock()
+	defer api.e.lock.RUnlock()
+	bodies := make([]*engine.ExecutionPayloadBodyV1, 0, count)
+	for n := uint64(start); n < uint64(start+count); n++ {
+		hash, ok := api.e.canonical[n]
+		if !ok {
+			break
+		}
+		bodies = append(bodies, payloadBody(api.e.blocks[hash]))
+	}
+	return bodies, nil
+}
+
+func payloadBody(b *block) *engine.ExecutionPayloadBodyV1 {
+	if b == nil {
+		return nil
+	}
+	txs := make([]hexutil.Bytes, len(b.Transactions()))
+	for i, tx := range b.Transactions() {
+		enc, err := tx.MarshalBinary()
+		if err != nil {
+			return nil
+		}
+		txs[i] = enc
+	}
+	return &engine.ExecutionPayloadBodyV1{TransactionData: txs, Withdrawals: b.Withdrawals()}
+}
@@ -0,0 +1,207 @@
+package mockengine
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type forkchoiceResponse struct {
+	Status    *pb.PayloadStatus  `json:"payloadStatus"`
+	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
+}
+
+func newTestEngine(t *testing.T) *gethRPC.Client {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.TerminalTotalDifficulty = "100"
+	cfg.DepositChainID = 1337
+	params.OverrideBeaconConfig(cfg)
+
+	e, err := New()
+	require.NoError(t, err)
+	srv, err := e.Server()
+	require.NoError(t, err)
+	client := gethRPC.DialInProc(srv)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func forkchoiceState(head []byte) *pb.ForkchoiceState {
+	return &pb.ForkchoiceState{HeadBlockHash: head, SafeBlockHash: make([]byte, 32), FinalizedBlockHash: make([]byte, 32)}
+}
+
+func latestBlock(t *testing.T, client *gethRPC.Client) *pb.ExecutionBlock {
+	blk := &pb.ExecutionBlock{}
+	require.NoError(t, client.CallContext(context.Background(), blk, "eth_getBlockByNumber", "latest", false))
+	return blk
+}
+
+func TestEngine_MergeTransitionAndForks(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEngine(t)
+
+	// The terminal block reaches the terminal total difficulty, unlike its parent.
+	terminal := latestBlock(t, client)
+	assert.Equal(t, uint64(1), terminal.Number.Uint64())
+	assert.Equal(t, "0x64", terminal.TotalDifficulty)
+	parent := &pb.ExecutionBlock{}
+	require.NoError(t, client.CallContext(ctx, parent, "eth_getBlockByHash", terminal.ParentHash, false))
+	assert.Equal(t, "0x0", parent.TotalDifficulty)
+
+	// Bellatrix merge block.
+	resp := &forkchoiceResponse{}
+	require.NoError(t, client.CallContext(ctx, resp, "engine_forkchoiceUpdatedV1", forkchoiceState(terminal.Hash[:]), &pb.PayloadAttributes{
+		Timestamp:             12,
+		PrevRandao:            bytesutil.PadTo([]byte{1}, 32),
+		SuggestedFeeRecipient: bytesutil.PadTo([]byte{2}, 20),
+	}))
+	assert.Equal(t, pb.PayloadStatus_VALID, resp.Status.Status)
+	require.NotNil(t, resp.PayloadId)
+	bellatrix := &pb.ExecutionPayload{}
+	require.NoError(t, client.CallContext(ctx, bellatrix, "engine_getPayloadV1", resp.PayloadId))
+	assert.DeepEqual(t, terminal.Hash[:], bellatrix.ParentHash)
+	assert.Equal(t, uint64(2), bellatrix.BlockNumber)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{2}, 20), bellatrix.FeeRecipient)
+	status := &pb.PayloadStatus{}
+	require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV1", bellatrix))
+	assert.Equal(t, pb.PayloadStatus_VALID, status.Status)
+	assert.DeepEqual(t, bellatrix.BlockHash, status.LatestValidHash)
+
+	// Capella block with withdrawals.
+	withdrawals := []*pb.Withdrawal{{Index: 1, ValidatorIndex: 2, Address: bytesutil.PadTo([]byte{3}, 20), Amount: 4}}
+	resp = &forkchoiceResponse{}
+	require.NoError(t, client.CallContext(ctx, resp, "engine_forkchoiceUpdatedV2", forkchoiceState(bellatrix.BlockHash), &pb.PayloadAttributesV2{
+		Timestamp:             24,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: make([]byte, 20),
+		Withdrawals:           withdrawals,
+	}))
+	capella := &pb.ExecutionPayloadCapellaWithValue{}
+	require.NoError(t, client.CallContext(ctx, capella, "engine_getPayloadV2", resp.PayloadId))
+	assert.DeepEqual(t, withdrawals, capella.Payload.Withdrawals)
+	status = &pb.PayloadStatus{}
+	require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV2", capella.Payload))
+	assert.Equal(t, pb.PayloadStatus_VALID, status.Status)
+
+	// Deneb block.
+	resp = &forkchoiceResponse{}
+	beaconRoot := bytesutil.PadTo([]byte{5}, 32)
+	require.NoError(t, client.CallContext(ctx, resp, "engine_forkchoiceUpdatedV3", forkchoiceState(capella.Payload.BlockHash), &pb.PayloadAttributesV3{
+		Timestamp:             36,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: make([]byte, 20),
+		Withdrawals:           []*pb.Withdrawal{},
+		ParentBeaconBlockRoot: beaconRoot,
+	}))
+	deneb := &pb.ExecutionPayloadDenebWithValueAndBlobsBundle{}
+	require.NoError(t, client.CallContext(ctx, deneb, "engine_getPayloadV3", resp.PayloadId))
+	assert.Equal(t, 0, len(deneb.BlobsBundle.KzgCommitments))
+	status = &pb.PayloadStatus{}
+	require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV3", deneb.Payload, []common.Hash{}, common.BytesToHash(beaconRoot)))
+	assert.Equal(t, pb.PayloadStatus_VALID, status.Status)
+
+	resp = &forkchoiceResponse{}
+	require.NoError(t, client.CallContext(ctx, resp, "engine_forkchoiceUpdatedV3", forkchoiceState(deneb.Payload.BlockHash), nil))
+	assert.Equal(t, pb.PayloadStatus_VALID, resp.Status.Status)
+	assert.Equal(t, uint64(4), latestBlock(t, client).Number.Uint64())
+
+	// Payload bodies of the canonical chain.
+	var bodies []*pb.ExecutionPayloadBodyV1
+	require.NoError(t, client.CallContext(ctx, &bodies, "engine_getPayloadBodiesByRangeV1", hexutil.Uint64(2), hexutil.Uint64(10)))
+	require.Equal(t, 3, len(bodies))
+	assert.DeepEqual(t, withdrawals, bodies[1].Withdrawals)
+	bodies = nil
+	require.NoError(t, client.CallContext(ctx, &bodies, "engine_getPayloadBodiesByHashV1", []common.Hash{
+		common.BytesToHash(capella.Payload.BlockHash), {0x01},
+	}))
+	require.Equal(t, 2, len(bodies))
+	assert.DeepEqual(t, withdrawals, bodies[0].Withdrawals)
+	assert.Equal(t, true, bodies[1] == nil)
+}
+
+func TestEngine_InvalidPayloads(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEngine(t)
+	terminal := latestBlock(t, client)
+
+	resp := &forkchoiceResponse{}
+	require.NoError(t, client.CallContext(ctx, resp, "engine_forkchoiceUpdatedV1", forkchoiceState(terminal.Hash[:]), &pb.PayloadAttributes{
+		Timestamp:             12,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: make([]byte, 20),
+	}))
+	payload := &pb.ExecutionPayload{}
+	require.NoError(t, client.CallContext(ctx, payload, "engine_getPayloadV1", resp.PayloadId))
+
+	t.Run("wrong block hash", func(t *testing.T) {
+		tampered := &pb.ExecutionPayload{}
+		require.NoError(t, json.Unmarshal(mustMarshal(t, payload), tampered))
+		tampered.GasUsed = 1
+		status := &pb.PayloadStatus{}
+		require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV1", tampered))
+		assert.Equal(t, pb.PayloadStatus_INVALID, status.Status)
+	})
+	t.Run("unknown parent", func(t *testing.T) {
+		unknown := &block{Block: gethTypes.NewBlockWithHeader(powHeader(common.Hash{0x01}, 5, common.Big1)), td: common.Big1}
+		_, envelope := buildPayload(unknown, &engine.PayloadAttributes{Timestamp: 12})
+		status := &pb.PayloadStatus{}
+		require.NoError(t, client.CallContext(ctx, status, "engine_newPayloadV1", envelope.ExecutionPayload))
+		assert.Equal(t, pb.PayloadStatus_SYNCING, status.Status)
+
+		resp := &forkchoiceResponse{}
+		require.NoError(t, client.CallContext(ctx, resp, "engine_forkchoiceUpdatedV1", forkchoiceState(unknown.Hash().Bytes()), nil))
+		assert.Equal(t, pb.PayloadStatus_SYNCING, resp.Status.Status)
+	})
+	t.Run("unknown payload", func(t *testing.T) {
+		err := client.CallContext(ctx, &pb.ExecutionPayload{}, "engine_getPayloadV1", pb.PayloadIDBytes{1})
+		require.ErrorContains(t, "Unknown payload", err)
+	})
+	t.Run("attributes timestamp", func(t *testing.T) {
+		err := client.CallContext(ctx, &forkchoiceResponse{}, "engine_forkchoiceUpdatedV1", forkchoiceState(terminal.Hash[:]), &pb.PayloadAttributes{
+			Timestamp:             1,
+			PrevRandao:            make([]byte, 32),
+			SuggestedFeeRecipient: make([]byte, 20),
+		})
+		require.ErrorContains(t, "Invalid payload attributes", err)
+	})
+}
+
+func TestEngine_EthCalls(t *testing.T) {
+	ctx := context.Background()
+	client := newTestEngine(t)
+	eth := ethclient.NewClient(client)
+
+	chainID, err := eth.ChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1337), chainID.Uint64())
+
+	caller, err := contracts.NewDepositContractCaller(common.HexToAddress("0x1234"), eth)
+	require.NoError(t, err)
+	count, err := caller.GetDepositCount(nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, 8), count)
+
+	var caps []string
+	require.NoError(t, client.CallContext(ctx, &caps, "engine_exchangeCapabilities", []string{"engine_newPayloadV1"}))
+	assert.DeepEqual(t, capabilities, caps)
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return b
+}
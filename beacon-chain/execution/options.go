@@ -2,9 +2,12 @@ package execution
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/recorder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/network"
@@ -37,6 +40,38 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithBackupEngineEndpoints for the execution engines engine API calls are sent to besides the primary execution
+// engine, authenticated with the same JWT secret.
+func WithBackupEngineEndpoints(endpointStrings []string, secret []byte) Option {
+	return func(s *Service) error {
+		endpoints := make([]network.Endpoint, 0, len(endpointStrings))
+		for _, endpointString := range endpointStrings {
+			if endpointString == "" {
+				continue
+			}
+			endpoint := network.HttpEndpoint(endpointString)
+			if len(secret) > 0 {
+				endpoint.Auth.Method = authorization.Bearer
+				endpoint.Auth.Value = string(secret)
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+		s.cfg.backupEngineEndpoints = endpoints
+		return nil
+	}
+}
+
+// WithEngineQuorum sets the number of execution engines which must agree on the validity of a payload.
+func WithEngineQuorum(quorum uint64) Option {
+	return func(s *Service) error {
+		if quorum == 0 {
+			return errors.New("execution engine quorum must be at least 1")
+		}
+		s.cfg.engineQuorum = quorum
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
@@ -115,3 +150,23 @@ func WithJwtId(jwtId string) Option {
 		return nil
 	}
 }
+
+// WithEngineRecording records every engine API call to the file at path, rotated every maxSizeMB megabytes.
+func WithEngineRecording(path string, maxSizeMB uint64) Option {
+	return func(s *Service) error {
+		if maxSizeMB == 0 {
+			return errors.New("engine API recording maximum size must be at least 1 MB")
+		}
+		s.cfg.engineRecorder = recorder.New(path, maxSizeMB)
+		return nil
+	}
+}
+
+// WithInProcessEngine connects to an in-process execution engine served by server, instead of the HTTP endpoint.
+func WithInProcessEngine(server *gethRPC.Server) Option {
+	return func(s *Service) error {
+		s.cfg.inProcessEngine = server
+		s.cfg.currHttpEndpoint = network.Endpoint{Url: inProcessEndpoint}
+		return nil
+	}
+}
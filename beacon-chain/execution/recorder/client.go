@@ -0,0 +1,79 @@
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+)
+
+// engineMethodPrefix is the prefix of the engine API methods, the only recorded calls.
+const engineMethodPrefix = "engine_"
+
+// RPCClient is the RPC client of an execution engine.
+type RPCClient interface {
+	Close()
+	BatchCall(b []gethRPC.BatchElem) error
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Client is an RPC client recording the engine API calls it makes. Other calls are made without being recorded.
+type Client struct {
+	RPCClient
+	endpoint string
+	primary  bool
+	recorder *Recorder
+}
+
+// NewClient returns a client recording the engine API calls made with client to the execution engine at endpoint,
+// which is the primary execution engine when primary is set.
+func NewClient(client RPCClient, endpoint string, primary bool, recorder *Recorder) *Client {
+	return &Client{RPCClient: client, endpoint: endpoint, primary: primary, recorder: recorder}
+}
+
+// CallContext makes an RPC call, recording the request and response of engine API calls.
+func (c *Client) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if !strings.HasPrefix(method, engineMethodPrefix) {
+		return c.RPCClient.CallContext(ctx, result, method, args...)
+	}
+	if args == nil {
+		args = []interface{}{}
+	}
+	params, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	e := &Entry{Time: time.Now(), Endpoint: c.endpoint, Primary: c.primary, Method: method, Params: params}
+	// The raw response is recorded as returned by the execution engine, before being decoded into the result.
+	var raw json.RawMessage
+	err = c.RPCClient.CallContext(ctx, &raw, method, args...)
+	e.Duration = time.Since(e.Time)
+	if err != nil {
+		e.Error = newError(err)
+	} else {
+		e.Result = raw
+		if result != nil {
+			err = json.Unmarshal(raw, result)
+		}
+	}
+	if recordErr := c.recorder.Record(e); recordErr != nil {
+		log.WithError(recordErr).WithField("method", method).Error("Could not record engine API call")
+	}
+	return err
+}
+
+func newError(err error) *Error {
+	e := &Error{Message: err.Error()}
+	var rpcErr gethRPC.Error
+	if errors.As(err, &rpcErr) {
+		e.Code = rpcErr.ErrorCode()
+	}
+	var dataErr gethRPC.DataError
+	if errors.As(err, &dataErr) {
+		e.Data = dataErr.ErrorData()
+	}
+	return e
+}
@@ -0,0 +1,47 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Diff returns the fields of a result which differ from the recorded result. A recorded error matches an error
+// with the same code. Differences of results which are not JSON objects are reported as a "result" field.
+func Diff(recorded *Entry, result json.RawMessage, err error) []string {
+	if recorded.Error != nil || err != nil {
+		if recorded.Error == nil || err == nil || recorded.Error.Code != newError(err).Code {
+			return []string{"error"}
+		}
+		return nil
+	}
+	var want, got interface{}
+	if json.Unmarshal(recorded.Result, &want) != nil || json.Unmarshal(result, &got) != nil {
+		if !bytes.Equal(recorded.Result, result) {
+			return []string{"result"}
+		}
+		return nil
+	}
+	wantFields, wantOk := want.(map[string]interface{})
+	gotFields, gotOk := got.(map[string]interface{})
+	if !wantOk || !gotOk {
+		if !reflect.DeepEqual(want, got) {
+			return []string{"result"}
+		}
+		return nil
+	}
+	var diff []string
+	for field, v := range wantFields {
+		if !reflect.DeepEqual(v, gotFields[field]) {
+			diff = append(diff, field)
+		}
+	}
+	for field := range gotFields {
+		if _, ok := wantFields[field]; !ok {
+			diff = append(diff, field)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
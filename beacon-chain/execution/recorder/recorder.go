@@ -0,0 +1,131 @@
+// Package recorder records the engine API calls made by the beacon node to its execution engines, and answers
+// engine API calls with recorded responses, so that execution engine incidents can be reproduced offline.
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// maxBackups is the number of rotated recording files kept besides the current one.
+const maxBackups = 10
+
+// Entry is an engine API call, as sent to an execution engine and answered by it. Primary is set for the calls sent to
+// the primary execution engine, as opposed to the backup execution engines.
+type Entry struct {
+	Time     time.Time       `json:"time"`
+	Endpoint string          `json:"endpoint"`
+	Primary  bool            `json:"primary,omitempty"`
+	Method   string          `json:"method"`
+	Params   json.RawMessage `json:"params"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Error    *Error          `json:"error,omitempty"`
+	Duration time.Duration   `json:"duration_ns"`
+}
+
+// Error is the error returned by an engine API call. A zero code means the execution engine could not be reached.
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *Error) ErrorCode() int {
+	return e.Code
+}
+
+// ErrorData returns the JSON-RPC error data.
+func (e *Error) ErrorData() interface{} {
+	return e.Data
+}
+
+// Recorder writes engine API calls to a file as JSON lines. The file is rotated once it reaches its maximum size.
+type Recorder struct {
+	out io.WriteCloser
+}
+
+// New returns a recorder writing to the file at path, rotated every maxSizeMB megabytes.
+func New(path string, maxSizeMB uint64) *Recorder {
+	return &Recorder{out: &lumberjack.Logger{
+		Filename:   filepath.Clean(path),
+		MaxSize:    int(maxSizeMB),
+		MaxBackups: maxBackups,
+	}}
+}
+
+// Record writes an engine API call.
+func (r *Recorder) Record(e *Entry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal engine API call")
+	}
+	// A single write per entry, the rotating file being safe for concurrent writes.
+	_, err = r.out.Write(append(b, '\n'))
+	return err
+}
+
+// Close closes the recording file.
+func (r *Recorder) Close() error {
+	return r.out.Close()
+}
+
+// ReadFile reads the engine API calls recorded in the file at path.
+func ReadFile(path string) ([]*Entry, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close recording file")
+		}
+	}()
+	return Read(f)
+}
+
+// Read reads engine API calls recorded as JSON lines.
+func Read(r io.Reader) ([]*Entry, error) {
+	var entries []*Entry
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		b, err := reader.ReadBytes('\n')
+		if len(b) > 0 && !(len(b) == 1 && b[0] == '\n') {
+			e := &Entry{}
+			if jsonErr := json.Unmarshal(b, e); jsonErr != nil {
+				return nil, errors.Wrapf(jsonErr, "could not parse recorded engine API call on line %d", line)
+			}
+			entries = append(entries, e)
+		}
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Filter returns the engine API calls recorded for the execution engine at endpoint, or for the primary execution
+// engine when endpoint is empty. A recording made with backup execution engines holds the calls to every engine.
+func Filter(entries []*Entry, endpoint string) []*Entry {
+	var filtered []*Entry
+	for _, e := range entries {
+		if (endpoint == "" && e.Primary) || (endpoint != "" && e.Endpoint == endpoint) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,171 @@
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+var recordedEntries = []*Entry{
+	{
+		Method: "engine_newPayloadV1",
+		Params: json.RawMessage(`[{"blockHash": "0x01"}]`),
+		Result: json.RawMessage(`{"status":"SYNCING","latestValidHash":null}`),
+	},
+	{
+		Method: "engine_newPayloadV1",
+		Params: json.RawMessage(`[{"blockHash": "0x01"}]`),
+		Result: json.RawMessage(`{"status":"VALID","latestValidHash":"0x01"}`),
+	},
+	{
+		Method: "engine_getPayloadV1",
+		Params: json.RawMessage(`["0x0000000000000001"]`),
+		Error:  &Error{Code: -38001, Message: "Unknown payload"},
+	},
+	{
+		Method: "engine_exchangeCapabilities",
+		Params: json.RawMessage(`[["engine_newPayloadV1"]]`),
+		Error:  &Error{Message: "connection refused"},
+	},
+}
+
+func newStubClient(t *testing.T, entries []*Entry) *gethRPC.Client {
+	srv := httptest.NewServer(NewStub(entries, 1337))
+	t.Cleanup(srv.Close)
+	client, err := gethRPC.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestStub(t *testing.T) {
+	ctx := context.Background()
+	client := newStubClient(t, recordedEntries)
+	payload := map[string]string{"blockHash": "0x01"}
+
+	// Recorded responses are given in order, the last one being repeated.
+	for _, want := range []string{"SYNCING", "VALID", "VALID"} {
+		resp := make(map[string]interface{})
+		require.NoError(t, client.CallContext(ctx, &resp, "engine_newPayloadV1", payload))
+		assert.Equal(t, want, resp["status"])
+	}
+
+	err := client.CallContext(ctx, nil, "engine_getPayloadV1", "0x0000000000000001")
+	var rpcErr gethRPC.Error
+	require.Equal(t, true, errors.As(err, &rpcErr))
+	assert.Equal(t, -38001, rpcErr.ErrorCode())
+
+	err = client.CallContext(ctx, nil, "engine_newPayloadV1", map[string]string{"blockHash": "0x02"})
+	require.Equal(t, true, errors.As(err, &rpcErr))
+	assert.Equal(t, errCodeNotRecorded, rpcErr.ErrorCode())
+
+	err = client.CallContext(ctx, nil, "engine_exchangeCapabilities", []string{"engine_newPayloadV1"})
+	require.ErrorContains(t, "503 Service Unavailable", err)
+}
+
+func TestStub_ChainCalls(t *testing.T) {
+	ctx := context.Background()
+	client := ethclient.NewClient(newStubClient(t, recordedEntries))
+
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1337), chainID.Uint64())
+	progress, err := client.SyncProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, progress == nil)
+	header, err := client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), header.Number.Uint64())
+	header, err = client.HeaderByNumber(ctx, big.NewInt(5))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), header.Number.Uint64())
+}
+
+func TestClient_Records(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "engine.jsonl")
+	r := New(path, 1)
+	client := NewClient(newStubClient(t, recordedEntries), "http://localhost:8551", true, r)
+
+	resp := make(map[string]interface{})
+	require.NoError(t, client.CallContext(ctx, &resp, "engine_newPayloadV1", map[string]string{"blockHash": "0x01"}))
+	assert.Equal(t, "SYNCING", resp["status"])
+	err := client.CallContext(ctx, nil, "engine_getPayloadV1", "0x0000000000000001")
+	require.ErrorContains(t, "Unknown payload", err)
+	// Calls other than engine API calls are not recorded.
+	var chainID string
+	require.NoError(t, client.CallContext(ctx, &chainID, "eth_chainId"))
+	assert.Equal(t, "0x539", chainID)
+	require.NoError(t, r.Close())
+
+	entries, err := ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, "http://localhost:8551", entries[0].Endpoint)
+	assert.Equal(t, true, entries[0].Primary)
+	assert.Equal(t, "engine_newPayloadV1", entries[0].Method)
+	assert.Equal(t, `[{"blockHash":"0x01"}]`, string(entries[0].Params))
+	assert.Equal(t, `{"status":"SYNCING","latestValidHash":null}`, string(entries[0].Result))
+	assert.Equal(t, false, entries[0].Time.IsZero())
+	assert.Equal(t, "engine_getPayloadV1", entries[1].Method)
+	require.NotNil(t, entries[1].Error)
+	assert.Equal(t, -38001, entries[1].Error.Code)
+	assert.Equal(t, 0, len(entries[1].Result))
+
+	// The recording answers the same calls when replayed.
+	client = NewClient(newStubClient(t, entries), "", false, New(filepath.Join(t.TempDir(), "replay.jsonl"), 1))
+	resp = make(map[string]interface{})
+	require.NoError(t, client.CallContext(ctx, &resp, "engine_newPayloadV1", map[string]string{"blockHash": "0x01"}))
+	assert.Equal(t, "SYNCING", resp["status"])
+}
+
+func TestFilter(t *testing.T) {
+	entries := []*Entry{
+		{Endpoint: "http://primary:8551", Primary: true, Method: "engine_newPayloadV1"},
+		{Endpoint: "http://backup:8551", Method: "engine_newPayloadV1"},
+		{Endpoint: "http://primary:8551", Primary: true, Method: "engine_forkchoiceUpdatedV1"},
+	}
+	primary := Filter(entries, "")
+	require.Equal(t, 2, len(primary))
+	assert.Equal(t, "engine_newPayloadV1", primary[0].Method)
+	assert.Equal(t, "engine_forkchoiceUpdatedV1", primary[1].Method)
+	backup := Filter(entries, "http://backup:8551")
+	require.Equal(t, 1, len(backup))
+	assert.Equal(t, "http://backup:8551", backup[0].Endpoint)
+	assert.Equal(t, 0, len(Filter(entries, "http://other:8551")))
+}
+
+func TestRead(t *testing.T) {
+	entries, err := Read(strings.NewReader(`{"method":"engine_forkchoiceUpdatedV1","params":[]}` + "\n\n" + `{"method":"engine_getPayloadV1"}`))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, "engine_getPayloadV1", entries[1].Method)
+
+	_, err = Read(strings.NewReader(`{"method":"engine_forkchoiceUpdatedV1"}` + "\n" + `{"method":`))
+	require.ErrorContains(t, "line 2", err)
+}
+
+func TestDiff(t *testing.T) {
+	recorded := recordedEntries[1]
+	assert.Equal(t, 0, len(Diff(recorded, json.RawMessage(`{"latestValidHash": "0x01", "status": "VALID"}`), nil)))
+	assert.DeepEqual(t, []string{"latestValidHash", "status", "validationError"},
+		Diff(recorded, json.RawMessage(`{"status":"INVALID","latestValidHash":"0x00","validationError":"bad"}`), nil))
+	assert.DeepEqual(t, []string{"error"}, Diff(recorded, nil, errors.New("timeout")))
+
+	recorded = recordedEntries[2]
+	assert.Equal(t, 0, len(Diff(recorded, nil, &Error{Code: -38001, Message: "unknown payload"})))
+	assert.DeepEqual(t, []string{"error"}, Diff(recorded, nil, &Error{Code: -32603}))
+	assert.DeepEqual(t, []string{"error"}, Diff(recorded, json.RawMessage(`{}`), nil))
+
+	assert.DeepEqual(t, []string{"result"}, Diff(&Entry{Result: json.RawMessage(`["a"]`)}, json.RawMessage(`["b"]`), nil))
+}
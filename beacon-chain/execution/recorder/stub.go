@@ -0,0 +1,192 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// errCodeNotRecorded is the JSON-RPC error code of calls without a recorded response.
+const errCodeNotRecorded = -32000
+
+type jsonrpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Stub is a JSON-RPC server answering engine API calls with recorded responses. A call is answered with the next
+// recorded response to the same method and parameters, and with the last one once all were given. Calls which
+// could not reach the execution engine when recorded are answered with a 503 status. The eth_chainId, eth_syncing
+// and eth_getBlockByNumber calls a beacon node makes when connecting, which are not recorded, are answered for the
+// chain ID of the stub.
+type Stub struct {
+	lock      sync.Mutex
+	chainID   uint64
+	responses map[string][]*Entry
+	served    map[string]int
+}
+
+// NewStub returns a stub answering with the responses of the recorded engine API calls, for the execution chain
+// with the given chain ID.
+func NewStub(entries []*Entry, chainID uint64) *Stub {
+	s := &Stub{chainID: chainID, responses: make(map[string][]*Entry), served: make(map[string]int)}
+	for _, e := range entries {
+		key := callKey(e.Method, e.Params)
+		s.responses[key] = append(s.responses[key], e)
+	}
+	return s
+}
+
+// ServeHTTP answers a JSON-RPC request or batch of requests.
+func (s *Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	body = bytes.TrimSpace(body)
+	batch := len(body) > 0 && body[0] == '['
+	var reqs []*jsonrpcRequest
+	if batch {
+		err = json.Unmarshal(body, &reqs)
+	} else {
+		req := &jsonrpcRequest{}
+		err = json.Unmarshal(body, req)
+		reqs = []*jsonrpcRequest{req}
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resps := make([]*jsonrpcResponse, len(reqs))
+	for i, req := range reqs {
+		e := s.next(req.Method, req.Params)
+		if e == nil {
+			e = s.chainCall(req.Method, req.Params)
+		}
+		switch {
+		case e == nil:
+			resps[i] = &jsonrpcResponse{Error: &Error{
+				Code:    errCodeNotRecorded,
+				Message: fmt.Sprintf("no recorded response to %s with these parameters", req.Method),
+			}}
+		case e.Error != nil && e.Error.Code == 0:
+			http.Error(w, e.Error.Message, http.StatusServiceUnavailable)
+			return
+		case e.Error != nil:
+			resps[i] = &jsonrpcResponse{Error: e.Error}
+		default:
+			resps[i] = &jsonrpcResponse{Result: e.Result}
+		}
+		resps[i].Version = "2.0"
+		resps[i].ID = req.ID
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	var resp interface{} = resps[0]
+	if batch {
+		resp = resps
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write engine API response")
+	}
+}
+
+func (s *Stub) next(method string, params json.RawMessage) *Entry {
+	key := callKey(method, params)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	responses := s.responses[key]
+	if len(responses) == 0 {
+		return nil
+	}
+	i := s.served[key]
+	if i >= len(responses) {
+		return responses[len(responses)-1]
+	}
+	s.served[key] = i + 1
+	return responses[i]
+}
+
+// chainCall answers the calls about the execution chain which are not recorded, or returns nil for other calls. Blocks
+// are answered with an empty block at the requested height, the latest block being the genesis block.
+func (s *Stub) chainCall(method string, params json.RawMessage) *Entry {
+	var result interface{}
+	switch method {
+	case "eth_chainId":
+		result = hexutil.Uint64(s.chainID)
+	case "eth_syncing":
+		result = false
+	case "eth_getBlockByNumber":
+		var args []json.RawMessage
+		if err := json.Unmarshal(params, &args); err != nil || len(args) == 0 {
+			return nil
+		}
+		number := new(big.Int)
+		var n hexutil.Big
+		if err := json.Unmarshal(args[0], &n); err == nil {
+			number = n.ToInt()
+		}
+		block, err := emptyBlock(number)
+		if err != nil {
+			return &Entry{Method: method, Error: &Error{Code: errCodeNotRecorded, Message: err.Error()}}
+		}
+		result = block
+	default:
+		return nil
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return &Entry{Method: method, Error: &Error{Code: errCodeNotRecorded, Message: err.Error()}}
+	}
+	return &Entry{Method: method, Result: b}
+}
+
+// emptyBlock returns the JSON-RPC representation of a block without transactions at the given height.
+func emptyBlock(number *big.Int) (map[string]interface{}, error) {
+	header := &gethTypes.Header{
+		UncleHash:   gethTypes.EmptyUncleHash,
+		Root:        gethTypes.EmptyRootHash,
+		TxHash:      gethTypes.EmptyTxsHash,
+		ReceiptHash: gethTypes.EmptyReceiptsHash,
+		Difficulty:  new(big.Int),
+		Number:      number,
+	}
+	b, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	block := make(map[string]interface{})
+	if err := json.Unmarshal(b, &block); err != nil {
+		return nil, err
+	}
+	block["totalDifficulty"] = (*hexutil.Big)(new(big.Int))
+	block["transactions"] = []interface{}{}
+	block["uncles"] = []interface{}{}
+	return block, nil
+}
+
+// callKey identifies the calls to a method with the same parameters, whatever the parameters JSON formatting.
+func callKey(method string, params json.RawMessage) string {
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, params); err != nil || compacted.Len() == 0 || compacted.String() == "null" {
+		return method + "[]"
+	}
+	return method + compacted.String()
+}
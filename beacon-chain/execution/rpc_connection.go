@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/recorder"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
@@ -17,14 +18,17 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/network/authorization"
 )
 
+// inProcessEndpoint is the endpoint of an in-process execution engine.
+const inProcessEndpoint = "inproc"
+
 func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpoint network.Endpoint) error {
-	client, err := s.newRPCClientWithAuth(ctx, currEndpoint)
+	client, err := s.dialExecutionClient(ctx, currEndpoint)
 	if err != nil {
 		return errors.Wrap(err, "could not dial execution node")
 	}
 	// Attach the clients to the service struct.
 	fetcher := ethclient.NewClient(client)
-	s.rpcClient = client
+	s.rpcClient = s.recordEngineCalls(currEndpoint, client, true)
 	s.httpLogger = fetcher
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.cfg.depositContractAddr, fetcher)
@@ -105,6 +109,14 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	s.runError = nil
 }
 
+// dialExecutionClient connects to the in-process execution engine when there is one, or else dials the endpoint.
+func (s *Service) dialExecutionClient(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
+	if s.cfg.inProcessEngine != nil {
+		return gethRPC.DialInProc(s.cfg.inProcessEngine), nil
+	}
+	return s.newRPCClientWithAuth(ctx, endpoint)
+}
+
 // Initializes an RPC connection with authentication headers.
 func (s *Service) newRPCClientWithAuth(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
 	headers := http.Header{}
@@ -129,6 +141,14 @@ func (s *Service) newRPCClientWithAuth(ctx context.Context, endpoint network.End
 	return network.NewExecutionRPCClient(ctx, endpoint, headers)
 }
 
+// recordEngineCalls returns a client recording the engine API calls made to the endpoint, when recording is enabled.
+func (s *Service) recordEngineCalls(endpoint network.Endpoint, client *gethRPC.Client, primary bool) RPCClient {
+	if s.cfg.engineRecorder == nil {
+		return client
+	}
+	return recorder.NewClient(client, logs.MaskCredentialsLogging(endpoint.Url), primary, s.cfg.engineRecorder)
+}
+
 // Checks the chain ID of the execution client to ensure
 // it matches local parameters of what Prysm expects.
 func ensureCorrectExecutionChain(ctx context.Context, client *ethclient.Client) error {
@@ -25,6 +25,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/recorder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
@@ -36,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/clientstats"
 	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -129,6 +131,10 @@ type config struct {
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 	jwtId                   string
+	backupEngineEndpoints   []network.Endpoint
+	engineQuorum            uint64
+	engineRecorder          *recorder.Recorder
+	inProcessEngine         *gethRPC.Server
 }
 
 // Service fetches important information about the canonical
@@ -156,6 +162,11 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	engineEndpoints         []*engineEndpoint
+	engineEndpointsOnce     sync.Once
+	payloadIDsLock          sync.RWMutex
+	payloadIDs              map[pb.PayloadIDBytes]map[*engineEndpoint]pb.PayloadIDBytes
+	payloadIDsOrder         []pb.PayloadIDBytes
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -207,6 +218,9 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			return nil, err
 		}
 	}
+	if err := s.setupBackupEngines(ctx); err != nil {
+		return nil, err
+	}
 
 	eth1Data, err := s.validPowchainData(ctx)
 	if err != nil {
@@ -253,6 +267,12 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	s.closeBackupEngines()
+	if s.cfg != nil && s.cfg.engineRecorder != nil {
+		if err := s.cfg.engineRecorder.Close(); err != nil {
+			log.WithError(err).Error("Could not close engine API recording")
+		}
+	}
 	return nil
 }
 
@@ -1,8 +1,11 @@
 package execution
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"math/big"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -17,16 +20,20 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositcache"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/recorder"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v5/contracts/deposit/mock"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/clientstats"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -853,3 +860,41 @@ func TestService_migrateOldDepositTree(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, oldDepositTreeRoot, newDepositTreeRoot)
 }
+
+func TestService_ConnectsToEngineReplayStub(t *testing.T) {
+	ctx := context.Background()
+	payload := &pb.ExecutionPayload{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    make([]byte, fieldparams.RootLength),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength),
+		Transactions:  [][]byte{},
+	}
+	newPayloadParams, err := json.Marshal([]interface{}{payload})
+	require.NoError(t, err)
+	stub := recorder.NewStub([]*recorder.Entry{{
+		Method: NewPayloadMethod,
+		Params: newPayloadParams,
+		Result: json.RawMessage(`{"status":"VALID","latestValidHash":"0x` + strings.Repeat("61", 32) + `"}`),
+	}}, params.BeaconConfig().DepositChainID)
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+
+	s, err := NewService(ctx, WithHttpEndpoint(srv.URL), WithDatabase(dbutil.SetupDB(t)))
+	require.NoError(t, err)
+	require.NoError(t, s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint))
+	assert.Equal(t, true, s.connectedETH1)
+
+	header, err := s.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), header.Number.Uint64())
+	wrapped, err := blocks.WrappedExecutionPayload(payload)
+	require.NoError(t, err)
+	lvh, err := s.NewPayload(ctx, wrapped, nil, nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytes.Repeat([]byte{'a'}, fieldparams.RootLength), lvh)
+}
@@ -0,0 +1,9 @@
+/*
+Package lightclient implements a light client following the beacon chain as specified in
+https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md.
+
+Starting from a trusted block root, it retrieves light client updates from a beacon node API,
+verifies the sync committee signatures and merkle proofs they carry, and exposes the resulting
+finalized and optimistic headers through a small local API, without having to run a full node.
+*/
+package lightclient
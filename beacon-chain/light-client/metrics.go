@@ -0,0 +1,21 @@
+package lightclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	finalizedSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "light_client_finalized_slot",
+		Help: "Slot of the latest finalized header verified by the light client.",
+	})
+	optimisticSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "light_client_optimistic_slot",
+		Help: "Slot of the latest optimistic header verified by the light client.",
+	})
+	processedUpdatesCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "light_client_processed_updates_total",
+		Help: "Number of light client updates processed, by outcome.",
+	}, []string{"outcome"})
+)
@@ -0,0 +1,37 @@
+package lightclient
+
+import "time"
+
+type Option func(s *Service) error
+
+// WithBeaconNodeHost sets the beacon node API the light client retrieves its updates from.
+func WithBeaconNodeHost(host string) Option {
+	return func(s *Service) error {
+		s.cfg.beaconNodeHost = host
+		return nil
+	}
+}
+
+// WithTrustedBlockRoot sets the block root the light client bootstraps from.
+func WithTrustedBlockRoot(root [32]byte) Option {
+	return func(s *Service) error {
+		s.cfg.trustedBlockRoot = root
+		return nil
+	}
+}
+
+// WithHTTPAddress sets the host:port the verified headers are served on.
+func WithHTTPAddress(addr string) Option {
+	return func(s *Service) error {
+		s.cfg.httpAddr = addr
+		return nil
+	}
+}
+
+// WithRequestTimeout sets the timeout of requests made to the beacon node API.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.requestTimeout = timeout
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package lightclient
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v5/proto/migration"
+)
+
+const (
+	finalizedHeaderPath  = "/prysm/v1/light_client/headers/finalized"
+	optimisticHeaderPath = "/prysm/v1/light_client/headers/optimistic"
+)
+
+func (s *Service) newMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(finalizedHeaderPath, s.GetFinalizedHeader)
+	mux.HandleFunc(optimisticHeaderPath, s.GetOptimisticHeader)
+	return mux
+}
+
+// GetFinalizedHeader returns the latest finalized header verified by the light client.
+func (s *Service) GetFinalizedHeader(w http.ResponseWriter, r *http.Request) {
+	s.writeHeader(w, r, (*Store).FinalizedHeader)
+}
+
+// GetOptimisticHeader returns the latest optimistic header verified by the light client.
+func (s *Service) GetOptimisticHeader(w http.ResponseWriter, r *http.Request) {
+	s.writeHeader(w, r, (*Store).OptimisticHeader)
+}
+
+func (s *Service) writeHeader(w http.ResponseWriter, r *http.Request, header func(*Store) *ethpbv1.BeaconBlockHeader) {
+	if r.Method != http.MethodGet {
+		httputil.HandleError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	store := s.getStore()
+	if store == nil {
+		httputil.HandleError(w, "Light client is not bootstrapped yet", http.StatusServiceUnavailable)
+		return
+	}
+	h := header(store)
+	root, err := h.HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not compute header root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.LightClientVerifiedHeaderResponse{
+		Data: &structs.LightClientVerifiedHeader{
+			Root:   hexutil.Encode(root[:]),
+			Header: structs.BeaconBlockHeaderFromConsensus(migration.V1HeaderToV1Alpha1(h)),
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package lightclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGetFinalizedHeader(t *testing.T) {
+	s := &Service{}
+
+	t.Run("not bootstrapped", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, finalizedHeaderPath, nil)
+		writer := httptest.NewRecorder()
+		s.GetFinalizedHeader(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+	store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.NoError(t, err)
+	s.store = store
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, finalizedHeaderPath, nil)
+		writer := httptest.NewRecorder()
+		s.GetFinalizedHeader(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.LightClientVerifiedHeaderResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, hexutil.Encode(root[:]), resp.Data.Root)
+		assert.Equal(t, "8", resp.Data.Header.Slot)
+	})
+	t.Run("method not allowed", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, finalizedHeaderPath, nil)
+		writer := httptest.NewRecorder()
+		s.GetFinalizedHeader(writer, request)
+		assert.Equal(t, http.StatusMethodNotAllowed, writer.Code)
+	})
+}
+
+func TestGetOptimisticHeader(t *testing.T) {
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+	store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.NoError(t, err)
+	update := c.update(24, 16, 1)
+	require.NoError(t, store.ProcessUpdate(update, 30))
+	s := &Service{store: store}
+
+	request := httptest.NewRequest(http.MethodGet, optimisticHeaderPath, nil)
+	writer := httptest.NewRecorder()
+	s.GetOptimisticHeader(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.LightClientVerifiedHeaderResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "24", resp.Data.Header.Slot)
+}
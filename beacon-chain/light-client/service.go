@@ -0,0 +1,283 @@
+package lightclient
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	lightclientapi "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/light-client"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	beaconNodeHost   string
+	trustedBlockRoot [32]byte
+	httpAddr         string
+	requestTimeout   time.Duration
+}
+
+// Service follows the chain as a light client. Once bootstrapped from a trusted block root, it polls the
+// beacon node API for light client updates every slot, verifies them, and serves the resulting headers.
+type Service struct {
+	cfg         *config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	client      *beacon.Client
+	server      *http.Server
+	genesisTime time.Time
+	storeLock   sync.RWMutex
+	store       *Store
+	statusLock  sync.RWMutex
+	failStatus  error
+}
+
+// NewService creates a light client service with the provided options.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg: &config{
+			beaconNodeHost: "localhost:3500",
+			httpAddr:       "127.0.0.1:3600",
+			requestTimeout: 30 * time.Second,
+		},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.trustedBlockRoot == [32]byte{} {
+		cancel()
+		return nil, errors.New("a trusted block root is required to bootstrap the light client")
+	}
+	c, err := beacon.NewClient(s.cfg.beaconNodeHost, client.WithTimeout(s.cfg.requestTimeout))
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create beacon node client")
+	}
+	s.client = c
+	s.server = &http.Server{Addr: s.cfg.httpAddr, Handler: s.newMux(), ReadHeaderTimeout: time.Second}
+	return s, nil
+}
+
+// Start bootstraps the light client, then follows the chain and serves the verified headers.
+func (s *Service) Start() {
+	go func() {
+		log.WithField("address", s.cfg.httpAddr).Info("Serving light client headers")
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve light client headers")
+			s.setFailStatus(err)
+		}
+	}()
+	go s.run()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status returns an error if the light client is not bootstrapped yet or failed to serve its headers.
+func (s *Service) Status() error {
+	s.statusLock.RLock()
+	failStatus := s.failStatus
+	s.statusLock.RUnlock()
+	if failStatus != nil {
+		return failStatus
+	}
+	if s.getStore() == nil {
+		return errors.New("light client is not bootstrapped")
+	}
+	return nil
+}
+
+func (s *Service) setFailStatus(err error) {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+	s.failStatus = err
+}
+
+func (s *Service) run() {
+	if err := s.bootstrap(); err != nil {
+		log.WithError(err).Error("Could not bootstrap light client")
+		s.setFailStatus(err)
+		return
+	}
+
+	ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-ticker.C():
+			if err := s.update(s.ctx); err != nil {
+				log.WithError(err).Error("Could not update light client")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// bootstrap initializes the light client store, retrying until the beacon node provides a valid bootstrap
+// for the trusted block root.
+func (s *Service) bootstrap() error {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		store, err := s.initializeStore(s.ctx)
+		if err == nil {
+			s.storeLock.Lock()
+			s.store = store
+			s.storeLock.Unlock()
+			updateMetrics(store)
+			log.WithFields(logrus.Fields{
+				"trustedBlockRoot": hexutil.Encode(s.cfg.trustedBlockRoot[:]),
+				"slot":             store.FinalizedHeader().Slot,
+			}).Info("Light client bootstrapped")
+			return nil
+		}
+		// A bootstrap not matching the trusted block root can't be fixed by retrying.
+		if errors.Is(err, errInvalidBootstrap) {
+			return err
+		}
+		log.WithError(err).Warn("Could not bootstrap light client, retrying")
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+func (s *Service) initializeStore(ctx context.Context) (*Store, error) {
+	genesis, err := s.client.GetGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesisTime, err := strconv.ParseInt(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse genesis time %s", genesis.GenesisTime)
+	}
+	genesisValidatorsRoot, err := hexutil.Decode(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode genesis validators root %s", genesis.GenesisValidatorsRoot)
+	}
+	s.genesisTime = time.Unix(genesisTime, 0)
+
+	resp, err := s.client.GetLightClientBootstrap(ctx, s.cfg.trustedBlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty light client bootstrap response")
+	}
+	bootstrap, err := lightclientapi.NewLightClientBootstrapFromJSON(resp.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode light client bootstrap")
+	}
+	return NewStore(s.cfg.trustedBlockRoot, bootstrap, bytesutil.ToBytes32(genesisValidatorsRoot))
+}
+
+// update retrieves and processes the updates the light client is missing. Updates of whole sync committee periods
+// are only requested when the light client lags behind the current period, or doesn't know the next sync committee.
+func (s *Service) update(ctx context.Context) error {
+	store := s.getStore()
+	currentSlot := slots.CurrentSlot(uint64(s.genesisTime.Unix()))
+	storePeriod := syncCommitteePeriodAtSlot(store.FinalizedHeader().Slot)
+	currentPeriod := syncCommitteePeriodAtSlot(currentSlot)
+
+	if storePeriod < currentPeriod || !store.NextSyncCommitteeKnown() {
+		count := min(currentPeriod-storePeriod+1, params.BeaconConfig().MaxRequestLightClientUpdates)
+		updates, err := s.client.GetLightClientUpdatesByRange(ctx, storePeriod, count)
+		if err != nil {
+			return errors.Wrap(err, "could not get light client updates")
+		}
+		for _, u := range updates {
+			update, err := lightclientapi.NewLightClientUpdateFromJSON(u.Data)
+			if err != nil {
+				return errors.Wrap(err, "could not decode light client update")
+			}
+			recordUpdate("update", update.AttestedHeader.Slot, store.ProcessUpdate(update, currentSlot))
+		}
+	}
+
+	finality, err := s.client.GetLightClientFinalityUpdate(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client finality update")
+	} else {
+		update, err := lightclientapi.NewLightClientUpdateFromJSON(finality.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not decode light client finality update")
+		}
+		err = store.ProcessFinalityUpdate(&ethpbv2.LightClientFinalityUpdate{
+			AttestedHeader:  update.AttestedHeader,
+			FinalizedHeader: update.FinalizedHeader,
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   update.SyncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}, currentSlot)
+		recordUpdate("finality update", update.AttestedHeader.Slot, err)
+	}
+
+	optimistic, err := s.client.GetLightClientOptimisticUpdate(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client optimistic update")
+	} else {
+		update, err := lightclientapi.NewLightClientUpdateFromJSON(optimistic.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not decode light client optimistic update")
+		}
+		err = store.ProcessOptimisticUpdate(&ethpbv2.LightClientOptimisticUpdate{
+			AttestedHeader: update.AttestedHeader,
+			SyncAggregate:  update.SyncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		}, currentSlot)
+		recordUpdate("optimistic update", update.AttestedHeader.Slot, err)
+	}
+
+	if err := store.ProcessForceUpdate(currentSlot); err != nil {
+		return errors.Wrap(err, "could not force light client update")
+	}
+	updateMetrics(store)
+	return nil
+}
+
+// recordUpdate records the outcome of processing an update. Updates the light client already knows about
+// are rejected as irrelevant, which is expected when polling, so rejections are only logged at debug level.
+func recordUpdate(kind string, attestedSlot primitives.Slot, err error) {
+	if err != nil {
+		processedUpdatesCount.WithLabelValues("rejected").Inc()
+		log.WithError(err).WithField("attestedSlot", attestedSlot).Debugf("Light client %s rejected", kind)
+		return
+	}
+	processedUpdatesCount.WithLabelValues("accepted").Inc()
+}
+
+func updateMetrics(store *Store) {
+	finalizedSlotGauge.Set(float64(store.FinalizedHeader().Slot))
+	optimisticSlotGauge.Set(float64(store.OptimisticHeader().Slot))
+}
+
+func (s *Service) getStore() *Store {
+	s.storeLock.RLock()
+	defer s.storeLock.RUnlock()
+	return s.store
+}
@@ -0,0 +1,41 @@
+package lightclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_StatusServeFailure(t *testing.T) {
+	beaconNode := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer beaconNode.Close()
+	// Serving the headers fails as the address is already taken.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+
+	s, err := NewService(context.Background(),
+		WithBeaconNodeHost(beaconNode.URL),
+		WithTrustedBlockRoot([32]byte{1}),
+		WithHTTPAddress(l.Addr().String()),
+	)
+	require.NoError(t, err)
+	require.ErrorContains(t, "not bootstrapped", s.Status())
+	s.Start()
+	defer func() { require.NoError(t, s.Stop()) }()
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if err := s.Status(); err != nil && strings.Contains(err.Error(), "address already in use") {
+			return
+		}
+	}
+	t.Fatalf("Unexpected status: %v", s.Status())
+}
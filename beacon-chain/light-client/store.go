@@ -0,0 +1,218 @@
+package lightclient
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Store is the light client store, which tracks the finalized and optimistic headers
+// the light client was able to verify, starting from a trusted block root.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#lightclientstore
+type Store struct {
+	lock                          sync.RWMutex
+	genesisValidatorsRoot         [32]byte
+	finalizedHeader               *ethpbv1.BeaconBlockHeader
+	currentSyncCommittee          *ethpbv2.SyncCommittee
+	nextSyncCommittee             *ethpbv2.SyncCommittee
+	bestValidUpdate               *ethpbv2.LightClientUpdate
+	optimisticHeader              *ethpbv1.BeaconBlockHeader
+	previousMaxActiveParticipants uint64
+	currentMaxActiveParticipants  uint64
+}
+
+// NewStore initializes a light client store from a bootstrap, after checking that it matches the trusted block root.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#initialize_light_client_store
+func NewStore(trustedBlockRoot [32]byte, bootstrap *ethpbv2.LightClientBootstrap, genesisValidatorsRoot [32]byte) (*Store, error) {
+	if bootstrap == nil || bootstrap.Header == nil || bootstrap.CurrentSyncCommittee == nil {
+		return nil, errors.New("nil light client bootstrap")
+	}
+	root, err := bootstrap.Header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute bootstrap header root")
+	}
+	if root != trustedBlockRoot {
+		return nil, errors.Wrapf(errInvalidBootstrap, "header root %#x does not match trusted block root %#x", root, trustedBlockRoot)
+	}
+	if err := verifySyncCommitteeBranch(
+		bootstrap.CurrentSyncCommittee,
+		bootstrap.CurrentSyncCommitteeBranch,
+		fieldparams.CurrentSyncCommitteeBranchDepth,
+		currentSyncCommitteeSubtreeIndex,
+		bootstrap.Header.StateRoot,
+	); err != nil {
+		return nil, errors.Wrap(errInvalidBootstrap, err.Error())
+	}
+	return &Store{
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		finalizedHeader:       bootstrap.Header,
+		currentSyncCommittee:  bootstrap.CurrentSyncCommittee,
+		optimisticHeader:      bootstrap.Header,
+	}, nil
+}
+
+// FinalizedHeader returns the latest verified finalized header.
+func (s *Store) FinalizedHeader() *ethpbv1.BeaconBlockHeader {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.finalizedHeader
+}
+
+// OptimisticHeader returns the latest verified header signed by a sufficient part of the sync committee.
+func (s *Store) OptimisticHeader() *ethpbv1.BeaconBlockHeader {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.optimisticHeader
+}
+
+// NextSyncCommitteeKnown reports whether the store knows the sync committee of the period following
+// the one of its finalized header.
+func (s *Store) NextSyncCommitteeKnown() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.isNextSyncCommitteeKnown()
+}
+
+// ProcessUpdate validates the given update and applies it to the store when it is final enough.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_update
+func (s *Store) ProcessUpdate(update *ethpbv2.LightClientUpdate, currentSlot primitives.Slot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.validateUpdate(update, currentSlot); err != nil {
+		return err
+	}
+
+	// Update the best update in case we have to force-update to it if the timeout elapses.
+	if s.bestValidUpdate == nil || blockchain.IsBetterUpdate(update, s.bestValidUpdate) {
+		s.bestValidUpdate = update
+	}
+
+	// Track the maximum number of active participants in the committee signatures.
+	participants := update.SyncAggregate.SyncCommitteeBits.Count()
+	s.currentMaxActiveParticipants = max(s.currentMaxActiveParticipants, participants)
+
+	// Update the optimistic header.
+	if participants > s.safetyThreshold() && update.AttestedHeader.Slot > s.optimisticHeader.Slot {
+		s.optimisticHeader = update.AttestedHeader
+	}
+
+	// Update finalized header.
+	hasFinalizedNextSyncCommittee := !s.isNextSyncCommitteeKnown() &&
+		update.IsSyncCommiteeUpdate() && update.IsFinalityUpdate() &&
+		syncCommitteePeriodAtSlot(update.FinalizedHeader.GetSlot()) == syncCommitteePeriodAtSlot(update.AttestedHeader.Slot)
+	if participants*3 >= params.BeaconConfig().SyncCommitteeSize*2 &&
+		(update.FinalizedHeader.GetSlot() > s.finalizedHeader.Slot || hasFinalizedNextSyncCommittee) {
+		// Normal update through 2/3 threshold.
+		if err := s.applyUpdate(update); err != nil {
+			return err
+		}
+		s.bestValidUpdate = nil
+	}
+	return nil
+}
+
+// ProcessFinalityUpdate processes a finality update, which is an update without a next sync committee.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_finality_update
+func (s *Store) ProcessFinalityUpdate(update *ethpbv2.LightClientFinalityUpdate, currentSlot primitives.Slot) error {
+	if update == nil {
+		return errors.New("nil light client finality update")
+	}
+	return s.ProcessUpdate(&ethpbv2.LightClientUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}, currentSlot)
+}
+
+// ProcessOptimisticUpdate processes an optimistic update, which is an update without finality information
+// nor next sync committee.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_optimistic_update
+func (s *Store) ProcessOptimisticUpdate(update *ethpbv2.LightClientOptimisticUpdate, currentSlot primitives.Slot) error {
+	if update == nil {
+		return errors.New("nil light client optimistic update")
+	}
+	return s.ProcessUpdate(&ethpbv2.LightClientUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}, currentSlot)
+}
+
+// ProcessForceUpdate applies the best valid update when no finality was reached for a whole sync
+// committee period, so that the light client keeps progressing during extended periods of non-finality.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_store_force_update
+func (s *Store) ProcessForceUpdate(currentSlot primitives.Slot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.bestValidUpdate == nil || currentSlot <= s.finalizedHeader.Slot+updateTimeout() {
+		return nil
+	}
+	// Because the apply logic waits for the finalized header to indicate sync committee finality,
+	// the attested header may be treated as the finalized header in extended periods of non-finality
+	// to guarantee progression into later sync committee periods.
+	update := s.bestValidUpdate
+	if update.FinalizedHeader == nil || update.FinalizedHeader.Slot <= s.finalizedHeader.Slot {
+		update.FinalizedHeader = update.AttestedHeader
+	}
+	if err := s.applyUpdate(update); err != nil {
+		return err
+	}
+	s.bestValidUpdate = nil
+	return nil
+}
+
+// applyUpdate moves the store to the finalized header of the update, rotating sync committees when
+// the update finalizes the next sync committee period.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#apply_light_client_update
+func (s *Store) applyUpdate(update *ethpbv2.LightClientUpdate) error {
+	storePeriod := syncCommitteePeriodAtSlot(s.finalizedHeader.Slot)
+	updateFinalizedPeriod := syncCommitteePeriodAtSlot(update.FinalizedHeader.Slot)
+	if !s.isNextSyncCommitteeKnown() {
+		if updateFinalizedPeriod != storePeriod {
+			return errors.Wrapf(errInvalidUpdate, "finalized period %d does not match store period %d", updateFinalizedPeriod, storePeriod)
+		}
+		s.nextSyncCommittee = update.NextSyncCommittee
+	} else if updateFinalizedPeriod == storePeriod+1 {
+		s.currentSyncCommittee = s.nextSyncCommittee
+		s.nextSyncCommittee = update.NextSyncCommittee
+		s.previousMaxActiveParticipants = s.currentMaxActiveParticipants
+		s.currentMaxActiveParticipants = 0
+	}
+	if update.FinalizedHeader.Slot > s.finalizedHeader.Slot {
+		s.finalizedHeader = update.FinalizedHeader
+		if s.finalizedHeader.Slot > s.optimisticHeader.Slot {
+			s.optimisticHeader = s.finalizedHeader
+		}
+	}
+	return nil
+}
+
+func (s *Store) isNextSyncCommitteeKnown() bool {
+	return !isEmptySyncCommittee(s.nextSyncCommittee)
+}
+
+// safetyThreshold is the number of participants an update must exceed to move the optimistic header.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#get_safety_threshold
+func (s *Store) safetyThreshold() uint64 {
+	return max(s.previousMaxActiveParticipants, s.currentMaxActiveParticipants) / 2
+}
+
+func updateTimeout() primitives.Slot {
+	cfg := params.BeaconConfig()
+	return cfg.SlotsPerEpoch.Mul(uint64(cfg.EpochsPerSyncCommitteePeriod))
+}
+
+func syncCommitteePeriodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
@@ -0,0 +1,294 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// testChain builds light client data out of a genesis state, whose sync committee members sign the updates.
+type testChain struct {
+	t                     *testing.T
+	genesis               state.BeaconState
+	keys                  map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	genesisValidatorsRoot [32]byte
+}
+
+func newTestChain(t *testing.T) *testChain {
+	st, keys := util.DeterministicGenesisStateAltair(t, 64)
+	committee, err := altair.NextSyncCommittee(context.Background(), st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(committee))
+	require.NoError(t, st.SetNextSyncCommittee(committee))
+	c := &testChain{
+		t:                     t,
+		genesis:               st,
+		keys:                  make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, len(keys)),
+		genesisValidatorsRoot: bytesutil.ToBytes32(st.GenesisValidatorsRoot()),
+	}
+	for _, k := range keys {
+		c.keys[bytesutil.ToBytes48(k.PublicKey().Marshal())] = k
+	}
+	return c
+}
+
+// stateAt returns a copy of the genesis state at the given slot, along with the header of a block
+// having that state as post-state.
+func (c *testChain) stateAt(slot primitives.Slot) (state.BeaconState, *ethpbv1.BeaconBlockHeader) {
+	st := c.genesis.Copy()
+	require.NoError(c.t, st.SetSlot(slot))
+	return st, c.header(st)
+}
+
+func (c *testChain) header(st state.BeaconState) *ethpbv1.BeaconBlockHeader {
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(c.t, err)
+	return &ethpbv1.BeaconBlockHeader{
+		Slot:       st.Slot(),
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  root[:],
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+}
+
+func (c *testChain) bootstrap(slot primitives.Slot) (*ethpbv2.LightClientBootstrap, [32]byte) {
+	st, header := c.stateAt(slot)
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(c.t, err)
+	branch, err := st.CurrentSyncCommitteeProof(context.Background())
+	require.NoError(c.t, err)
+	root, err := header.HashTreeRoot()
+	require.NoError(c.t, err)
+	return &ethpbv2.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       migration.V1Alpha1SyncCommitteeToV2(committee),
+		CurrentSyncCommitteeBranch: branch,
+	}, root
+}
+
+// update returns a light client update attesting a block at attestedSlot, whose state finalized a block
+// at finalizedSlot, signed by the given number of sync committee members.
+func (c *testChain) update(attestedSlot, finalizedSlot primitives.Slot, participants uint64) *ethpbv2.LightClientUpdate {
+	finalized := &ethpbv1.BeaconBlockHeader{
+		Slot:       finalizedSlot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  bytesutil.PadTo([]byte("finalized"), fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+	finalizedRoot, err := finalized.HashTreeRoot()
+	require.NoError(c.t, err)
+
+	st := c.genesis.Copy()
+	require.NoError(c.t, st.SetSlot(attestedSlot))
+	require.NoError(c.t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: slots.ToEpoch(finalizedSlot), Root: finalizedRoot[:]}))
+	attested := c.header(st)
+	nextCommittee, err := st.NextSyncCommittee()
+	require.NoError(c.t, err)
+	nextCommitteeBranch, err := st.NextSyncCommitteeProof(context.Background())
+	require.NoError(c.t, err)
+	finalityBranch, err := st.FinalizedRootProof(context.Background())
+	require.NoError(c.t, err)
+
+	update := &ethpbv2.LightClientUpdate{
+		AttestedHeader:          attested,
+		NextSyncCommittee:       migration.V1Alpha1SyncCommitteeToV2(nextCommittee),
+		NextSyncCommitteeBranch: nextCommitteeBranch,
+		FinalizedHeader:         finalized,
+		FinalityBranch:          finalityBranch,
+		SignatureSlot:           attestedSlot + 1,
+	}
+	update.SyncAggregate = c.sign(attested, update.SignatureSlot, participants)
+	return update
+}
+
+func (c *testChain) sign(header *ethpbv1.BeaconBlockHeader, signatureSlot primitives.Slot, participants uint64) *ethpbv1.SyncAggregate {
+	committee, err := c.genesis.CurrentSyncCommittee()
+	require.NoError(c.t, err)
+	fork, err := forks.Fork(slots.ToEpoch(signatureSlot - 1))
+	require.NoError(c.t, err)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, fork.CurrentVersion, c.genesisValidatorsRoot[:])
+	require.NoError(c.t, err)
+	signingRoot, err := signing.ComputeSigningRoot(header, domain)
+	require.NoError(c.t, err)
+
+	bits := bitfield.NewBitvector512()
+	sigs := make([]bls.Signature, 0, participants)
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+		sigs = append(sigs, c.keys[bytesutil.ToBytes48(committee.Pubkeys[i])].Sign(signingRoot[:]))
+	}
+	return &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: bls.AggregateSignatures(sigs).Marshal(),
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+
+	store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bootstrap.Header, store.FinalizedHeader())
+	assert.DeepEqual(t, bootstrap.Header, store.OptimisticHeader())
+	assert.Equal(t, false, store.NextSyncCommitteeKnown())
+
+	_, err = NewStore([32]byte{'a'}, bootstrap, c.genesisValidatorsRoot)
+	require.ErrorIs(t, err, errInvalidBootstrap)
+
+	bootstrap.CurrentSyncCommitteeBranch[0] = make([]byte, fieldparams.RootLength)
+	_, err = NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.ErrorIs(t, err, errInvalidBootstrap)
+}
+
+func TestStore_ProcessUpdate(t *testing.T) {
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+	store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.NoError(t, err)
+
+	update := c.update(24, 16, params.BeaconConfig().SyncCommitteeSize)
+	require.NoError(t, store.ProcessUpdate(update, 30))
+	assert.DeepEqual(t, update.FinalizedHeader, store.FinalizedHeader())
+	assert.DeepEqual(t, update.AttestedHeader, store.OptimisticHeader())
+	assert.Equal(t, true, store.NextSyncCommitteeKnown())
+
+	// Updates attesting blocks older than the finalized header are not relevant anymore.
+	require.ErrorIs(t, store.ProcessUpdate(c.update(12, 8, params.BeaconConfig().SyncCommitteeSize), 30), errInvalidUpdate)
+}
+
+func TestStore_ProcessUpdate_WithoutSupermajority(t *testing.T) {
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+	store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.NoError(t, err)
+
+	update := c.update(24, 16, params.BeaconConfig().SyncCommitteeSize/2)
+	require.NoError(t, store.ProcessUpdate(update, 30))
+	assert.DeepEqual(t, bootstrap.Header, store.FinalizedHeader(), "finalized header should not move without supermajority")
+	assert.DeepEqual(t, update.AttestedHeader, store.OptimisticHeader())
+
+	// The best valid update is applied once the update timeout elapsed.
+	require.NoError(t, store.ProcessForceUpdate(30))
+	assert.DeepEqual(t, bootstrap.Header, store.FinalizedHeader())
+	require.NoError(t, store.ProcessForceUpdate(bootstrap.Header.Slot+updateTimeout()+1))
+	assert.DeepEqual(t, update.FinalizedHeader, store.FinalizedHeader())
+	assert.Equal(t, true, store.NextSyncCommitteeKnown())
+}
+
+func TestStore_ProcessUpdate_Invalid(t *testing.T) {
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+	committeeSize := params.BeaconConfig().SyncCommitteeSize
+
+	tests := []struct {
+		name   string
+		modify func(update *ethpbv2.LightClientUpdate)
+		slot   primitives.Slot
+	}{
+		{
+			name: "signature slot in the future",
+			slot: 20,
+		},
+		{
+			name: "no participants",
+			modify: func(update *ethpbv2.LightClientUpdate) {
+				update.SyncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+			},
+		},
+		{
+			name: "invalid signature",
+			modify: func(update *ethpbv2.LightClientUpdate) {
+				update.SyncAggregate = c.sign(update.FinalizedHeader, update.SignatureSlot, committeeSize)
+			},
+		},
+		{
+			name: "invalid finality branch",
+			modify: func(update *ethpbv2.LightClientUpdate) {
+				update.FinalizedHeader.Slot++
+			},
+		},
+		{
+			name: "invalid next sync committee branch",
+			modify: func(update *ethpbv2.LightClientUpdate) {
+				update.NextSyncCommitteeBranch[1] = make([]byte, fieldparams.RootLength)
+			},
+		},
+		{
+			name: "finalized header without finality branch",
+			modify: func(update *ethpbv2.LightClientUpdate) {
+				update.FinalityBranch = nil
+			},
+		},
+		{
+			name: "attested header not newer than finalized header",
+			modify: func(update *ethpbv2.LightClientUpdate) {
+				update.FinalizedHeader.Slot = 26
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+			require.NoError(t, err)
+			update := c.update(24, 16, committeeSize)
+			if tt.modify != nil {
+				tt.modify(update)
+			}
+			slot := tt.slot
+			if slot == 0 {
+				slot = 30
+			}
+			require.ErrorIs(t, store.ProcessUpdate(update, slot), errInvalidUpdate)
+			assert.DeepEqual(t, bootstrap.Header, store.FinalizedHeader())
+			assert.DeepEqual(t, bootstrap.Header, store.OptimisticHeader())
+		})
+	}
+}
+
+func TestStore_ProcessFinalityAndOptimisticUpdates(t *testing.T) {
+	c := newTestChain(t)
+	bootstrap, root := c.bootstrap(8)
+	store, err := NewStore(root, bootstrap, c.genesisValidatorsRoot)
+	require.NoError(t, err)
+	committeeSize := params.BeaconConfig().SyncCommitteeSize
+
+	update := c.update(24, 16, committeeSize)
+	require.NoError(t, store.ProcessOptimisticUpdate(&ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}, 30))
+	assert.DeepEqual(t, bootstrap.Header, store.FinalizedHeader())
+	assert.DeepEqual(t, update.AttestedHeader, store.OptimisticHeader())
+
+	update = c.update(28, 16, committeeSize)
+	require.NoError(t, store.ProcessFinalityUpdate(&ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}, 30))
+	assert.DeepEqual(t, update.FinalizedHeader, store.FinalizedHeader())
+	assert.DeepEqual(t, update.AttestedHeader, store.OptimisticHeader())
+	assert.Equal(t, false, store.NextSyncCommitteeKnown())
+}
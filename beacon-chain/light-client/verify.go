@@ -0,0 +1,210 @@
+package lightclient
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+const (
+	// Indices of the sync committee and finalized root leaves within the subtrees their branches prove,
+	// which are get_subtree_index(CURRENT_SYNC_COMMITTEE_GINDEX), get_subtree_index(NEXT_SYNC_COMMITTEE_GINDEX)
+	// and get_subtree_index(FINALIZED_ROOT_GINDEX) in the spec.
+	currentSyncCommitteeSubtreeIndex = 22
+	nextSyncCommitteeSubtreeIndex    = 23
+	finalizedRootSubtreeIndex        = 41
+)
+
+var (
+	errInvalidBootstrap = errors.New("invalid light client bootstrap")
+	errInvalidUpdate    = errors.New("invalid light client update")
+)
+
+// validateUpdate checks that the update is consistent with the store and was signed by the relevant sync committee.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#validate_light_client_update
+func (s *Store) validateUpdate(update *ethpbv2.LightClientUpdate, currentSlot primitives.Slot) error {
+	if update == nil || update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return errors.Wrap(errInvalidUpdate, "missing attested header or sync aggregate")
+	}
+
+	// Verify sync committee has sufficient participants.
+	participants := update.SyncAggregate.SyncCommitteeBits.Count()
+	if participants < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return errors.Wrapf(errInvalidUpdate, "not enough sync committee participants: %d", participants)
+	}
+
+	// Verify update does not skip a sync committee period.
+	finalizedSlot := update.FinalizedHeader.GetSlot()
+	if currentSlot < update.SignatureSlot || update.SignatureSlot <= update.AttestedHeader.Slot || update.AttestedHeader.Slot < finalizedSlot {
+		return errors.Wrapf(
+			errInvalidUpdate,
+			"inconsistent slots: current %d, signature %d, attested %d, finalized %d",
+			currentSlot, update.SignatureSlot, update.AttestedHeader.Slot, finalizedSlot,
+		)
+	}
+	storePeriod := syncCommitteePeriodAtSlot(s.finalizedHeader.Slot)
+	signaturePeriod := syncCommitteePeriodAtSlot(update.SignatureSlot)
+	if s.isNextSyncCommitteeKnown() {
+		if signaturePeriod != storePeriod && signaturePeriod != storePeriod+1 {
+			return errors.Wrapf(errInvalidUpdate, "signature period %d is not the store period %d or the next one", signaturePeriod, storePeriod)
+		}
+	} else if signaturePeriod != storePeriod {
+		return errors.Wrapf(errInvalidUpdate, "signature period %d is not the store period %d", signaturePeriod, storePeriod)
+	}
+
+	// Verify update is relevant.
+	attestedPeriod := syncCommitteePeriodAtSlot(update.AttestedHeader.Slot)
+	hasNextSyncCommittee := !s.isNextSyncCommitteeKnown() && update.IsSyncCommiteeUpdate() && attestedPeriod == storePeriod
+	if update.AttestedHeader.Slot <= s.finalizedHeader.Slot && !hasNextSyncCommittee {
+		return errors.Wrap(errInvalidUpdate, "update is not relevant")
+	}
+
+	// Verify that the finalized header, if present, actually is the finalized header saved in the
+	// state of the attested header.
+	if !update.IsFinalityUpdate() {
+		if !isEmptyHeader(update.FinalizedHeader) {
+			return errors.Wrap(errInvalidUpdate, "finalized header without finality branch")
+		}
+	} else {
+		var finalizedRoot [32]byte
+		if update.FinalizedHeader == nil {
+			return errors.Wrap(errInvalidUpdate, "finality branch without finalized header")
+		}
+		if update.FinalizedHeader.Slot == params.BeaconConfig().GenesisSlot {
+			if !isEmptyHeader(update.FinalizedHeader) {
+				return errors.Wrap(errInvalidUpdate, "finalized header at genesis slot is not empty")
+			}
+		} else {
+			root, err := update.FinalizedHeader.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not compute finalized header root")
+			}
+			finalizedRoot = root
+		}
+		if !isValidMerkleBranch(finalizedRoot[:], update.FinalityBranch, fieldparams.FinalityBranchDepth, finalizedRootSubtreeIndex, update.AttestedHeader.StateRoot) {
+			return errors.Wrap(errInvalidUpdate, "invalid finality branch")
+		}
+	}
+
+	// Verify that the next sync committee, if present, actually is the next sync committee saved in the
+	// state of the attested header.
+	if !update.IsSyncCommiteeUpdate() {
+		if !isEmptySyncCommittee(update.NextSyncCommittee) {
+			return errors.Wrap(errInvalidUpdate, "next sync committee without branch")
+		}
+	} else {
+		if attestedPeriod == storePeriod && s.isNextSyncCommitteeKnown() && !update.NextSyncCommittee.Equals(s.nextSyncCommittee) {
+			return errors.Wrap(errInvalidUpdate, "next sync committee does not match the known one")
+		}
+		if err := verifySyncCommitteeBranch(
+			update.NextSyncCommittee,
+			update.NextSyncCommitteeBranch,
+			fieldparams.NextSyncCommitteeBranchDepth,
+			nextSyncCommitteeSubtreeIndex,
+			update.AttestedHeader.StateRoot,
+		); err != nil {
+			return errors.Wrap(errInvalidUpdate, err.Error())
+		}
+	}
+
+	// Verify sync committee aggregate signature.
+	committee := s.currentSyncCommittee
+	if signaturePeriod != storePeriod {
+		committee = s.nextSyncCommittee
+	}
+	return s.verifySyncAggregate(update, committee)
+}
+
+// verifySyncAggregate checks the sync committee signature of the attested header of the update.
+func (s *Store) verifySyncAggregate(update *ethpbv2.LightClientUpdate, committee *ethpbv2.SyncCommittee) error {
+	bits := update.SyncAggregate.SyncCommitteeBits
+	pubkeys := make([]bls.PublicKey, 0, bits.Count())
+	for i, pk := range committee.Pubkeys {
+		if !bits.BitAt(uint64(i)) {
+			continue
+		}
+		pubkey, err := bls.PublicKeyFromBytes(pk)
+		if err != nil {
+			return errors.Wrapf(err, "could not deserialize sync committee public key %d", i)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	sig, err := bls.SignatureFromBytes(update.SyncAggregate.SyncCommitteeSignature)
+	if err != nil {
+		return errors.Wrap(errInvalidUpdate, "could not deserialize sync committee signature")
+	}
+
+	// The sync committee signs the attested header at the slot before the signature slot.
+	forkVersionSlot := max(update.SignatureSlot, 1) - 1
+	fork, err := forks.Fork(slots.ToEpoch(forkVersionSlot))
+	if err != nil {
+		return errors.Wrap(err, "could not get fork")
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, fork.CurrentVersion, s.genesisValidatorsRoot[:])
+	if err != nil {
+		return errors.Wrap(err, "could not compute sync committee domain")
+	}
+	signingRoot, err := signing.ComputeSigningRoot(update.AttestedHeader, domain)
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing root")
+	}
+	if !sig.FastAggregateVerify(pubkeys, signingRoot) {
+		return errors.Wrap(errInvalidUpdate, "invalid sync committee signature")
+	}
+	return nil
+}
+
+// verifySyncCommitteeBranch checks that the sync committee is part of the state with the given root.
+func verifySyncCommitteeBranch(committee *ethpbv2.SyncCommittee, branch [][]byte, depth int, index uint64, stateRoot []byte) error {
+	root, err := committee.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute sync committee root")
+	}
+	if !isValidMerkleBranch(root[:], branch, depth, index, stateRoot) {
+		return errors.New("invalid sync committee branch")
+	}
+	return nil
+}
+
+// isValidMerkleBranch implements is_valid_merkle_branch from the spec.
+func isValidMerkleBranch(leaf []byte, branch [][]byte, depth int, index uint64, root []byte) bool {
+	if len(branch) != depth {
+		return false
+	}
+	// The trie helper expects proofs with a trailing length mix-in, hence the depth of one less than the branch length.
+	return trie.VerifyMerkleProofWithDepth(root, leaf, index, branch, uint64(depth-1))
+}
+
+func isEmptyHeader(header *ethpbv1.BeaconBlockHeader) bool {
+	if header == nil {
+		return true
+	}
+	return header.Slot == 0 && header.ProposerIndex == 0 &&
+		isZero(header.ParentRoot) && isZero(header.StateRoot) && isZero(header.BodyRoot)
+}
+
+func isEmptySyncCommittee(committee *ethpbv2.SyncCommittee) bool {
+	if committee == nil {
+		return true
+	}
+	for _, pk := range committee.Pubkeys {
+		if !isZero(pk) {
+			return false
+		}
+	}
+	return isZero(committee.AggregatePubkey)
+}
+
+func isZero(b []byte) bool {
+	return len(bytes.Trim(b, "\x00")) == 0
+}
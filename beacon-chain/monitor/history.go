@@ -0,0 +1,268 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// PerformanceHistoryLength defines the number of epochs of performance history kept for each tracked validator.
+	PerformanceHistoryLength = 225
+	// MaxTrackedValidators defines the maximum number of validators tracked at runtime.
+	MaxTrackedValidators = 4096
+)
+
+// PerformanceTracker gives access to the performance history of the tracked validators,
+// and allows changing the set of tracked validators at runtime.
+type PerformanceTracker interface {
+	TrackedIndices() []primitives.ValidatorIndex
+	PerformanceHistory(indices []primitives.ValidatorIndex) []*validator.EpochPerformance
+	TrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) error
+	UntrackValidators(indices []primitives.ValidatorIndex)
+}
+
+// TrackedIndices returns the sorted indices of the tracked validators.
+func (s *Service) TrackedIndices() []primitives.ValidatorIndex {
+	s.RLock()
+	defer s.RUnlock()
+	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		tracked = append(tracked, idx)
+	}
+	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
+	return tracked
+}
+
+// PerformanceHistory returns the performance of the given tracked validators in the epochs that are final,
+// sorted by validator index and epoch. The history of all tracked validators is returned when no index is given.
+func (s *Service) PerformanceHistory(indices []primitives.ValidatorIndex) []*validator.EpochPerformance {
+	s.RLock()
+	defer s.RUnlock()
+	if len(indices) == 0 {
+		indices = make([]primitives.ValidatorIndex, 0, len(s.performanceHistory))
+		for idx := range s.performanceHistory {
+			indices = append(indices, idx)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	history := make([]*validator.EpochPerformance, 0)
+	for i, idx := range indices {
+		if i > 0 && indices[i-1] == idx {
+			continue
+		}
+		for _, p := range s.performanceHistory[idx] {
+			c := *p
+			history = append(history, &c)
+		}
+	}
+	return history
+}
+
+// TrackValidators starts tracking the given validators. Their performance is recorded from the next epoch on.
+// It fails when the number of tracked validators would exceed MaxTrackedValidators.
+func (s *Service) TrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) error {
+	for {
+		// The head state is retrieved before taking the lock, so that the monitor is not blocked meanwhile.
+		s.RLock()
+		isLogging := s.isLogging
+		s.RUnlock()
+		var st state.BeaconState
+		if isLogging {
+			var err error
+			st, err = s.config.HeadFetcher.HeadState(ctx)
+			if err != nil {
+				return errors.Wrap(err, "could not get head state")
+			}
+		}
+		if retry, err := s.trackValidators(st, indices); !retry {
+			return err
+		}
+	}
+}
+
+// trackValidators tracks the given validators, initializing their performance from the head state once the
+// monitor runs. It asks for a retry when the monitor started since the head state was retrieved.
+func (s *Service) trackValidators(st state.BeaconState, indices []primitives.ValidatorIndex) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.isLogging && st == nil {
+		return true, nil
+	}
+	added := make([]primitives.ValidatorIndex, 0, len(indices))
+	seen := make(map[primitives.ValidatorIndex]bool, len(indices))
+	for _, idx := range indices {
+		if s.trackedIndex(idx) || seen[idx] {
+			continue
+		}
+		if st != nil && uint64(idx) >= uint64(st.NumValidators()) {
+			return false, fmt.Errorf("validator index %d does not exist", idx)
+		}
+		seen[idx] = true
+		added = append(added, idx)
+	}
+	if len(s.TrackedValidators)+len(added) > MaxTrackedValidators {
+		return false, fmt.Errorf("cannot track more than %d validators", MaxTrackedValidators)
+	}
+	for _, idx := range added {
+		s.TrackedValidators[idx] = true
+		// Validators added before the service runs are initialized with the others once synced.
+		if st != nil {
+			s.initializeValidatorPerformance(st, idx, slots.ToEpoch(st.Slot()))
+			s.updateSyncCommitteeTrackedVal(st, idx)
+		}
+	}
+	if len(added) > 0 {
+		log.WithField("validatorIndices", added).Info("Started tracking validators")
+	}
+	return false, nil
+}
+
+// UntrackValidators stops tracking the given validators and discards their performance history.
+func (s *Service) UntrackValidators(indices []primitives.ValidatorIndex) {
+	s.Lock()
+	defer s.Unlock()
+	removed := make([]primitives.ValidatorIndex, 0, len(indices))
+	for _, idx := range indices {
+		if !s.trackedIndex(idx) {
+			continue
+		}
+		delete(s.TrackedValidators, idx)
+		delete(s.latestPerformance, idx)
+		delete(s.aggregatedPerformance, idx)
+		delete(s.trackedSyncCommitteeIndices, idx)
+		delete(s.performanceHistory, idx)
+		for _, pending := range s.pendingPerformance {
+			delete(pending, idx)
+		}
+		deleteMetrics(idx)
+		removed = append(removed, idx)
+	}
+	if len(removed) > 0 {
+		log.WithField("validatorIndices", removed).Info("Stopped tracking validators")
+	}
+}
+
+// pendingEpochPerformance returns the performance record of a tracked validator for an epoch which is not final yet.
+// It returns nil if the epoch is not recorded, either because it is final or because tracking started during the epoch.
+// It assumes the caller holds the service Lock
+func (s *Service) pendingEpochPerformance(idx primitives.ValidatorIndex, epoch primitives.Epoch) *validator.EpochPerformance {
+	pending, ok := s.pendingPerformance[epoch]
+	if !ok {
+		return nil
+	}
+	return pending[idx]
+}
+
+// processEpochTransition starts recording the performance of the tracked validators when the first block
+// of an epoch is processed, and finalizes the performance of the epochs whose attestations can no longer
+// be included.
+func (s *Service) processEpochTransition(ctx context.Context, st state.BeaconState, epoch primitives.Epoch) {
+	s.Lock()
+	defer s.Unlock()
+	if epoch <= s.lastBlockEpoch {
+		return
+	}
+
+	balances := make(map[primitives.ValidatorIndex]uint64, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("validatorIndex", idx).Error("Could not get balance")
+			continue
+		}
+		balances[idx] = balance
+	}
+	for idx, p := range s.pendingPerformance[s.lastBlockEpoch] {
+		p.EndBalance = balances[idx]
+	}
+
+	pending := make(map[primitives.ValidatorIndex]*validator.EpochPerformance, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		pending[idx] = &validator.EpochPerformance{
+			ValidatorIndex: idx,
+			Epoch:          epoch,
+			StartBalance:   balances[idx],
+		}
+	}
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		log.WithError(err).Error("Could not get epoch start slot")
+		return
+	}
+	for slot := max(start, 1); slot < start+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+		if err != nil {
+			log.WithError(err).WithField("slot", slot).Error("Could not get proposer index")
+			continue
+		}
+		if p, ok := pending[proposer]; ok {
+			p.ProposerDuties++
+		}
+	}
+	s.pendingPerformance[epoch] = pending
+	s.lastBlockEpoch = epoch
+
+	// Attestations of an epoch can be included until the end of the next epoch.
+	final := make([]primitives.Epoch, 0, len(s.pendingPerformance))
+	for e := range s.pendingPerformance {
+		if e+2 <= epoch {
+			final = append(final, e)
+		}
+	}
+	sort.Slice(final, func(i, j int) bool { return final[i] < final[j] })
+	for _, e := range final {
+		s.finalizeEpochPerformance(e)
+	}
+}
+
+// finalizeEpochPerformance moves the performance of the epoch to the history and notifies subscribers.
+// It assumes the caller holds the service Lock
+func (s *Service) finalizeEpochPerformance(epoch primitives.Epoch) {
+	pending := s.pendingPerformance[epoch]
+	delete(s.pendingPerformance, epoch)
+
+	performance := make([]*validator.EpochPerformance, 0, len(pending))
+	for idx, p := range pending {
+		history := append(s.performanceHistory[idx], p)
+		if len(history) > PerformanceHistoryLength {
+			history = history[len(history)-PerformanceHistoryLength:]
+		}
+		s.performanceHistory[idx] = history
+		c := *p
+		performance = append(performance, &c)
+
+		log.WithFields(logrus.Fields{
+			"validatorIndex":      idx,
+			"epoch":               epoch,
+			"attestationIncluded": p.AttestationIncluded,
+			"inclusionDistance":   p.InclusionDistance,
+			"correctSource":       p.CorrectSource,
+			"correctTarget":       p.CorrectTarget,
+			"correctHead":         p.CorrectHead,
+			"missedProposals":     p.MissedProposals(),
+			"balanceChange":       p.BalanceChange(),
+		}).Debug("Epoch performance")
+	}
+	sort.Slice(performance, func(i, j int) bool { return performance[i].ValidatorIndex < performance[j].ValidatorIndex })
+
+	// The monitor is itself subscribed to the state feed, sending from its routine could deadlock.
+	go s.config.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.ValidatorPerformance,
+		Data: &statefeed.ValidatorPerformanceData{
+			Epoch:       epoch,
+			Performance: performance,
+		},
+	})
+}
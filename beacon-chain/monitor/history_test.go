@@ -0,0 +1,141 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestProcessEpochTransition(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.config.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	// Epoch 1 starts being recorded with the proposer duties of the tracked validators.
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	s.processEpochTransition(ctx, st, 1)
+	var duties uint64
+	for slot := params.BeaconConfig().SlotsPerEpoch; slot < 2*params.BeaconConfig().SlotsPerEpoch; slot++ {
+		proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+		require.NoError(t, err)
+		if s.trackedIndex(proposer) {
+			duties++
+		}
+	}
+	var recordedDuties uint64
+	for idx := range s.TrackedValidators {
+		p := s.pendingEpochPerformance(idx, 1)
+		require.NotNil(t, p)
+		require.Equal(t, uint64(32000000000), p.StartBalance)
+		recordedDuties += p.ProposerDuties
+	}
+	require.Equal(t, duties, recordedDuties)
+	require.Equal(t, (*validator.EpochPerformance)(nil), s.pendingEpochPerformance(1, 0))
+
+	p := s.pendingEpochPerformance(1, 1)
+	p.AttestationIncluded = true
+	p.InclusionDistance = 1
+	p.CorrectSource = true
+
+	// Epoch 1 is final once epoch 3 starts.
+	require.NoError(t, st.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, st.UpdateBalancesAtIndex(1, 32100000000))
+	s.processEpochTransition(ctx, st, 2)
+	require.Equal(t, 0, len(s.PerformanceHistory(nil)))
+	require.NoError(t, st.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	s.processEpochTransition(ctx, st, 3)
+
+	history := s.PerformanceHistory([]primitives.ValidatorIndex{1})
+	require.Equal(t, 1, len(history))
+	require.Equal(t, primitives.Epoch(1), history[0].Epoch)
+	require.Equal(t, true, history[0].AttestationIncluded)
+	require.Equal(t, primitives.Slot(1), history[0].InclusionDistance)
+	require.Equal(t, int64(100000000), history[0].BalanceChange())
+	require.Equal(t, 4, len(s.PerformanceHistory(nil)))
+	require.Equal(t, 2, len(s.pendingPerformance))
+
+	select {
+	case e := <-stateChannel:
+		require.Equal(t, statefeed.ValidatorPerformance, int(e.Type))
+		data, ok := e.Data.(*statefeed.ValidatorPerformanceData)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.Epoch(1), data.Epoch)
+		require.Equal(t, 4, len(data.Performance))
+		require.Equal(t, primitives.ValidatorIndex(1), data.Performance[0].ValidatorIndex)
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive validator performance event")
+	}
+}
+
+func TestProcessEpochTransition_PreviousEpoch(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	s.lastBlockEpoch = 2
+
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	s.processEpochTransition(ctx, st, 1)
+	require.Equal(t, 0, len(s.pendingPerformance))
+}
+
+func TestTrackValidators(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+
+	// Validators added before the service runs are only tracked.
+	require.NoError(t, s.TrackValidators(ctx, []primitives.ValidatorIndex{3}))
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 2, 3, 12, 15}, s.TrackedIndices())
+	_, ok := s.latestPerformance[3]
+	require.Equal(t, false, ok)
+
+	s.isLogging = true
+	require.NoError(t, s.TrackValidators(ctx, []primitives.ValidatorIndex{0, 4}))
+	require.DeepEqual(t, []primitives.ValidatorIndex{0, 1, 2, 3, 4, 12, 15}, s.TrackedIndices())
+	require.Equal(t, uint64(32000000000), s.latestPerformance[4].balance)
+	require.DeepEqual(t, []primitives.CommitteeIndex{0}, s.trackedSyncCommitteeIndices[0])
+
+	require.ErrorContains(t, "validator index 1000 does not exist", s.TrackValidators(ctx, []primitives.ValidatorIndex{5, 1000}))
+	require.Equal(t, false, s.trackedIndex(5))
+
+	s.isLogging = false
+	tooMany := make([]primitives.ValidatorIndex, MaxTrackedValidators)
+	for i := range tooMany {
+		tooMany[i] = primitives.ValidatorIndex(100 + i)
+	}
+	require.ErrorContains(t, "cannot track more than", s.TrackValidators(ctx, tooMany))
+	require.Equal(t, 7, len(s.TrackedIndices()))
+}
+
+func TestUntrackValidators(t *testing.T) {
+	s := setupService(t)
+	s.performanceHistory[1] = []*validator.EpochPerformance{{ValidatorIndex: 1, Epoch: 1}}
+	s.performanceHistory[2] = []*validator.EpochPerformance{{ValidatorIndex: 2, Epoch: 1}}
+	s.pendingPerformance[2] = map[primitives.ValidatorIndex]*validator.EpochPerformance{
+		1: {ValidatorIndex: 1, Epoch: 2},
+		2: {ValidatorIndex: 2, Epoch: 2},
+	}
+
+	s.UntrackValidators([]primitives.ValidatorIndex{1, 3})
+	require.DeepEqual(t, []primitives.ValidatorIndex{2, 12, 15}, s.TrackedIndices())
+	_, ok := s.latestPerformance[1]
+	require.Equal(t, false, ok)
+	_, ok = s.trackedSyncCommitteeIndices[1]
+	require.Equal(t, false, ok)
+	require.Equal(t, 1, len(s.pendingPerformance[2]))
+	history := s.PerformanceHistory(nil)
+	require.Equal(t, 1, len(history))
+	require.Equal(t, primitives.ValidatorIndex(2), history[0].ValidatorIndex)
+}
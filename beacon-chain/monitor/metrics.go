@@ -1,8 +1,11 @@
 package monitor
 
 import (
+	"fmt"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/sirupsen/logrus"
 )
 
@@ -91,3 +94,15 @@ var (
 		},
 	)
 )
+
+// deleteMetrics removes the metrics of a validator which is no longer tracked.
+func deleteMetrics(idx primitives.ValidatorIndex) {
+	label := fmt.Sprintf("%d", idx)
+	inclusionSlotGauge.DeleteLabelValues(label)
+	timelyHeadCounter.DeleteLabelValues(label)
+	timelyTargetCounter.DeleteLabelValues(label)
+	timelySourceCounter.DeleteLabelValues(label)
+	proposedSlotsCounter.DeleteLabelValues(label)
+	aggregationCounter.DeleteLabelValues(label)
+	syncCommitteeContributionCounter.DeleteLabelValues(label)
+}
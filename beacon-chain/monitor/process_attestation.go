@@ -93,7 +93,7 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
 
-			if state.Version() == version.Altair {
+			if state.Version() >= version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
 				sourceIdx := params.BeaconConfig().TimelySourceFlagIndex
 				headIdx := params.BeaconConfig().TimelyHeadFlagIndex
@@ -146,6 +146,13 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 					aggregatedPerf.totalCorrectTarget++
 				}
 			}
+			if p := s.pendingEpochPerformance(primitives.ValidatorIndex(idx), slots.ToEpoch(att.Data.Slot)); p != nil {
+				p.AttestationIncluded = true
+				p.InclusionDistance = latestPerf.inclusionSlot - latestPerf.attestedSlot
+				p.CorrectSource = latestPerf.timelySource
+				p.CorrectTarget = latestPerf.timelyTarget
+				p.CorrectHead = latestPerf.timelyHead
+			}
 			logFields["correctHead"] = latestPerf.timelyHead
 			logFields["correctSource"] = latestPerf.timelySource
 			logFields["correctTarget"] = latestPerf.timelyTarget
@@ -29,6 +29,14 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 	}
 	blk := b.Block()
 
+	// The monitor may run without tracked validators, waiting for them to be added at runtime.
+	s.RLock()
+	tracking := len(s.TrackedValidators) > 0
+	s.RUnlock()
+	if !tracking {
+		return
+	}
+
 	s.processSlashings(blk)
 	s.processExitsFromBlock(blk)
 
@@ -54,6 +62,7 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 		s.updateSyncCommitteeTrackedVals(st)
 	}
 
+	s.processEpochTransition(ctx, st, currEpoch)
 	s.processSyncAggregate(st, blk)
 	s.processProposedBlock(st, root, blk)
 	s.processAttestations(ctx, st, blk)
@@ -88,6 +97,10 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		aggPerf.totalProposedCount++
 		s.aggregatedPerformance[blk.ProposerIndex()] = aggPerf
 
+		if p := s.pendingEpochPerformance(blk.ProposerIndex(), slots.ToEpoch(blk.Slot())); p != nil {
+			p.ProposedBlocks++
+		}
+
 		parentRoot := blk.ParentRoot()
 		log.WithFields(logrus.Fields{
 			"proposerIndex": blk.ProposerIndex(),
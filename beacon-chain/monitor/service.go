@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 )
@@ -70,7 +71,8 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch, pendingPerformance,
+	// performanceHistory and lastBlockEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
@@ -78,6 +80,12 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+
+	// Performance of the tracked validators in the epochs which are not final yet, and the
+	// rolling history of the epochs which are.
+	pendingPerformance map[primitives.Epoch]map[primitives.ValidatorIndex]*validator.EpochPerformance
+	performanceHistory map[primitives.ValidatorIndex][]*validator.EpochPerformance
+	lastBlockEpoch     primitives.Epoch
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -91,6 +99,8 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		latestPerformance:           make(map[primitives.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[primitives.ValidatorIndex]ValidatorAggregatedPerformance),
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
+		pendingPerformance:          make(map[primitives.Epoch]map[primitives.ValidatorIndex]*validator.EpochPerformance),
+		performanceHistory:          make(map[primitives.ValidatorIndex][]*validator.EpochPerformance),
 		isLogging:                   false,
 	}
 	for _, idx := range tracked {
@@ -155,19 +165,27 @@ func (s *Service) run() {
 // and validatorAggregatedPerformance for each tracked validator.
 func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch primitives.Epoch) {
 	for idx := range s.TrackedValidators {
-		balance, err := state.BalanceAtIndex(idx)
-		if err != nil {
-			log.WithError(err).WithField("validatorIndex", idx).Error(
-				"Could not fetch starting balance, skipping aggregated logs.")
-			balance = 0
-		}
-		s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
-			startEpoch:   epoch,
-			startBalance: balance,
-		}
-		s.latestPerformance[idx] = ValidatorLatestPerformance{
-			balance: balance,
-		}
+		s.initializeValidatorPerformance(state, idx, epoch)
+	}
+	s.lastBlockEpoch = epoch
+}
+
+// initializeValidatorPerformance initializes the validatorLatestPerformance
+// and validatorAggregatedPerformance of a tracked validator.
+// It assumes the caller holds the service Lock
+func (s *Service) initializeValidatorPerformance(state state.BeaconState, idx primitives.ValidatorIndex, epoch primitives.Epoch) {
+	balance, err := state.BalanceAtIndex(idx)
+	if err != nil {
+		log.WithError(err).WithField("validatorIndex", idx).Error(
+			"Could not fetch starting balance, skipping aggregated logs.")
+		balance = 0
+	}
+	s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
+		startEpoch:   epoch,
+		startBalance: balance,
+	}
+	s.latestPerformance[idx] = ValidatorLatestPerformance{
+		balance: balance,
 	}
 }
 
@@ -274,16 +292,22 @@ func (s *Service) updateSyncCommitteeTrackedVals(state state.BeaconState) {
 	s.Lock()
 	defer s.Unlock()
 	for idx := range s.TrackedValidators {
-		syncIdx, err := helpers.CurrentPeriodSyncSubcommitteeIndices(state, idx)
-		if err != nil {
-			log.WithError(err).WithField("validatorIndex", idx).Error(
-				"Sync committee assignments will not be reported")
-			delete(s.trackedSyncCommitteeIndices, idx)
-		} else if len(syncIdx) == 0 {
-			delete(s.trackedSyncCommitteeIndices, idx)
-		} else {
-			s.trackedSyncCommitteeIndices[idx] = syncIdx
-		}
+		s.updateSyncCommitteeTrackedVal(state, idx)
 	}
 	s.lastSyncedEpoch = slots.ToEpoch(state.Slot())
 }
+
+// updateSyncCommitteeTrackedVal updates the sync committee assignments of a tracked validator.
+// It assumes the caller holds the service Lock
+func (s *Service) updateSyncCommitteeTrackedVal(state state.BeaconState, idx primitives.ValidatorIndex) {
+	syncIdx, err := helpers.CurrentPeriodSyncSubcommitteeIndices(state, idx)
+	if err != nil {
+		log.WithError(err).WithField("validatorIndex", idx).Error(
+			"Sync committee assignments will not be reported")
+		delete(s.trackedSyncCommitteeIndices, idx)
+	} else if len(syncIdx) == 0 {
+		delete(s.trackedSyncCommitteeIndices, idx)
+	} else {
+		s.trackedSyncCommitteeIndices[idx] = syncIdx
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v5/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	mockengine "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/mock-engine"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/gateway"
@@ -45,6 +46,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	rewardsindexer "github.com/prysmaticlabs/prysm/v5/beacon-chain/rewards-indexer"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
@@ -365,6 +367,11 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register builder service")
 	}
 
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register validator monitoring service")
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := newRouter(cliCtx)
 	if err := beacon.registerRPCService(router); err != nil {
@@ -376,9 +383,9 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register GRPC gateway service")
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
-		return errors.Wrap(err, "could not register validator monitoring service")
+	log.Debugln("Registering Rewards Indexer Service")
+	if err := beacon.registerRewardsIndexerService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register rewards indexer service")
 	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
@@ -479,7 +486,7 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
-func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string) (*kv.Store, error) {
+func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string, opts ...kv.KVStoreOption) (*kv.Store, error) {
 	var err error
 	clearDBConfirmed := false
 
@@ -507,7 +514,7 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 			return nil, errors.Wrap(err, "could not clear blob storage")
 		}
 
-		d, err = kv.NewKVStore(b.ctx, dbPath)
+		d, err = kv.NewKVStore(b.ctx, dbPath, opts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not create new database")
 		}
@@ -549,13 +556,25 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	log.WithField("databasePath", dbPath).Info("Checking DB")
 
-	d, err := kv.NewKVStore(b.ctx, dbPath)
+	var dbOpts []kv.KVStoreOption
+	if cliCtx.IsSet(flags.StateDiffExponents.Name) {
+		var exponents []uint64
+		for _, e := range cliCtx.IntSlice(flags.StateDiffExponents.Name) {
+			if e < 0 {
+				return fmt.Errorf("invalid negative state diff exponent %d", e)
+			}
+			exponents = append(exponents, uint64(e))
+		}
+		dbOpts = append(dbOpts, kv.WithStateDiffExponents(exponents))
+	}
+
+	d, err := kv.NewKVStore(b.ctx, dbPath, dbOpts...)
 	if err != nil {
 		return errors.Wrapf(err, "could not create database at %s", dbPath)
 	}
 
 	if clearDBRequired || forceClearDBRequired {
-		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath)
+		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath, dbOpts...)
 		if err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
@@ -820,6 +839,17 @@ func (b *BeaconNode) registerPOWChainService() error {
 		execution.WithFinalizedStateAtStartup(b.finalizedStateAtStartUp),
 		execution.WithJwtId(b.cliCtx.String(flags.JwtId.Name)),
 	)
+	if b.cliCtx.Bool(flags.InteropMockExecutionEngineFlag.Name) {
+		engine, err := mockengine.New()
+		if err != nil {
+			return errors.Wrap(err, "could not create mock execution engine")
+		}
+		server, err := engine.Server()
+		if err != nil {
+			return errors.Wrap(err, "could not serve mock execution engine")
+		}
+		opts = append(opts, execution.WithInProcessEngine(server))
+	}
 	web3Service, err := execution.NewService(b.ctx, opts...)
 	if err != nil {
 		return errors.Wrap(err, "could not register proof-of-work chain web3Service")
@@ -943,10 +973,21 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	}
 
 	var slasherService *slasher.Service
+	var slashingChecker slasher.SlashingChecker
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slashingChecker = slasherService
+	}
+
+	var validatorMonitor monitor.PerformanceTracker
+	if b.validatorMonitorEnabled() {
+		var monitorService *monitor.Service
+		if err := b.services.FetchService(&monitorService); err != nil {
+			return err
+		}
+		validatorMonitor = monitorService
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -1009,6 +1050,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
+		EngineStatusFetcher:           web3Service,
 		ChainStartFetcher:             chainStartFetcher,
 		MockEth1Votes:                 mockEth1DataVotes,
 		SyncService:                   syncService,
@@ -1021,11 +1063,13 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
 		MaxMsgSize:                    maxMsgSize,
 		BlockBuilder:                  b.fetchBuilderService(),
+		SlashingChecker:               slashingChecker,
 		Router:                        router,
 		ClockWaiter:                   b.clockWaiter,
 		BlobStorage:                   b.BlobStorage,
 		TrackedValidatorsCache:        b.trackedValidatorsCache,
 		PayloadIDCache:                b.payloadIDCache,
+		ValidatorMonitor:              validatorMonitor,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -1124,11 +1168,17 @@ func (b *BeaconNode) registerDeterministicGenesisService() error {
 	return nil
 }
 
+// validatorMonitorEnabled returns whether validators are given to the monitor at startup, or the monitor is
+// explicitly enabled to track validators at runtime.
+func (b *BeaconNode) validatorMonitorEnabled() bool {
+	return b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name) != nil || b.cliCtx.Bool(cmd.EnableValidatorMonitorFlag.Name)
+}
+
 func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan struct{}) error {
-	cliSlice := b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name)
-	if cliSlice == nil {
+	if !b.validatorMonitorEnabled() {
 		return nil
 	}
+	cliSlice := b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name)
 	tracked := make([]primitives.ValidatorIndex, len(cliSlice))
 	for i := range tracked {
 		tracked[i] = primitives.ValidatorIndex(cliSlice[i])
@@ -1152,6 +1202,36 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 	return b.services.RegisterService(svc)
 }
 
+func (b *BeaconNode) registerRewardsIndexerService(initialSyncComplete chan struct{}) error {
+	if !b.cliCtx.Bool(flags.EnableRewardsIndexer.Name) {
+		return nil
+	}
+	cliSlice := b.cliCtx.IntSlice(flags.RewardsIndexerIndices.Name)
+	tracked := make([]primitives.ValidatorIndex, len(cliSlice))
+	for i := range tracked {
+		tracked[i] = primitives.ValidatorIndex(cliSlice[i])
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc, err := rewardsindexer.NewService(
+		b.ctx,
+		rewardsindexer.WithDatabase(b.db),
+		rewardsindexer.WithStateGen(b.stateGen),
+		rewardsindexer.WithHeadFetcher(chainService),
+		rewardsindexer.WithClockWaiter(b.clockWaiter),
+		rewardsindexer.WithInitialSyncComplete(initialSyncComplete),
+		rewardsindexer.WithTrackedValidators(tracked),
+		rewardsindexer.WithRetentionEpochs(primitives.Epoch(b.cliCtx.Uint64(flags.RewardsIndexerRetentionEpochs.Name))),
+	)
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -1159,7 +1239,7 @@ func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 	}
 
 	opts := b.serviceFlagOpts.builderOpts
-	opts = append(opts, builder.WithHeadFetcher(chainService), builder.WithDatabase(b.db))
+	opts = append(opts, builder.WithHeadFetcher(chainService), builder.WithCanonicalFetcher(chainService), builder.WithDatabase(b.db), builder.WithStateNotifier(b))
 
 	// make cache the default.
 	if !cliCtx.Bool(features.DisableRegistrationCache.Name) {
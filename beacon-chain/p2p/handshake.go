@@ -86,7 +86,11 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 				s.peers.Add(nil /* ENR */, remotePeer, conn.RemoteMultiaddr(), conn.Stat().Direction)
 				// Defensive check in the event we still get a bad peer.
 				if s.peers.IsBad(remotePeer) {
-					log.WithField("reason", "bad peer").Trace("Ignoring connection request")
+					logger := log.WithField("reason", "bad peer")
+					if b, err := s.peers.ScoreBreakdown(remotePeer); err == nil {
+						logger = logger.WithFields(b.LogFields())
+					}
+					logger.Debug("Ignoring connection request")
 					disconnectFromPeer()
 					return
 				}
@@ -52,6 +52,8 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	// BannedUntil is the end of the ban of a peer found bad before a restart.
+	BannedUntil time.Time
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
@@ -0,0 +1,171 @@
+package peers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+)
+
+// PersistedPeer is the data of a peer kept across restarts of the node.
+type PersistedPeer struct {
+	ID               peer.ID           `json:"id"`
+	Enr              []byte            `json:"enr,omitempty"`
+	Address          string            `json:"address"`
+	Direction        network.Direction `json:"direction"`
+	ChainState       []byte            `json:"chain_state,omitempty"`
+	LastSeen         time.Time         `json:"last_seen"`
+	Score            float64           `json:"score"`
+	BadResponses     int               `json:"bad_responses"`
+	ProcessedBlocks  uint64            `json:"processed_blocks"`
+	GossipScore      float64           `json:"gossip_score"`
+	BehaviourPenalty float64           `json:"behaviour_penalty"`
+	NextValidTime    time.Time         `json:"next_valid_time"`
+	BannedUntil      time.Time         `json:"banned_until"`
+}
+
+// Snapshot returns the data of the known peers to persist across restarts, the best-scoring peers first. The peers
+// the scorers consider bad are banned for the ban duration, unless they are already banned for longer. At most limit
+// peers are returned besides the banned ones, which are all kept. Trusted peers and peers without a known address
+// are left out.
+func (p *Status) Snapshot(limit int, banDuration time.Duration) []*PersistedPeer {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := prysmTime.Now()
+	all := make([]*PersistedPeer, 0, len(p.store.Peers()))
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Address == nil || p.store.IsTrustedPeer(pid) {
+			continue
+		}
+		persisted := &PersistedPeer{
+			ID:               pid,
+			Address:          peerData.Address.String(),
+			Direction:        peerData.Direction,
+			LastSeen:         peerData.ChainStateLastUpdated,
+			Score:            p.scorers.ScoreNoLock(pid),
+			BadResponses:     peerData.BadResponses,
+			ProcessedBlocks:  peerData.ProcessedBlocks,
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+			NextValidTime:    peerData.NextValidTime,
+			BannedUntil:      peerData.BannedUntil,
+		}
+		if peerData.ConnState == PeerConnected {
+			persisted.LastSeen = now
+		}
+		if p.scorers.IsBadPeerNoLock(pid) && persisted.BannedUntil.Before(now.Add(banDuration)) {
+			persisted.BannedUntil = now.Add(banDuration)
+		}
+		if peerData.Enr != nil {
+			record, err := rlp.EncodeToBytes(peerData.Enr)
+			if err != nil {
+				log.WithError(err).WithField("peer", pid).Debug("Could not encode peer ENR")
+			} else {
+				persisted.Enr = record
+			}
+		}
+		if peerData.ChainState != nil {
+			chainState, err := peerData.ChainState.MarshalSSZ()
+			if err != nil {
+				log.WithError(err).WithField("peer", pid).Debug("Could not encode peer chain state")
+			} else {
+				persisted.ChainState = chainState
+			}
+		}
+		all = append(all, persisted)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Score > all[j].Score
+	})
+
+	snapshot := make([]*PersistedPeer, 0, len(all))
+	numPeers := 0
+	for _, persisted := range all {
+		if persisted.BannedUntil.After(now) {
+			snapshot = append(snapshot, persisted)
+			continue
+		}
+		if numPeers < limit {
+			snapshot = append(snapshot, persisted)
+			numPeers++
+		}
+	}
+	return snapshot
+}
+
+// Restore adds the persisted peers to the known peers, along with their scoring data and bans. Peers which are
+// already known are left untouched. It returns the restored peers which are not bad, the best-scoring peers first,
+// so that they are dialed first.
+func (p *Status) Restore(persisted []*PersistedPeer) []peer.AddrInfo {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	sort.SliceStable(persisted, func(i, j int) bool {
+		return persisted[i].Score > persisted[j].Score
+	})
+	now := prysmTime.Now()
+	dialable := make([]peer.AddrInfo, 0, len(persisted))
+	restored := 0
+	for _, pp := range persisted {
+		if _, ok := p.store.PeerData(pp.ID); ok {
+			continue
+		}
+		address, err := ma.NewMultiaddr(pp.Address)
+		if err != nil {
+			log.WithError(err).WithField("peer", pp.ID).Debug("Could not decode persisted peer address")
+			continue
+		}
+		peerData := &peerdata.PeerData{
+			Address:               address,
+			Direction:             pp.Direction,
+			ConnState:             PeerDisconnected,
+			NextValidTime:         pp.NextValidTime,
+			BannedUntil:           pp.BannedUntil,
+			ChainStateLastUpdated: pp.LastSeen,
+			BadResponses:          pp.BadResponses,
+			ProcessedBlocks:       pp.ProcessedBlocks,
+		}
+		// The gossip score of a peer is only updated while it is connected, so the gossip score of a banned peer
+		// is not restored, for it not to outlive the ban.
+		if !now.Before(pp.BannedUntil) {
+			peerData.GossipScore = pp.GossipScore
+			peerData.BehaviourPenalty = pp.BehaviourPenalty
+		}
+		if len(pp.Enr) > 0 {
+			record := &enr.Record{}
+			if err := rlp.DecodeBytes(pp.Enr, record); err != nil {
+				log.WithError(err).WithField("peer", pp.ID).Debug("Could not decode persisted peer ENR")
+			} else {
+				peerData.Enr = record
+			}
+		}
+		if len(pp.ChainState) > 0 {
+			chainState := &pb.Status{}
+			if err := chainState.UnmarshalSSZ(pp.ChainState); err != nil {
+				log.WithError(err).WithField("peer", pp.ID).Debug("Could not decode persisted peer chain state")
+			} else {
+				peerData.ChainState = chainState
+			}
+		}
+		p.store.SetPeerData(pp.ID, peerData)
+		p.addIpToTracker(pp.ID)
+		restored++
+		if !p.isBad(pp.ID) {
+			dialable = append(dialable, peer.AddrInfo{ID: pp.ID, Addrs: []ma.Multiaddr{address}})
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"restored": restored,
+		"dialable": len(dialable),
+	}).Debug("Restored persisted peers")
+	return dialable
+}
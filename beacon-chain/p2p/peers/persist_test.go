@@ -0,0 +1,131 @@
+package peers_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func newPersistTestStatus() *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+}
+
+func TestStatus_SnapshotRestore(t *testing.T) {
+	p := newPersistTestStatus()
+	good, bad, trusted, noAddr := peer.ID("good"), peer.ID("bad"), peer.ID("trusted"), peer.ID("noAddr")
+	for i, pid := range []peer.ID{good, bad, trusted} {
+		address, err := ma.NewMultiaddr("/ip4/213.202.254." + strconv.Itoa(i+1) + "/tcp/13000")
+		require.NoError(t, err)
+		record := &enr.Record{}
+		record.Set(enr.IPv4{213, 202, 254, byte(1 + i)})
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		require.NoError(t, enode.SignV4(record, key))
+		p.Add(record, pid, address, network.DirOutbound)
+	}
+	p.SetTrustedPeers([]peer.ID{trusted})
+	p.Scorers().BadResponsesScorer().Increment(noAddr)
+	p.SetConnectionState(good, peers.PeerConnected)
+	p.SetChainState(good, &pb.Status{
+		ForkDigest:     []byte{1, 2, 3, 4},
+		FinalizedRoot:  make([]byte, 32),
+		FinalizedEpoch: 10,
+		HeadRoot:       make([]byte, 32),
+		HeadSlot:       330,
+	})
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(good, 64)
+	p.Scorers().BadResponsesScorer().Increment(bad)
+	p.Scorers().BadResponsesScorer().Increment(bad)
+	require.Equal(t, true, p.IsBad(bad))
+
+	snapshot := p.Snapshot(10, time.Hour)
+	require.Equal(t, 2, len(snapshot))
+	assert.Equal(t, good, snapshot[0].ID)
+	assert.Equal(t, true, snapshot[0].BannedUntil.IsZero())
+	assert.Equal(t, bad, snapshot[1].ID)
+	assert.Equal(t, true, snapshot[1].BannedUntil.After(time.Now().Add(59*time.Minute)))
+
+	restored := newPersistTestStatus()
+	dialable := restored.Restore(snapshot)
+	require.Equal(t, 1, len(dialable))
+	assert.Equal(t, good, dialable[0].ID)
+	assert.Equal(t, "/ip4/213.202.254.1/tcp/13000", dialable[0].Addrs[0].String())
+
+	connState, err := restored.ConnectionState(good)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, connState)
+	chainState, err := restored.ChainState(good)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(330), uint64(chainState.HeadSlot))
+	assert.Equal(t, uint64(64), restored.Scorers().BlockProviderScorer().ProcessedBlocks(good))
+	record, err := restored.ENR(good)
+	require.NoError(t, err)
+	var ip enr.IPv4
+	require.NoError(t, record.Load(&ip))
+	assert.DeepEqual(t, enr.IPv4{213, 202, 254, 1}, ip)
+
+	// The bad peer is banned, even once its bad responses have decayed.
+	assert.Equal(t, true, restored.IsBad(bad))
+	restored.Scorers().BadResponsesScorer().Decay()
+	restored.Scorers().BadResponsesScorer().Decay()
+	assert.Equal(t, true, restored.IsBad(bad))
+	b, err := restored.ScoreBreakdown(bad)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(b.BadPeerReasons))
+	assert.StringContains(t, "banned until", b.BadPeerReasons[0])
+}
+
+func TestStatus_Snapshot_Limit(t *testing.T) {
+	p := newPersistTestStatus()
+	pids := []peer.ID{"a", "b", "c"}
+	for i, pid := range pids {
+		address, err := ma.NewMultiaddr("/ip4/10.0.0." + strconv.Itoa(i+1) + "/tcp/13000")
+		require.NoError(t, err)
+		p.Add(nil, pid, address, network.DirInbound)
+	}
+	// The peer with a bad response has a lower score, and is left out.
+	p.Scorers().BadResponsesScorer().Increment("b")
+
+	snapshot := p.Snapshot(2, time.Hour)
+	require.Equal(t, 2, len(snapshot))
+	for _, pp := range snapshot {
+		assert.NotEqual(t, peer.ID("b"), pp.ID)
+	}
+}
+
+func TestStatus_Restore_ExpiredBan(t *testing.T) {
+	p := newPersistTestStatus()
+	dialable := p.Restore([]*peers.PersistedPeer{
+		{ID: "a", Address: "/ip4/10.0.0.1/tcp/13000", BannedUntil: time.Now().Add(-time.Minute), GossipScore: -10},
+		{ID: "b", Address: "/ip4/10.0.0.2/tcp/13000", BannedUntil: time.Now().Add(time.Minute), GossipScore: -1000},
+		{ID: "c", Address: "not an address"},
+	})
+	require.Equal(t, 1, len(dialable))
+	assert.Equal(t, peer.ID("a"), dialable[0].ID)
+	assert.Equal(t, -10.0, p.Scorers().GossipScorer().Score("a"))
+	// The gossip score of a banned peer is not restored, for it not to outlive the ban.
+	assert.Equal(t, 0.0, p.Scorers().GossipScorer().Score("b"))
+	assert.Equal(t, true, p.IsBad("b"))
+	_, err := p.Address("c")
+	require.NotNil(t, err)
+}
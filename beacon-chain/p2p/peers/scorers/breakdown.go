@@ -0,0 +1,125 @@
+package scorers
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	pbrpc "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// ScorerBreakdown is the score a single scorer gives to a peer, and what it contributes to the overall peer score.
+type ScorerBreakdown struct {
+	// Score is the score given by the scorer.
+	Score float64
+	// Weight is the share of the scorer in the overall score, from 0 to 1.
+	Weight float64
+	// Contribution is the part of the overall score coming from the scorer.
+	Contribution float64
+}
+
+// ScoreBreakdown details how the overall score of a peer is calculated, and the data the scorers base it on.
+type ScoreBreakdown struct {
+	Score             float64
+	BadResponses      ScorerBreakdown
+	BlockProvider     ScorerBreakdown
+	PeerStatus        ScorerBreakdown
+	Gossip            ScorerBreakdown
+	BadResponsesCount int
+	ProcessedBlocks   uint64
+	BehaviourPenalty  float64
+	TopicScores       map[string]*pbrpc.TopicScoreSnapshot
+	ValidationError   error
+	// BadPeerReasons explains why the peer is considered bad. It is empty if the peer is not bad.
+	BadPeerReasons []string
+}
+
+// ScoreBreakdown returns the contribution of every scorer to the score of the peer.
+func (s *Service) ScoreBreakdown(pid peer.ID) (*ScoreBreakdown, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.ScoreBreakdownNoLock(pid)
+}
+
+// ScoreBreakdownNoLock is a lock-free version of ScoreBreakdown.
+func (s *Service) ScoreBreakdownNoLock(pid peer.ID) (*ScoreBreakdown, error) {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return nil, peerdata.ErrPeerUnknown
+	}
+	breakdown := func(scorer Scorer, score float64) ScorerBreakdown {
+		weight := s.scorerWeight(scorer)
+		return ScorerBreakdown{Score: score, Weight: weight, Contribution: score * weight}
+	}
+	b := &ScoreBreakdown{
+		Score:             s.ScoreNoLock(pid),
+		BadResponses:      breakdown(s.scorers.badResponsesScorer, s.scorers.badResponsesScorer.scoreNoLock(pid)),
+		BlockProvider:     breakdown(s.scorers.blockProviderScorer, s.scorers.blockProviderScorer.scoreNoLock(pid)),
+		PeerStatus:        breakdown(s.scorers.peerStatusScorer, s.scorers.peerStatusScorer.scoreNoLock(pid)),
+		Gossip:            breakdown(s.scorers.gossipScorer, s.scorers.gossipScorer.scoreNoLock(pid)),
+		BadResponsesCount: peerData.BadResponses,
+		ProcessedBlocks:   s.scorers.blockProviderScorer.processedBlocksNoLock(pid),
+		BehaviourPenalty:  peerData.BehaviourPenalty,
+		TopicScores:       peerData.TopicScores,
+		ValidationError:   peerData.ChainStateValidationError,
+		BadPeerReasons:    s.BadPeerReasonsNoLock(pid),
+	}
+	return b, nil
+}
+
+// BadPeerReasons returns a description of every check of the scorers the peer fails, in the order IsBadPeer
+// checks them. It is empty if the peer is not considered bad.
+func (s *Service) BadPeerReasons(pid peer.ID) []string {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.BadPeerReasonsNoLock(pid)
+}
+
+// BadPeerReasonsNoLock is a lock-free version of BadPeerReasons.
+func (s *Service) BadPeerReasonsNoLock(pid peer.ID) []string {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return nil
+	}
+	var reasons []string
+	if s.scorers.badResponsesScorer.isBadPeerNoLock(pid) {
+		reasons = append(reasons, fmt.Sprintf(
+			"%d bad responses, threshold is %d", peerData.BadResponses, s.scorers.badResponsesScorer.config.Threshold,
+		))
+	}
+	if s.scorers.peerStatusScorer.isBadPeerNoLock(pid) {
+		reasons = append(reasons, fmt.Sprintf("invalid chain status: %v", peerData.ChainStateValidationError))
+	}
+	if features.Get().EnablePeerScorer && s.scorers.gossipScorer.isBadPeerNoLock(pid) {
+		reasons = append(reasons, fmt.Sprintf(
+			"gossip score %.4f is below threshold %.4f", peerData.GossipScore, s.scorers.gossipScorer.config.Threshold,
+		))
+	}
+	return reasons
+}
+
+// LogFields returns the breakdown as structured log fields.
+func (b *ScoreBreakdown) LogFields() logrus.Fields {
+	round := func(f float64) float64 {
+		return math.Round(f*ScoreRoundingFactor) / ScoreRoundingFactor
+	}
+	fields := logrus.Fields{
+		"score":                     b.Score,
+		"badResponsesContribution":  round(b.BadResponses.Contribution),
+		"blockProviderContribution": round(b.BlockProvider.Contribution),
+		"peerStatusContribution":    round(b.PeerStatus.Contribution),
+		"gossipContribution":        round(b.Gossip.Contribution),
+		"badResponses":              b.BadResponsesCount,
+		"processedBlocks":           b.ProcessedBlocks,
+		"gossipScore":               round(b.Gossip.Score),
+		"gossipBehaviourPenalty":    round(b.BehaviourPenalty),
+		"reasons":                   b.BadPeerReasons,
+	}
+	if b.ValidationError != nil {
+		fields["validationError"] = b.ValidationError.Error()
+	}
+	return fields
+}
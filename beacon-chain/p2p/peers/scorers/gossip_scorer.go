@@ -21,17 +21,24 @@ type GossipScorer struct {
 }
 
 // GossipScorerConfig holds configuration parameters for gossip scoring service.
-type GossipScorerConfig struct{}
+type GossipScorerConfig struct {
+	// Threshold specifies the gossip score under which a peer is considered bad.
+	Threshold float64
+}
 
 // newGossipScorer creates new gossip scoring service.
 func newGossipScorer(store *peerdata.Store, config *GossipScorerConfig) *GossipScorer {
 	if config == nil {
 		config = &GossipScorerConfig{}
 	}
-	return &GossipScorer{
+	scorer := &GossipScorer{
 		config: config,
 		store:  store,
 	}
+	if scorer.config.Threshold == 0 {
+		scorer.config.Threshold = gossipThreshold
+	}
+	return scorer
 }
 
 // Score returns calculated peer score.
@@ -63,7 +70,7 @@ func (s *GossipScorer) isBadPeerNoLock(pid peer.ID) bool {
 	if !ok {
 		return false
 	}
-	return peerData.GossipScore < gossipThreshold
+	return peerData.GossipScore < s.config.Threshold
 }
 
 // BadPeers returns the peers that are considered bad.
@@ -80,6 +87,11 @@ func (s *GossipScorer) BadPeers() []peer.ID {
 	return badPeers
 }
 
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
 // SetGossipData sets the gossip related data of a peer.
 func (s *GossipScorer) SetGossipData(pid peer.ID, gScore float64,
 	bPenalty float64, topicScores map[string]*pbrpc.TopicScoreSnapshot) {
@@ -2,6 +2,7 @@ package scorers
 
 import (
 	"context"
+	"errors"
 	"math"
 	"time"
 
@@ -49,6 +50,53 @@ type Config struct {
 	GossipScorerConfig        *GossipScorerConfig
 }
 
+// Weights holds the weights of the scorers in the overall peer score. The contribution of a scorer is its weight
+// divided by the sum of all weights.
+type Weights struct {
+	BadResponses  float64
+	BlockProvider float64
+	PeerStatus    float64
+	Gossip        float64
+}
+
+// Thresholds holds the limits past which a peer is considered bad.
+type Thresholds struct {
+	// BadResponses is the number of bad responses from which a peer is bad.
+	BadResponses int
+	// Gossip is the gossip score under which a peer is bad.
+	Gossip float64
+}
+
+// Validate checks the weights can be set.
+func (w *Weights) Validate() error {
+	for _, v := range []float64{w.BadResponses, w.BlockProvider, w.PeerStatus, w.Gossip} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.New("scorer weights must be finite numbers")
+		}
+	}
+	if w.BadResponses < 0 || w.BlockProvider < 0 || w.PeerStatus < 0 || w.Gossip < 0 {
+		return errors.New("scorer weights must not be negative")
+	}
+	if w.BadResponses+w.BlockProvider+w.PeerStatus+w.Gossip == 0 {
+		return errors.New("at least one scorer weight must be positive")
+	}
+	return nil
+}
+
+// Validate checks the thresholds can be set.
+func (t *Thresholds) Validate() error {
+	if t.BadResponses <= 0 {
+		return errors.New("bad responses threshold must be positive")
+	}
+	if math.IsNaN(t.Gossip) || math.IsInf(t.Gossip, 0) {
+		return errors.New("gossip threshold must be a finite number")
+	}
+	if t.Gossip >= 0 {
+		return errors.New("gossip threshold must be negative")
+	}
+	return nil
+}
+
 // NewService provides fully initialized peer scoring service.
 func NewService(ctx context.Context, store *peerdata.Store, config *Config) *Service {
 	s := &Service{
@@ -173,6 +221,92 @@ func (s *Service) ValidationError(pid peer.ID) error {
 	return peerData.ChainStateValidationError
 }
 
+// Weights returns the current weights of the scorers.
+func (s *Service) Weights() *Weights {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.weightsLocked()
+}
+
+func (s *Service) weightsLocked() *Weights {
+	return &Weights{
+		BadResponses:  s.weights[s.scorers.badResponsesScorer],
+		BlockProvider: s.weights[s.scorers.blockProviderScorer],
+		PeerStatus:    s.weights[s.scorers.peerStatusScorer],
+		Gossip:        s.weights[s.scorers.gossipScorer],
+	}
+}
+
+// SetWeights replaces the weights of the scorers, which apply to the scores calculated from then on.
+func (s *Service) SetWeights(w *Weights) error {
+	if err := w.Validate(); err != nil {
+		return err
+	}
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.setWeightsLocked(w)
+	return nil
+}
+
+func (s *Service) setWeightsLocked(w *Weights) {
+	s.weights = make(map[Scorer]float64)
+	s.totalWeight = 0
+	s.setScorerWeight(s.scorers.badResponsesScorer, w.BadResponses)
+	s.setScorerWeight(s.scorers.blockProviderScorer, w.BlockProvider)
+	s.setScorerWeight(s.scorers.peerStatusScorer, w.PeerStatus)
+	s.setScorerWeight(s.scorers.gossipScorer, w.Gossip)
+}
+
+// Thresholds returns the current thresholds of the scorers.
+func (s *Service) Thresholds() *Thresholds {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.thresholdsLocked()
+}
+
+func (s *Service) thresholdsLocked() *Thresholds {
+	return &Thresholds{
+		BadResponses: s.scorers.badResponsesScorer.config.Threshold,
+		Gossip:       s.scorers.gossipScorer.config.Threshold,
+	}
+}
+
+// SetThresholds replaces the thresholds of the scorers. Peers already past the new thresholds are considered bad,
+// and disconnected, the next time they are checked.
+func (s *Service) SetThresholds(t *Thresholds) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	s.store.Lock()
+	defer s.store.Unlock()
+	s.setThresholdsLocked(t)
+	return nil
+}
+
+func (s *Service) setThresholdsLocked(t *Thresholds) {
+	s.scorers.badResponsesScorer.config.Threshold = t.BadResponses
+	s.scorers.gossipScorer.config.Threshold = t.Gossip
+}
+
+// UpdateScoring calls update with the current weights and thresholds, and sets the values it leaves them with. They
+// are read and set under the same lock, so that concurrent updates do not overwrite each other, and nothing is set
+// if either the weights or the thresholds are invalid.
+func (s *Service) UpdateScoring(update func(w *Weights, t *Thresholds)) (*Weights, *Thresholds, error) {
+	s.store.Lock()
+	defer s.store.Unlock()
+	w, t := s.weightsLocked(), s.thresholdsLocked()
+	update(w, t)
+	if err := w.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := t.Validate(); err != nil {
+		return nil, nil, err
+	}
+	s.setWeightsLocked(w)
+	s.setThresholdsLocked(t)
+	return w, t, nil
+}
+
 // loop handles background tasks.
 func (s *Service) loop(ctx context.Context) {
 	decayBadResponsesStats := time.NewTicker(s.scorers.badResponsesScorer.Params().DecayInterval)
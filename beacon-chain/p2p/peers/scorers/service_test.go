@@ -2,15 +2,19 @@ package scorers_test
 
 import (
 	"context"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
 func TestScorers_Service_Init(t *testing.T) {
@@ -308,3 +312,116 @@ func TestScorers_Service_BadPeers(t *testing.T) {
 	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer3"))
 	assert.Equal(t, 2, len(peerStatuses.Scorers().BadPeers()))
 }
+
+func TestScorers_Service_ScoreBreakdown(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     5,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	s := peerStatuses.Scorers()
+
+	_, err := s.ScoreBreakdown("peer1")
+	require.ErrorIs(t, err, peerdata.ErrPeerUnknown)
+
+	s.BadResponsesScorer().Increment("peer1")
+	s.GossipScorer().SetGossipData("peer1", -150, 2, nil)
+	b, err := s.ScoreBreakdown("peer1")
+	require.NoError(t, err)
+	assert.Equal(t, -2.0, b.BadResponses.Score)
+	assert.Equal(t, 0.3, b.BadResponses.Weight)
+	assert.Equal(t, -150.0, b.Gossip.Score)
+	assert.Equal(t, 0.4, b.Gossip.Weight)
+	assert.Equal(t, 1, b.BadResponsesCount)
+	assert.Equal(t, 2.0, b.BehaviourPenalty)
+	sum := b.BadResponses.Contribution + b.BlockProvider.Contribution + b.PeerStatus.Contribution + b.Gossip.Contribution
+	assert.Equal(t, s.Score("peer1"), math.Round(sum*scorers.ScoreRoundingFactor)/scorers.ScoreRoundingFactor)
+	assert.DeepEqual(t, []string{"gossip score -150.0000 is below threshold -100.0000"}, b.BadPeerReasons)
+
+	s.GossipScorer().SetGossipData("peer1", 0, 0, nil)
+	b, err = s.ScoreBreakdown("peer1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(b.BadPeerReasons))
+	assert.Equal(t, false, s.IsBadPeer("peer1"))
+}
+
+func TestScorers_Service_SetWeights(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	s := peerStatuses.Scorers()
+	assert.DeepEqual(t, &scorers.Weights{BadResponses: 0.3, PeerStatus: 0.3, Gossip: 0.4}, s.Weights())
+
+	for i := 0; i < 3; i++ {
+		s.BadResponsesScorer().Increment("peer1")
+	}
+	require.NoError(t, s.SetWeights(&scorers.Weights{BadResponses: 1}))
+	assert.Equal(t, s.BadResponsesScorer().Score("peer1"), s.Score("peer1"))
+	assert.Equal(t, 1, s.ActiveScorersCount())
+
+	require.ErrorContains(t, "must not be negative", s.SetWeights(&scorers.Weights{BadResponses: 1, Gossip: -1}))
+	require.ErrorContains(t, "at least one scorer weight must be positive", s.SetWeights(&scorers.Weights{}))
+	require.ErrorContains(t, "must be finite", s.SetWeights(&scorers.Weights{BadResponses: 1, Gossip: math.NaN()}))
+	require.ErrorContains(t, "must be finite", s.SetWeights(&scorers.Weights{BadResponses: math.Inf(1)}))
+	assert.DeepEqual(t, &scorers.Weights{BadResponses: 1}, s.Weights())
+}
+
+func TestScorers_Service_SetThresholds(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	s := peerStatuses.Scorers()
+	assert.DeepEqual(t, &scorers.Thresholds{BadResponses: scorers.DefaultBadResponsesThreshold, Gossip: -100}, s.Thresholds())
+
+	s.BadResponsesScorer().Increment("peer1")
+	s.GossipScorer().SetGossipData("peer2", -20, 0, nil)
+	assert.Equal(t, 0, len(s.BadPeers()))
+	require.NoError(t, s.SetThresholds(&scorers.Thresholds{BadResponses: 1, Gossip: -10}))
+	assert.Equal(t, true, s.IsBadPeer("peer1"))
+	assert.Equal(t, true, s.IsBadPeer("peer2"))
+
+	require.ErrorContains(t, "bad responses threshold must be positive", s.SetThresholds(&scorers.Thresholds{Gossip: -10}))
+	require.ErrorContains(t, "gossip threshold must be negative", s.SetThresholds(&scorers.Thresholds{BadResponses: 1}))
+	require.ErrorContains(t, "must be a finite number", s.SetThresholds(&scorers.Thresholds{BadResponses: 1, Gossip: math.NaN()}))
+	require.ErrorContains(t, "must be a finite number", s.SetThresholds(&scorers.Thresholds{BadResponses: 1, Gossip: math.Inf(-1)}))
+}
+
+func TestScorers_Service_UpdateScoring(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	s := peerStatuses.Scorers()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _, err := s.UpdateScoring(func(w *scorers.Weights, _ *scorers.Thresholds) { w.BlockProvider += 1 })
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _, err := s.UpdateScoring(func(_ *scorers.Weights, t *scorers.Thresholds) { t.BadResponses += 1 })
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 100.0, s.Weights().BlockProvider)
+	assert.Equal(t, scorers.DefaultBadResponsesThreshold+100, s.Thresholds().BadResponses)
+
+	// Nothing is set when the thresholds are invalid.
+	_, _, err := s.UpdateScoring(func(w *scorers.Weights, t *scorers.Thresholds) {
+		w.Gossip = 1
+		t.Gossip = 1
+	})
+	require.ErrorContains(t, "gossip threshold must be negative", err)
+	assert.Equal(t, 0.4, s.Weights().Gossip)
+}
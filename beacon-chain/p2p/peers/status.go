@@ -339,7 +339,39 @@ func (p *Status) isBad(pid peer.ID) bool {
 	if p.store.IsTrustedPeer(pid) {
 		return false
 	}
-	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
+	return p.isfromBadIP(pid) || p.isBanned(pid) || p.scorers.IsBadPeerNoLock(pid)
+}
+
+// isBanned checks whether the peer is still banned from before a restart.
+func (p *Status) isBanned(pid peer.ID) bool {
+	peerData, ok := p.store.PeerData(pid)
+	return ok && prysmTime.Now().Before(peerData.BannedUntil)
+}
+
+// ScoreBreakdown returns how the scorers score the peer. Its reasons to be considered bad include the colocation
+// of its IP address, which is not tracked by the scorers.
+func (p *Status) ScoreBreakdown(pid peer.ID) (*scorers.ScoreBreakdown, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	b, err := p.scorers.ScoreBreakdownNoLock(pid)
+	if err != nil {
+		return nil, err
+	}
+	if p.store.IsTrustedPeer(pid) {
+		b.BadPeerReasons = nil
+		return b, nil
+	}
+	var reasons []string
+	if p.isfromBadIP(pid) {
+		reasons = append(reasons, "too many peers from the same IP address")
+	}
+	if p.isBanned(pid) {
+		peerData, _ := p.store.PeerData(pid)
+		reasons = append(reasons, "banned until "+peerData.BannedUntil.UTC().Format(time.RFC3339))
+	}
+	b.BadPeerReasons = append(reasons, b.BadPeerReasons...)
+	return b, nil
 }
 
 // NextValidTime gets the earliest possible time it is to contact/dial
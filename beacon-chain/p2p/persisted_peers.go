@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// peersFileName is the file of the data directory the known peers are persisted to.
+	peersFileName = "peers.json"
+	// persistedPeersVersion is the version of the persisted peers file, increased on any incompatible change.
+	persistedPeersVersion = 1
+	// persistPeersInterval is how often the known peers are persisted.
+	persistPeersInterval = 5 * time.Minute
+	// maxPersistedPeers is the number of best-scoring peers persisted, besides the banned peers.
+	maxPersistedPeers = 500
+	// persistedBanDuration is how long a peer found bad stays banned across restarts, which matches the time it takes
+	// for a bad responses ban to decay.
+	persistedBanDuration = time.Hour
+)
+
+type persistedPeers struct {
+	Version int                    `json:"version"`
+	Peers   []*peers.PersistedPeer `json:"peers"`
+}
+
+// peersFilePath returns the path of the file the known peers are persisted to, or an empty string when the node
+// has no data directory to persist them in.
+func (s *Service) peersFilePath() string {
+	if s.cfg.DataDir == "" {
+		return ""
+	}
+	return path.Join(s.cfg.DataDir, peersFileName)
+}
+
+// restorePeers adds the peers persisted before the last restart to the known peers, keeping the ones to dial on start.
+func (s *Service) restorePeers() error {
+	filePath := s.peersFilePath()
+	if filePath == "" {
+		return nil
+	}
+	exists, err := file.Exists(filePath, file.Regular)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	enc, err := file.ReadFileAsBytes(filePath)
+	if err != nil {
+		return errors.Wrap(err, "could not read persisted peers")
+	}
+	persisted := &persistedPeers{}
+	if err := json.Unmarshal(enc, persisted); err != nil {
+		return errors.Wrap(err, "could not decode persisted peers")
+	}
+	if persisted.Version != persistedPeersVersion {
+		return errors.Errorf("unsupported persisted peers version %d, expected %d", persisted.Version, persistedPeersVersion)
+	}
+	s.restoredPeers = s.peers.Restore(persisted.Peers)
+	log.WithFields(logrus.Fields{
+		"numPeers":         len(persisted.Peers),
+		"numDialablePeers": len(s.restoredPeers),
+	}).Info("Restored peers known before restart")
+	return nil
+}
+
+// persistPeers saves the known peers, so that they are known again after a restart. The file is replaced at once, so
+// that it is never left half-written.
+func (s *Service) persistPeers() {
+	filePath := s.peersFilePath()
+	if filePath == "" {
+		return
+	}
+	enc, err := json.Marshal(&persistedPeers{
+		Version: persistedPeersVersion,
+		Peers:   s.peers.Snapshot(maxPersistedPeers, persistedBanDuration),
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not encode peers to persist")
+		return
+	}
+	tmpPath := filePath + ".tmp"
+	if err := file.WriteFile(tmpPath, enc); err != nil {
+		log.WithError(err).Error("Could not persist peers")
+		return
+	}
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		log.WithError(err).Error("Could not persist peers")
+	}
+}
+
+// dialRestoredPeers dials the peers restored at startup, the best-scoring peers first, up to the peer limit.
+func (s *Service) dialRestoredPeers() {
+	restored := s.restoredPeers
+	s.restoredPeers = nil
+	if len(restored) > int(s.cfg.MaxPeers) {
+		restored = restored[:s.cfg.MaxPeers]
+	}
+	for _, info := range restored {
+		if s.peers.IsActive(info.ID) {
+			continue
+		}
+		// Each dial is non-blocking, the dials being started in order of score.
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with restored peer %s", info.String())
+			}
+		}(info)
+	}
+}
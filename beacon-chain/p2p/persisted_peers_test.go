@@ -0,0 +1,61 @@
+package p2p
+
+import (
+	"context"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func newPeerStatus() *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+}
+
+func TestService_PersistRestorePeers(t *testing.T) {
+	dataDir := t.TempDir()
+	s := &Service{cfg: &Config{DataDir: dataDir, MaxPeers: 30}, peers: newPeerStatus()}
+	ids := libp2ptest.GeneratePeerIDs(2)
+	for i, pid := range ids {
+		address, err := ma.NewMultiaddr("/ip4/10.0.0." + strconv.Itoa(i+1) + "/tcp/13000")
+		require.NoError(t, err)
+		s.peers.Add(nil, pid, address, network.DirOutbound)
+	}
+	for i := 0; i < scorers.DefaultBadResponsesThreshold; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(ids[1])
+	}
+	s.persistPeers()
+	_, err := os.Stat(path.Join(dataDir, peersFileName))
+	require.NoError(t, err)
+
+	restarted := &Service{cfg: &Config{DataDir: dataDir, MaxPeers: 30}, peers: newPeerStatus()}
+	require.NoError(t, restarted.restorePeers())
+	require.Equal(t, 1, len(restarted.restoredPeers))
+	assert.Equal(t, ids[0], restarted.restoredPeers[0].ID)
+	assert.Equal(t, true, restarted.peers.IsBad(ids[1]))
+
+	require.NoError(t, os.WriteFile(path.Join(dataDir, peersFileName), []byte(`{"version":2}`), 0600))
+	require.ErrorContains(t, "unsupported persisted peers version 2", restarted.restorePeers())
+}
+
+func TestService_RestorePeers_NoFile(t *testing.T) {
+	s := &Service{cfg: &Config{DataDir: t.TempDir()}, peers: newPeerStatus()}
+	require.NoError(t, s.restorePeers())
+	assert.Equal(t, 0, len(s.restoredPeers))
+
+	// Peers are not persisted without a data directory.
+	s = &Service{cfg: &Config{}, peers: newPeerStatus()}
+	s.persistPeers()
+	require.NoError(t, s.restorePeers())
+}
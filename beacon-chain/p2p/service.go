@@ -77,6 +77,7 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	restoredPeers         []peer.AddrInfo
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -161,6 +162,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		},
 	})
 
+	if err := s.restorePeers(); err != nil {
+		log.WithError(err).Error("Could not restore persisted peers")
+	}
+
 	// Initialize Data maps.
 	types.InitializeDataMaps()
 
@@ -220,6 +225,7 @@ func (s *Service) Start() {
 		s.peers.SetTrustedPeers(pids)
 		s.connectWithAllTrustedPeers(addrs)
 	}
+	s.dialRestoredPeers()
 	// Initialize metadata according to the
 	// current epoch.
 	s.RefreshENR()
@@ -229,6 +235,7 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, s.peers, relayNodes...)
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, persistPeersInterval, s.persistPeers)
 	async.RunEvery(s.ctx, time.Duration(params.BeaconConfig().RespTimeout)*time.Second, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
@@ -261,6 +268,7 @@ func (s *Service) Start() {
 func (s *Service) Stop() error {
 	defer s.cancel()
 	s.started = false
+	s.persistPeers()
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
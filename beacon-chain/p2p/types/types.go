@@ -207,6 +207,70 @@ func (s BlobSidecarsByRootReq) Len() int {
 	return len(s)
 }
 
+// LightClientBootstrapReq is the block root of the trusted checkpoint requested in a LightClientBootstrap RPC request.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ marshals the light client bootstrap request into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, rootLength))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (_ *LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return errors.Errorf("expected buffer with length of %d but received length %d", rootLength, len(buf))
+	}
+	copy(r[:], buf)
+	return nil
+}
+
+// LightClientUpdatesByRangeReq specifies the range of sync committee periods
+// requested in a LightClientUpdatesByRange RPC request.
+type LightClientUpdatesByRangeReq struct {
+	StartPeriod uint64
+	Count       uint64
+}
+
+const lightClientUpdatesByRangeReqSize = 16
+
+// MarshalSSZTo marshals the light client updates by range request with the provided byte slice.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = ssz.MarshalUint64(dst, r.StartPeriod)
+	return ssz.MarshalUint64(dst, r.Count), nil
+}
+
+// MarshalSSZ marshals the light client updates by range request into the serialized object.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, lightClientUpdatesByRangeReqSize))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (_ *LightClientUpdatesByRangeReq) SizeSSZ() int {
+	return lightClientUpdatesByRangeReqSize
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client updates by range request object.
+func (r *LightClientUpdatesByRangeReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != lightClientUpdatesByRangeReqSize {
+		return errors.Errorf("expected buffer with length of %d but received length %d", lightClientUpdatesByRangeReqSize, len(buf))
+	}
+	r.StartPeriod = ssz.UnmarshallUint64(buf[:8])
+	r.Count = ssz.UnmarshallUint64(buf[8:])
+	return nil
+}
+
 func init() {
 	sizer := &eth.BlobIdentifier{}
 	blobIdSize = sizer.SizeSSZ()
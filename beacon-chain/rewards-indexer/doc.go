@@ -0,0 +1,6 @@
+/*
+Package rewardsindexer defines a runtime service which records, at each epoch transition, the attestation,
+proposer and sync committee rewards and penalties of validators into the beacon database. The rewards
+history can then be queried over ranges of epochs without replaying states.
+*/
+package rewardsindexer
@@ -0,0 +1,5 @@
+package rewardsindexer
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rewards-indexer")
@@ -0,0 +1,18 @@
+package rewardsindexer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	lastIndexedEpochGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "rewards_indexer_last_indexed_epoch",
+		Help: "The most recent epoch for which validator rewards were indexed.",
+	})
+	indexingDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "rewards_indexer_epoch_duration_milliseconds",
+		Help:    "Time it takes to compute and save the validator rewards of an epoch.",
+		Buckets: []float64{100, 250, 500, 1000, 2000, 4000, 8000, 16000},
+	})
+)
@@ -0,0 +1,70 @@
+package rewardsindexer
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+type Option func(s *Service) error
+
+// WithDatabase sets the database the rewards are saved into.
+func WithDatabase(beaconDB db.NoHeadAccessDatabase) Option {
+	return func(s *Service) error {
+		s.cfg.beaconDB = beaconDB
+		return nil
+	}
+}
+
+// WithStateGen sets the state manager used to retrieve the states rewards are computed from.
+func WithStateGen(sg stategen.StateManager) Option {
+	return func(s *Service) error {
+		s.cfg.stateGen = sg
+		return nil
+	}
+}
+
+// WithHeadFetcher sets the head fetcher used to find the canonical blocks of indexed epochs.
+func WithHeadFetcher(hf blockchain.HeadFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.headFetcher = hf
+		return nil
+	}
+}
+
+// WithClockWaiter sets the clock waiter used to wait for chain start.
+func WithClockWaiter(cw startup.ClockWaiter) Option {
+	return func(s *Service) error {
+		s.cfg.clockWaiter = cw
+		return nil
+	}
+}
+
+// WithInitialSyncComplete sets the channel signaling the end of initial sync, before which nothing is indexed.
+func WithInitialSyncComplete(c chan struct{}) Option {
+	return func(s *Service) error {
+		s.cfg.initialSyncComplete = c
+		return nil
+	}
+}
+
+// WithTrackedValidators sets the validators whose rewards are indexed. At least one validator is required.
+func WithTrackedValidators(indices []primitives.ValidatorIndex) Option {
+	return func(s *Service) error {
+		s.tracked = make(map[primitives.ValidatorIndex]bool, len(indices))
+		for _, idx := range indices {
+			s.tracked[idx] = true
+		}
+		return nil
+	}
+}
+
+// WithRetentionEpochs sets the number of epochs for which rewards are kept. Rewards are kept forever when 0.
+func WithRetentionEpochs(epochs primitives.Epoch) Option {
+	return func(s *Service) error {
+		s.cfg.retentionEpochs = epochs
+		return nil
+	}
+}
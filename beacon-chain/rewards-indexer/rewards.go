@@ -0,0 +1,262 @@
+package rewardsindexer
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// epochRewards computes the rewards and penalties validators received for their duties of the given epoch.
+// The canonical chain is the one of the head state, which must be at least two epochs past the given epoch.
+func (s *Service) epochRewards(ctx context.Context, headState state.ReadOnlyBeaconState, epoch primitives.Epoch) ([]*validator.EpochRewards, error) {
+	rewards := make(map[primitives.ValidatorIndex]*validator.EpochRewards)
+	rewardsOf := func(idx primitives.ValidatorIndex) *validator.EpochRewards {
+		if !s.isTracked(idx) {
+			return nil
+		}
+		r, ok := rewards[idx]
+		if !ok {
+			r = &validator.EpochRewards{ValidatorIndex: idx, Epoch: epoch}
+			rewards[idx] = r
+		}
+		return r
+	}
+
+	if err := s.attestationRewards(ctx, headState, epoch, rewardsOf); err != nil {
+		return nil, err
+	}
+	if err := s.blockRewards(ctx, headState, epoch, rewardsOf); err != nil {
+		return nil, err
+	}
+
+	result := make([]*validator.EpochRewards, 0, len(rewards))
+	for _, r := range rewards {
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// attestationRewards computes the attestation rewards and penalties of the epoch, which are applied
+// during the transition to the second epoch following it.
+func (s *Service) attestationRewards(
+	ctx context.Context,
+	headState state.ReadOnlyBeaconState,
+	epoch primitives.Epoch,
+	rewardsOf func(primitives.ValidatorIndex) *validator.EpochRewards,
+) error {
+	// The state at the end of the next epoch has the participation of the epoch as previous epoch participation.
+	endSlot, err := slots.EpochEnd(epoch + 1)
+	if err != nil {
+		return err
+	}
+	st, err := s.stateAtSlot(ctx, headState, endSlot)
+	if err != nil {
+		return err
+	}
+	vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize precompute validators")
+	}
+	vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+	if err != nil {
+		return errors.Wrap(err, "could not process epoch participation")
+	}
+	// Inactivity scores are updated before rewards and penalties are applied.
+	st, vals, err = altair.ProcessInactivityScores(ctx, st, vals)
+	if err != nil {
+		return errors.Wrap(err, "could not process inactivity scores")
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestations delta")
+	}
+	for i, d := range deltas {
+		if !precompute.EligibleForRewards(vals[i]) {
+			continue
+		}
+		r := rewardsOf(primitives.ValidatorIndex(i))
+		if r == nil {
+			continue
+		}
+		r.Head = signedDelta(d.HeadReward, 0)
+		r.Source = signedDelta(d.SourceReward, d.SourcePenalty)
+		r.Target = signedDelta(d.TargetReward, d.TargetPenalty)
+		r.Inactivity = signedDelta(0, d.InactivityPenalty)
+	}
+	return nil
+}
+
+// blockRewards replays the canonical blocks of the epoch to compute the proposer rewards and the sync
+// committee rewards and penalties of each block.
+func (s *Service) blockRewards(
+	ctx context.Context,
+	headState state.ReadOnlyBeaconState,
+	epoch primitives.Epoch,
+	rewardsOf func(primitives.ValidatorIndex) *validator.EpochRewards,
+) error {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+	endSlot, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return err
+	}
+	// Start from the state preceding the epoch. The genesis block carries no rewards.
+	prevSlot := max(startSlot, 1) - 1
+	st, err := s.stateAtSlot(ctx, headState, prevSlot)
+	if err != nil {
+		return err
+	}
+	prevRoot, err := helpers.BlockRootAtSlot(headState, prevSlot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get block root at slot %d", prevSlot)
+	}
+
+	for slot := prevSlot + 1; slot <= endSlot; slot++ {
+		root, err := helpers.BlockRootAtSlot(headState, slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block root at slot %d", slot)
+		}
+		// Skipped slots repeat the block root of the previous slot.
+		if bytes.Equal(root, prevRoot) {
+			continue
+		}
+		prevRoot = root
+		blk, err := s.cfg.beaconDB.Block(ctx, bytesutil.ToBytes32(root))
+		if err != nil {
+			return errors.Wrapf(err, "could not get block %#x", root)
+		}
+		if blk == nil || blk.IsNil() {
+			return errors.Errorf("block %#x not found", root)
+		}
+		st, err = stategen.ReplayProcessSlots(ctx, st, slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+		if err := processBlockRewards(ctx, st.Copy(), blk.Block(), rewardsOf); err != nil {
+			return errors.Wrapf(err, "could not compute rewards of block %#x", root)
+		}
+		st, err = transition.ProcessBlockForStateRoot(ctx, st, blk)
+		if err != nil {
+			return errors.Wrapf(err, "could not process block %#x", root)
+		}
+	}
+	return nil
+}
+
+// processBlockRewards computes the rewards of the proposer and of the sync committee members out of the balance
+// changes caused by the block operations, applied on top of the given pre-state.
+func processBlockRewards(
+	ctx context.Context,
+	st state.BeaconState,
+	blk interfaces.ReadOnlyBeaconBlock,
+	rewardsOf func(primitives.ValidatorIndex) *validator.EpochRewards,
+) error {
+	proposerIndex := blk.ProposerIndex()
+	initBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer's balance")
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		return errors.Wrap(err, "could not process attestations")
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, blk.Body().AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		return errors.Wrap(err, "could not process attester slashings")
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, blk.Body().ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
+	}
+	operationsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposer's balance")
+	}
+
+	sa, err := blk.Body().SyncAggregate()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync aggregate")
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return errors.Wrap(err, "could not get current sync committee")
+	}
+	preBalances := make(map[primitives.ValidatorIndex]uint64, len(committee.Pubkeys))
+	for _, pk := range committee.Pubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pk))
+		if !ok {
+			return errors.Errorf("no validator index found for sync committee pubkey %#x", pk)
+		}
+		preBalances[idx], err = st.BalanceAtIndex(idx)
+		if err != nil {
+			return errors.Wrap(err, "could not get sync committee member's balance")
+		}
+	}
+	st, proposerReward, err := altair.ProcessSyncAggregate(ctx, st, sa)
+	if err != nil {
+		return errors.Wrap(err, "could not process sync aggregate")
+	}
+	for idx, preBalance := range preBalances {
+		r := rewardsOf(idx)
+		if r == nil {
+			continue
+		}
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return errors.Wrap(err, "could not get sync committee member's balance")
+		}
+		// The proposer reward for including the sync aggregate is accounted as a proposer reward.
+		if idx == proposerIndex {
+			balance -= proposerReward
+		}
+		r.SyncCommittee += signedDelta(balance, preBalance)
+	}
+
+	if r := rewardsOf(proposerIndex); r != nil {
+		r.Proposer += signedDelta(operationsBalance+proposerReward, initBalance)
+	}
+	return nil
+}
+
+// signedDelta returns the difference between a reward and a penalty as a signed value.
+func signedDelta(reward, penalty uint64) int64 {
+	return int64(reward) - int64(penalty) // lint:ignore uintcast -- Rewards and penalties are bounded by the total supply of Gwei.
+}
+
+// stateAtSlot returns a copy of the canonical state at the given slot, which must be in the block roots
+// history of the head state.
+func (s *Service) stateAtSlot(ctx context.Context, headState state.ReadOnlyBeaconState, slot primitives.Slot) (state.BeaconState, error) {
+	root, err := helpers.BlockRootAtSlot(headState, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block root at slot %d", slot)
+	}
+	st, err := s.cfg.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of block %#x", root)
+	}
+	// States are shared with the state caches, so they must not be modified in place.
+	st = st.Copy()
+	if st.Slot() < slot {
+		st, err = stategen.ReplayProcessSlots(ctx, st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+	}
+	return st, nil
+}
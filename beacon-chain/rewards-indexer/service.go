@@ -0,0 +1,207 @@
+package rewardsindexer
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+type config struct {
+	beaconDB            db.NoHeadAccessDatabase
+	stateGen            stategen.StateManager
+	headFetcher         blockchain.HeadFetcher
+	clockWaiter         startup.ClockWaiter
+	initialSyncComplete chan struct{}
+	retentionEpochs     primitives.Epoch
+}
+
+// Service indexes the rewards of validators once per epoch. The rewards of an epoch are computed
+// when the head reaches the second epoch following it, once attestations of the epoch could no
+// longer be included on chain.
+type Service struct {
+	cfg       *config
+	ctx       context.Context
+	cancel    context.CancelFunc
+	tracked   map[primitives.ValidatorIndex]bool
+	nextEpoch primitives.Epoch
+}
+
+// NewService creates a rewards indexer with the provided options.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:    &config{},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.beaconDB == nil || s.cfg.stateGen == nil || s.cfg.headFetcher == nil || s.cfg.clockWaiter == nil {
+		cancel()
+		return nil, errors.New("rewards indexer requires a database, a state manager, a head fetcher and a clock waiter")
+	}
+	if len(s.tracked) == 0 {
+		cancel()
+		return nil, errors.New("rewards indexer requires the indices of the validators to index")
+	}
+	return s, nil
+}
+
+// Start the rewards indexer in the background.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"validatorCount":  len(s.tracked),
+		"retentionEpochs": s.cfg.retentionEpochs,
+	}).Info("Indexing rewards of tracked validators")
+	go s.run()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not receive chain start notification")
+		return
+	}
+	if s.cfg.initialSyncComplete != nil {
+		select {
+		case <-s.cfg.initialSyncComplete:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+
+	last, err := s.cfg.beaconDB.LastIndexedRewardsEpoch(s.ctx)
+	switch {
+	case err == nil:
+		s.nextEpoch = last + 1
+		lastIndexedEpochGauge.Set(float64(last))
+	case errors.Is(err, db.ErrNotFound):
+		// Nothing was indexed yet, start from the most recent epoch with final rewards.
+		s.nextEpoch = params.BeaconConfig().FarFutureEpoch
+	default:
+		log.WithError(err).Error("Could not get last indexed rewards epoch")
+		return
+	}
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-ticker.C():
+			if err := s.indexEpochs(s.ctx); err != nil {
+				log.WithError(err).Error("Could not index validator rewards")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// indexEpochs indexes all epochs whose rewards became final since the last call.
+func (s *Service) indexEpochs(ctx context.Context) error {
+	headState, err := s.cfg.headFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	headEpoch := slots.ToEpoch(headState.Slot())
+	if headEpoch < 2 {
+		return nil
+	}
+	target := headEpoch - 2
+	if s.nextEpoch == params.BeaconConfig().FarFutureEpoch {
+		s.nextEpoch = target
+	}
+	next := max(s.nextEpoch, params.BeaconConfig().AltairForkEpoch)
+	// The canonical block roots of older epochs are not in the head state anymore.
+	if oldest := oldestIndexableEpoch(headState.Slot()); next < oldest {
+		log.WithFields(logrus.Fields{
+			"fromEpoch": next,
+			"toEpoch":   oldest - 1,
+		}).Warn("Skipping epochs too old to be indexed")
+		next = oldest
+	}
+	if next > target {
+		return nil
+	}
+
+	for epoch := next; epoch <= target; epoch++ {
+		if err := s.indexEpoch(ctx, headState, epoch); err != nil {
+			return errors.Wrapf(err, "could not index rewards of epoch %d", epoch)
+		}
+		s.nextEpoch = epoch + 1
+		if err := s.prune(ctx, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prune deletes the rewards that fell out of the retention window once the given epoch is indexed.
+func (s *Service) prune(ctx context.Context, epoch primitives.Epoch) error {
+	if s.cfg.retentionEpochs == 0 || epoch < s.cfg.retentionEpochs {
+		return nil
+	}
+	if err := s.cfg.beaconDB.DeleteValidatorRewardsBefore(ctx, epoch-s.cfg.retentionEpochs+1); err != nil {
+		return errors.Wrap(err, "could not prune validator rewards")
+	}
+	return nil
+}
+
+func (s *Service) indexEpoch(ctx context.Context, headState state.ReadOnlyBeaconState, epoch primitives.Epoch) error {
+	start := time.Now()
+	rewards, err := s.epochRewards(ctx, headState, epoch)
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.beaconDB.SaveValidatorRewards(ctx, epoch, rewards); err != nil {
+		return errors.Wrap(err, "could not save validator rewards")
+	}
+	indexingDuration.Observe(float64(time.Since(start).Milliseconds()))
+	lastIndexedEpochGauge.Set(float64(epoch))
+	log.WithFields(logrus.Fields{
+		"epoch":          epoch,
+		"validatorCount": len(rewards),
+		"duration":       time.Since(start),
+	}).Debug("Indexed validator rewards")
+	return nil
+}
+
+func (s *Service) isTracked(idx primitives.ValidatorIndex) bool {
+	return s.tracked[idx]
+}
+
+// oldestIndexableEpoch returns the oldest epoch whose block roots, including the one preceding the epoch,
+// are still in the block roots history of a state at the given slot.
+func oldestIndexableEpoch(headSlot primitives.Slot) primitives.Epoch {
+	history := params.BeaconConfig().SlotsPerHistoricalRoot
+	if headSlot < history {
+		return 0
+	}
+	return slots.ToEpoch(headSlot-history) + 1
+}
@@ -0,0 +1,201 @@
+package rewardsindexer
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// testChain builds a chain with a block at every slot, saving blocks and post-states to the database.
+func testChain(t *testing.T, s *Service, lastSlot primitives.Slot) []state.BeaconState {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisStateAltair(t, 64)
+	c, err := altair.NextSyncCommittee(ctx, st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(c))
+	require.NoError(t, st.SetNextSyncCommittee(c))
+	states := []state.BeaconState{st.Copy()}
+	for slot := primitives.Slot(1); slot <= lastSlot; slot++ {
+		conf := util.DefaultBlockGenConfig()
+		conf.FullSyncAggregate = true
+		b, err := util.GenerateFullBlockAltair(st.Copy(), keys, conf, slot)
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, s.cfg.beaconDB.SaveBlock(ctx, wsb))
+		require.NoError(t, s.cfg.beaconDB.SaveState(ctx, st, root))
+		states = append(states, st.Copy())
+	}
+	s.cfg.headFetcher = &mock.ChainService{State: st}
+	return states
+}
+
+func testService(t *testing.T) *Service {
+	beaconDB := dbtest.SetupDB(t)
+	tracked := make(map[primitives.ValidatorIndex]bool)
+	for i := primitives.ValidatorIndex(0); i < 64; i++ {
+		tracked[i] = true
+	}
+	return &Service{
+		cfg: &config{
+			beaconDB: beaconDB,
+			stateGen: stategen.New(beaconDB, doublylinkedtree.New()),
+		},
+		tracked:   tracked,
+		nextEpoch: params.BeaconConfig().FarFutureEpoch,
+	}
+}
+
+func totalBalance(st state.ReadOnlyBeaconState) int64 {
+	var total int64
+	for _, b := range st.Balances() {
+		total += int64(b)
+	}
+	return total
+}
+
+func sumRewards(rewards []*validator.EpochRewards, field func(*validator.EpochRewards) int64) int64 {
+	var total int64
+	for _, r := range rewards {
+		total += field(r)
+	}
+	return total
+}
+
+func TestService_EpochRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	s := testService(t)
+	spe := params.BeaconConfig().SlotsPerEpoch
+	states := testChain(t, s, 3*spe+1)
+	headState, err := s.cfg.headFetcher.HeadStateReadOnly(ctx)
+	require.NoError(t, err)
+
+	rewards, err := s.epochRewards(ctx, headState, 1)
+	require.NoError(t, err)
+	require.Equal(t, 64, len(rewards))
+
+	// Block rewards of the epoch account for all balance changes caused by its blocks.
+	pre, err := transition.ProcessSlots(ctx, states[spe-1].Copy(), spe)
+	require.NoError(t, err)
+	post := states[2*spe-1]
+	assert.Equal(t, totalBalance(post)-totalBalance(pre), sumRewards(rewards, func(r *validator.EpochRewards) int64 {
+		return r.Proposer + r.SyncCommittee
+	}))
+	assert.NotEqual(t, int64(0), sumRewards(rewards, func(r *validator.EpochRewards) int64 { return r.Proposer }))
+	assert.NotEqual(t, int64(0), sumRewards(rewards, func(r *validator.EpochRewards) int64 { return r.SyncCommittee }))
+
+	// Attestation rewards of the epoch account for all balance changes of the epoch transition following the next epoch.
+	pre = states[3*spe-1]
+	post, err = transition.ProcessSlots(ctx, pre.Copy(), 3*spe)
+	require.NoError(t, err)
+	assert.Equal(t, totalBalance(post)-totalBalance(pre), sumRewards(rewards, func(r *validator.EpochRewards) int64 {
+		return r.Head + r.Source + r.Target + r.Inactivity
+	}))
+
+	// Only tracked validators are returned.
+	proposer := states[spe].LatestBlockHeader().ProposerIndex
+	s.tracked = map[primitives.ValidatorIndex]bool{proposer: true}
+	tracked, err := s.epochRewards(ctx, headState, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tracked))
+	for _, r := range rewards {
+		if r.ValidatorIndex == proposer {
+			require.DeepEqual(t, r, tracked[0])
+		}
+	}
+}
+
+func TestService_IndexEpochs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	s := testService(t)
+	s.cfg.retentionEpochs = 1
+	testChain(t, s, 3*params.BeaconConfig().SlotsPerEpoch+1)
+
+	require.NoError(t, s.indexEpochs(ctx))
+	last, err := s.cfg.beaconDB.LastIndexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(1), last)
+	assert.Equal(t, primitives.Epoch(2), s.nextEpoch)
+	rewards, err := s.cfg.beaconDB.ValidatorRewards(ctx, []primitives.ValidatorIndex{0, 1, 2}, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(rewards))
+
+	// Nothing new to index until the head reaches the next epoch.
+	require.NoError(t, s.indexEpochs(ctx))
+	assert.Equal(t, primitives.Epoch(2), s.nextEpoch)
+}
+
+func TestService_IndexEpochsPrunes(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	s := testService(t)
+	s.cfg.retentionEpochs = 1
+	s.nextEpoch = 1
+	testChain(t, s, 4*params.BeaconConfig().SlotsPerEpoch+1)
+
+	// Epochs 1 and 2 are indexed in one go, only the last one is kept.
+	require.NoError(t, s.indexEpochs(ctx))
+	rewards, err := s.cfg.beaconDB.ValidatorRewards(ctx, []primitives.ValidatorIndex{0}, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rewards))
+	assert.Equal(t, primitives.Epoch(2), rewards[0].Epoch)
+}
+
+func TestNewService_RequiresIndices(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, doublylinkedtree.New())),
+		WithHeadFetcher(&mock.ChainService{}),
+		WithClockWaiter(startup.NewClockSynchronizer()),
+	}
+	_, err := NewService(context.Background(), opts...)
+	require.ErrorContains(t, "requires the indices", err)
+
+	_, err = NewService(context.Background(), append(opts, WithTrackedValidators([]primitives.ValidatorIndex{1}))...)
+	require.NoError(t, err)
+}
+
+func TestOldestIndexableEpoch(t *testing.T) {
+	history := params.BeaconConfig().SlotsPerHistoricalRoot
+	spe := params.BeaconConfig().SlotsPerEpoch
+	assert.Equal(t, primitives.Epoch(0), oldestIndexableEpoch(history-1))
+	assert.Equal(t, primitives.Epoch(1), oldestIndexableEpoch(history))
+	assert.Equal(t, primitives.Epoch(2), oldestIndexableEpoch(history+spe))
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	beaconprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/beacon"
+	builderprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/builder"
 	nodeprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/node"
+	slasherprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
@@ -51,6 +53,8 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
 	endpoints = append(endpoints, s.prysmValidatorEndpoints(coreService, stater)...)
+	endpoints = append(endpoints, s.prysmBuilderEndpoints()...)
+	endpoints = append(endpoints, s.prysmSlasherEndpoints()...)
 	if enableDebug {
 		endpoints = append(endpoints, s.debugEndpoints(stater)...)
 	}
@@ -580,6 +584,7 @@ func (s *Service) lightClientEndpoints(blocker lookup.Blocker, stater lookup.Sta
 		Blocker:     blocker,
 		Stater:      stater,
 		HeadFetcher: s.cfg.HeadFetcher,
+		BeaconDB:    s.cfg.BeaconDB,
 	}
 
 	const namespace = "lightclient"
@@ -714,6 +719,7 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
+		EngineStatusFetcher:       s.cfg.EngineStatusFetcher,
 	}
 
 	const namespace = "prysm.node"
@@ -754,12 +760,39 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 			handler:  server.RemoveTrustedPeer,
 			methods:  []string{http.MethodDelete},
 		},
+		{
+			template: "/prysm/v1/node/peers/{peer_id}/score",
+			name:     namespace + ".GetPeerScore",
+			handler:  server.GetPeerScore,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/peers/scoring",
+			name:     namespace + ".GetPeerScoring",
+			handler:  server.GetPeerScoring,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/peers/scoring",
+			name:     namespace + ".SetPeerScoring",
+			handler:  server.SetPeerScoring,
+			methods:  []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/node/execution_engines",
+			name:     namespace + ".GetExecutionEngines",
+			handler:  server.GetExecutionEngines,
+			methods:  []string{http.MethodGet},
+		},
 	}
 }
 
 func (s *Service) prysmValidatorEndpoints(coreService *core.Service, stater lookup.Stater) []endpoint {
 	server := &validatorprysm.Server{
-		CoreService: coreService,
+		CoreService:      coreService,
+		BeaconDB:         s.cfg.BeaconDB,
+		HeadFetcher:      s.cfg.HeadFetcher,
+		ValidatorMonitor: s.cfg.ValidatorMonitor,
 	}
 
 	const namespace = "prysm.validator"
@@ -776,5 +809,115 @@ func (s *Service) prysmValidatorEndpoints(coreService *core.Service, stater look
 			handler:  server.GetValidatorPerformance,
 			methods:  []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/rewards/validators/{ids}",
+			name:     namespace + ".GetValidatorRewardsHistory",
+			handler:  server.GetValidatorRewardsHistory,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/monitor",
+			name:     namespace + ".GetValidatorMonitorPerformance",
+			handler:  server.GetValidatorMonitorPerformance,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/monitor/indices",
+			name:     namespace + ".GetValidatorMonitorIndices",
+			handler:  server.GetValidatorMonitorIndices,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/monitor/indices",
+			name:     namespace + ".AddValidatorMonitorIndices",
+			handler:  server.AddValidatorMonitorIndices,
+			methods:  []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/validators/monitor/indices",
+			name:     namespace + ".RemoveValidatorMonitorIndices",
+			handler:  server.RemoveValidatorMonitorIndices,
+			methods:  []string{http.MethodDelete},
+		},
+		{
+			template: "/prysm/v1/validators/monitor/pubkeys",
+			name:     namespace + ".AddValidatorMonitorPubkeys",
+			handler:  server.AddValidatorMonitorPubkeys,
+			methods:  []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/validators/monitor/pubkeys",
+			name:     namespace + ".RemoveValidatorMonitorPubkeys",
+			handler:  server.RemoveValidatorMonitorPubkeys,
+			methods:  []string{http.MethodDelete},
+		},
+	}
+}
+
+func (s *Service) prysmBuilderEndpoints() []endpoint {
+	server := &builderprysm.Server{
+		BlockBuilder: s.cfg.BlockBuilder,
+	}
+
+	const namespace = "prysm.builder"
+	return []endpoint{
+		{
+			template: "/prysm/v1/builder/bids/{slot}",
+			name:     namespace + ".GetBuilderBids",
+			handler:  server.GetBuilderBids,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/builder/relays",
+			name:     namespace + ".GetBuilderRelays",
+			handler:  server.GetBuilderRelays,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/builder/relays/validators",
+			name:     namespace + ".SetValidatorRelays",
+			handler:  server.SetValidatorRelays,
+			methods:  []string{http.MethodPost},
+		},
+	}
+}
+
+func (s *Service) prysmSlasherEndpoints() []endpoint {
+	server := &slasherprysm.Server{
+		SlashingChecker: s.cfg.SlashingChecker,
+	}
+
+	const namespace = "prysm.slasher"
+	return []endpoint{
+		{
+			template: "/prysm/v1/slasher/attester_slashings",
+			name:     namespace + ".GetAttesterSlashings",
+			handler:  server.GetAttesterSlashings,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/proposer_slashings",
+			name:     namespace + ".GetProposerSlashings",
+			handler:  server.GetProposerSlashings,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/attestations/slashable",
+			name:     namespace + ".IsSlashableAttestation",
+			handler:  server.IsSlashableAttestation,
+			methods:  []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/blocks/slashable",
+			name:     namespace + ".IsSlashableBlock",
+			handler:  server.IsSlashableBlock,
+			methods:  []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/events",
+			name:     namespace + ".StreamSlashings",
+			handler:  server.StreamSlashings,
+			methods:  []string{http.MethodGet},
+		},
 	}
 }
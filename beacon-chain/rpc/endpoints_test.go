@@ -120,16 +120,37 @@ func Test_endpoints(t *testing.T) {
 		"/prysm/v1/node/trusted_peers":           {http.MethodGet, http.MethodPost},
 		"/prysm/node/trusted_peers/{peer_id}":    {http.MethodDelete},
 		"/prysm/v1/node/trusted_peers/{peer_id}": {http.MethodDelete},
+		"/prysm/v1/node/peers/{peer_id}/score":   {http.MethodGet},
+		"/prysm/v1/node/peers/scoring":           {http.MethodGet, http.MethodPost},
+		"/prysm/v1/node/execution_engines":       {http.MethodGet},
 	}
 
 	prysmValidatorRoutes := map[string][]string{
-		"/prysm/validators/performance":    {http.MethodPost},
-		"/prysm/v1/validators/performance": {http.MethodPost},
+		"/prysm/validators/performance":        {http.MethodPost},
+		"/prysm/v1/validators/performance":     {http.MethodPost},
+		"/prysm/v1/rewards/validators/{ids}":   {http.MethodGet},
+		"/prysm/v1/validators/monitor":         {http.MethodGet},
+		"/prysm/v1/validators/monitor/indices": {http.MethodGet, http.MethodPost, http.MethodDelete},
+		"/prysm/v1/validators/monitor/pubkeys": {http.MethodPost, http.MethodDelete},
 	}
 
 	s := &Service{cfg: &Config{}}
 
-	routesMap := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes)
+	prysmBuilderRoutes := map[string][]string{
+		"/prysm/v1/builder/bids/{slot}":       {http.MethodGet},
+		"/prysm/v1/builder/relays":            {http.MethodGet},
+		"/prysm/v1/builder/relays/validators": {http.MethodPost},
+	}
+
+	prysmSlasherRoutes := map[string][]string{
+		"/prysm/v1/slasher/attester_slashings":     {http.MethodGet},
+		"/prysm/v1/slasher/proposer_slashings":     {http.MethodGet},
+		"/prysm/v1/slasher/attestations/slashable": {http.MethodPost},
+		"/prysm/v1/slasher/blocks/slashable":       {http.MethodPost},
+		"/prysm/v1/slasher/events":                 {http.MethodGet},
+	}
+
+	routesMap := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes, prysmBuilderRoutes, prysmSlasherRoutes)
 	actual := s.endpoints(true, nil, nil, nil, nil, nil, nil)
 	for _, e := range actual {
 		methods, ok := routesMap[e.template]
@@ -281,6 +281,41 @@ func TestBlobs(t *testing.T) {
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 		require.Equal(t, 0, len(resp.Data))
 	})
+	t.Run("outside retention period with archival storage", func(t *testing.T) {
+		archive, err := filesystem.NewBlobStorage(filesystem.WithBasePath(t.TempDir()), filesystem.WithArchival(true))
+		require.NoError(t, err)
+		require.NoError(t, archive.Save(testSidecars[0]))
+		moc := &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: blockRoot[:]}}
+		blocker := &lookup.BeaconDbBlocker{
+			ChainInfoFetcher:   moc,
+			GenesisTimeFetcher: moc, // genesis time is set to 0 here, so it results in current epoch being extremely large
+			BeaconDB:           db,
+			BlobStorage:        archive,
+		}
+		s := &Server{
+			Blocker: blocker,
+		}
+
+		request := httptest.NewRequest("GET", "http://foo.example/123", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.Blobs(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SidecarsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "0", resp.Data[0].Index)
+
+		// Blobs missing from the archive are not an error outside of the retention period.
+		request = httptest.NewRequest("GET", "http://foo.example/123?indices=1", nil)
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.Blobs(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp = &structs.SidecarsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 0, len(resp.Data))
+	})
 	t.Run("block without commitments returns 200 w/empty list ", func(t *testing.T) {
 		denebBlock, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 333, 0)
 		commitments, err := denebBlock.Block().Body().BlobKzgCommitments()
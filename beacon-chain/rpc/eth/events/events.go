@@ -56,6 +56,10 @@ const (
 	LightClientFinalityUpdateTopic = "light_client_finality_update"
 	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
 	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// ValidatorMonitorTopic represents a new finalized epoch performance of validators tracked by the validator monitor.
+	ValidatorMonitorTopic = "validator_monitor"
+	// BuilderBidTopic represents a new answer of a builder relay to a get header request of a proposer.
+	BuilderBidTopic = "builder_bid"
 )
 
 const topicDataMismatch = "Event data type %T does not correspond to event topic %s"
@@ -77,6 +81,8 @@ var casesHandled = map[string]bool{
 	AttesterSlashingTopic:            true,
 	LightClientFinalityUpdateTopic:   true,
 	LightClientOptimisticUpdateTopic: true,
+	ValidatorMonitorTopic:            true,
+	BuilderBidTopic:                  true,
 }
 
 // StreamEvents provides an endpoint to subscribe to the beacon node Server-Sent-Events stream.
@@ -367,6 +373,29 @@ func (s *Server) handleStateEvents(ctx context.Context, w http.ResponseWriter, f
 			ExecutionOptimistic: reorgData.ExecutionOptimistic,
 		}
 		return send(w, flusher, ChainReorgTopic, reorg)
+	case statefeed.ValidatorPerformance:
+		if _, ok := requestedTopics[ValidatorMonitorTopic]; !ok {
+			return nil
+		}
+		performanceData, ok := event.Data.(*statefeed.ValidatorPerformanceData)
+		if !ok {
+			return write(w, flusher, topicDataMismatch, event.Data, ValidatorMonitorTopic)
+		}
+		for _, p := range performanceData.Performance {
+			if err := send(w, flusher, ValidatorMonitorTopic, structs.ValidatorEpochPerformanceFromConsensus(p)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case statefeed.BuilderBidReceived:
+		if _, ok := requestedTopics[BuilderBidTopic]; !ok {
+			return nil
+		}
+		bidData, ok := event.Data.(*statefeed.BuilderBidReceivedData)
+		if !ok {
+			return write(w, flusher, topicDataMismatch, event.Data, BuilderBidTopic)
+		}
+		return send(w, flusher, BuilderBidTopic, structs.BuilderBidFromConsensus(bidData.Slot, bidData.Bid))
 	case statefeed.BlockProcessed:
 		if _, ok := requestedTopics[BlockTopic]; !ok {
 			return nil
@@ -19,6 +19,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -278,6 +280,112 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 		require.NotNil(t, body)
 		assert.Equal(t, stateResult, string(body))
 	})
+	t.Run("validator monitor", func(t *testing.T) {
+		s := &Server{
+			StateNotifier:     &mockChain.MockStateNotifier{},
+			OperationNotifier: &mockChain.MockOperationNotifier{},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/events?topics=%s", ValidatorMonitorTopic), nil)
+		w := &flushableResponseRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+		}
+
+		go func() {
+			s.StreamEvents(w, request)
+		}()
+		// wait for initiation of StreamEvents
+		time.Sleep(100 * time.Millisecond)
+		s.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.ValidatorPerformance,
+			Data: &statefeed.ValidatorPerformanceData{
+				Epoch: 1,
+				Performance: []*validator.EpochPerformance{
+					{
+						ValidatorIndex:      1,
+						Epoch:               1,
+						AttestationIncluded: true,
+						InclusionDistance:   1,
+						CorrectSource:       true,
+						CorrectTarget:       true,
+						ProposerDuties:      1,
+						StartBalance:        32000000000,
+						EndBalance:          32000010000,
+					},
+					{
+						ValidatorIndex: 2,
+						Epoch:          1,
+						StartBalance:   32000000000,
+						EndBalance:     31999990000,
+					},
+				},
+			},
+		})
+
+		// wait for feed
+		time.Sleep(1 * time.Second)
+		request.Context().Done()
+
+		resp := w.Result()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NotNil(t, body)
+		assert.Equal(t, validatorMonitorResult, string(body))
+	})
+	t.Run("builder bid", func(t *testing.T) {
+		s := &Server{
+			StateNotifier:     &mockChain.MockStateNotifier{},
+			OperationNotifier: &mockChain.MockOperationNotifier{},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/events?topics=%s", BuilderBidTopic), nil)
+		w := &flushableResponseRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+		}
+
+		go func() {
+			s.StreamEvents(w, request)
+		}()
+		// wait for initiation of StreamEvents
+		time.Sleep(100 * time.Millisecond)
+		s.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BuilderBidReceived,
+			Data: &statefeed.BuilderBidReceivedData{
+				Slot: 5,
+				Bid: &eth.BuilderBidRecord{
+					Relay:         "http://relay.example.com",
+					Value:         bytesutil.PadTo([]byte{1, 2}, 32),
+					BlockHash:     make([]byte, 32),
+					GasLimit:      30000000,
+					FeeRecipient:  make([]byte, 20),
+					BuilderPubkey: make([]byte, 48),
+					LatencyMs:     120,
+					ReceivedAtMs:  1700000000000,
+				},
+			},
+		})
+		s.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BuilderBidReceived,
+			Data: &statefeed.BuilderBidReceivedData{
+				Slot: 5,
+				Bid: &eth.BuilderBidRecord{
+					Relay:     "http://other.example.com",
+					LatencyMs: 1000,
+					Error:     "timeout",
+				},
+			},
+		})
+
+		// wait for feed
+		time.Sleep(1 * time.Second)
+		request.Context().Done()
+
+		resp := w.Result()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NotNil(t, body)
+		assert.Equal(t, builderBidResult, string(body))
+	})
 	t.Run("payload attributes", func(t *testing.T) {
 		type testCase struct {
 			name     string
@@ -403,6 +511,26 @@ data: {"signed_header_1":{"message":{"slot":"0","proposer_index":"0","parent_roo
 
 `
 
+const validatorMonitorResult = `:
+
+event: validator_monitor
+data: {"validator_index":"1","epoch":"1","attestation_included":true,"inclusion_distance":"1","missed_source":false,"missed_target":false,"missed_head":true,"proposer_duties":"1","missed_proposals":"1","sync_committee_duties":"0","sync_committee_contributions":"0","start_balance":"32000000000","end_balance":"32000010000","balance_change":"10000"}
+
+event: validator_monitor
+data: {"validator_index":"2","epoch":"1","attestation_included":false,"inclusion_distance":"0","missed_source":true,"missed_target":true,"missed_head":true,"proposer_duties":"0","missed_proposals":"0","sync_committee_duties":"0","sync_committee_contributions":"0","start_balance":"32000000000","end_balance":"31999990000","balance_change":"-10000"}
+
+`
+
+const builderBidResult = `:
+
+event: builder_bid
+data: {"slot":"5","relay":"http://relay.example.com","value":"513","block_hash":"0x0000000000000000000000000000000000000000000000000000000000000000","gas_limit":"30000000","fee_recipient":"0x0000000000000000000000000000000000000000","builder_pubkey":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","latency_ms":"120","received_at_ms":"1700000000000"}
+
+event: builder_bid
+data: {"slot":"5","relay":"http://other.example.com","value":"0","block_hash":"0x","gas_limit":"0","fee_recipient":"0x","builder_pubkey":"0x","latency_ms":"1000","received_at_ms":"0","error":"timeout"}
+
+`
+
 const stateResult = `:
 
 event: head
@@ -14,10 +14,8 @@ import (
 	"github.com/wealdtech/go-bytesutil"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
-	types "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
@@ -36,6 +34,21 @@ func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, req *http.Reques
 	}
 
 	blockRoot := bytesutil.ToBytes32(blockRootParam)
+
+	// Bootstraps of finalized checkpoints are persisted by the blockchain service
+	stored, v, err := s.BeaconDB.LightClientBootstrap(ctx, blockRoot)
+	if err != nil {
+		httputil.HandleError(w, "could not get light client bootstrap from db: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if stored != nil {
+		httputil.WriteJson(w, &structs.LightClientBootstrapResponse{
+			Version: version.String(v),
+			Data:    newLightClientBootstrapToJSON(stored),
+		})
+		return
+	}
+
 	blk, err := s.Blocker.Block(ctx, blockRoot[:])
 	if !shared.WriteBlockFetchError(w, blk, err) {
 		return
@@ -118,102 +131,22 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 		endPeriod = maxSlot / slotsPerPeriod
 	}
 
-	// Populate updates
+	// Populate updates from the best updates persisted by the blockchain service
 	var updates []*structs.LightClientUpdateWithVersion
 	for period := startPeriod; period <= endPeriod; period++ {
-		// Get the last known state of the period,
-		//    1. We wish the block has a parent in the same period if possible
-		//	  2. We wish the block has a state in the same period
-		lastSlotInPeriod := period*slotsPerPeriod + slotsPerPeriod - 1
-		if lastSlotInPeriod > maxSlot {
-			lastSlotInPeriod = maxSlot
-		}
-		firstSlotInPeriod := period * slotsPerPeriod
-
-		// Let's not use the first slot in the period, otherwise the attested header will be in previous period
-		firstSlotInPeriod++
-
-		var state state.BeaconState
-		var block interfaces.ReadOnlySignedBeaconBlock
-		for slot := lastSlotInPeriod; slot >= firstSlotInPeriod; slot-- {
-			state, err = s.Stater.StateBySlot(ctx, types.Slot(slot))
-			if err != nil {
-				continue
-			}
-
-			// Get the block
-			latestBlockHeader := state.LatestBlockHeader()
-			latestStateRoot, err := state.HashTreeRoot(ctx)
-			if err != nil {
-				continue
-			}
-			latestBlockHeader.StateRoot = latestStateRoot[:]
-			blockRoot, err := latestBlockHeader.HashTreeRoot()
-			if err != nil {
-				continue
-			}
-
-			block, err = s.Blocker.Block(ctx, blockRoot[:])
-			if err != nil || block == nil {
-				continue
-			}
-
-			syncAggregate, err := block.Block().Body().SyncAggregate()
-			if err != nil || syncAggregate == nil {
-				continue
-			}
-
-			if syncAggregate.SyncCommitteeBits.Count()*3 < config.SyncCommitteeSize*2 {
-				// Not enough votes
-				continue
-			}
-
-			break
-		}
-
-		if block == nil {
-			// No valid block found for the period
-			continue
-		}
-
-		// Get attested state
-		attestedRoot := block.Block().ParentRoot()
-		attestedBlock, err := s.Blocker.Block(ctx, attestedRoot[:])
-		if err != nil || attestedBlock == nil {
-			continue
-		}
-
-		attestedSlot := attestedBlock.Block().Slot()
-		attestedState, err := s.Stater.StateBySlot(ctx, attestedSlot)
+		update, v, err := s.BeaconDB.LightClientUpdate(ctx, period)
 		if err != nil {
-			continue
-		}
-
-		// Get finalized block
-		var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
-		finalizedCheckPoint := attestedState.FinalizedCheckpoint()
-		if finalizedCheckPoint != nil {
-			finalizedRoot := bytesutil.ToBytes32(finalizedCheckPoint.Root)
-			finalizedBlock, err = s.Blocker.Block(ctx, finalizedRoot[:])
-			if err != nil {
-				finalizedBlock = nil
-			}
+			httputil.HandleError(w, fmt.Sprintf("could not get light client update for period %d: %s", period, err.Error()), http.StatusInternalServerError)
+			return
 		}
-
-		update, err := createLightClientUpdate(
-			ctx,
-			state,
-			block,
-			attestedState,
-			finalizedBlock,
-		)
-
-		if err == nil {
-			updates = append(updates, &structs.LightClientUpdateWithVersion{
-				Version: version.String(attestedState.Version()),
-				Data:    update,
-			})
+		if update == nil {
+			// Updates must be returned for consecutive periods, stop at the first gap
+			break
 		}
+		updates = append(updates, &structs.LightClientUpdateWithVersion{
+			Version: version.String(v),
+			Data:    newLightClientUpdateToJSON(update),
+		})
 	}
 
 	if len(updates) == 0 {
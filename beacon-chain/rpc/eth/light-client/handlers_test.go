@@ -13,8 +13,11 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -25,6 +28,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
@@ -61,6 +65,7 @@ func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
 		}},
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    dbtesting.SetupDB(t),
 	}
 	muxVars := make(map[string]string)
 	muxVars["block_root"] = hexutil.Encode(r[:])
@@ -78,6 +83,67 @@ func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
 	require.NotNil(t, resp.Data)
 }
 
+func TestLightClientHandler_GetLightClientBootstrap_FromDB(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	slot := primitives.Slot(params.BeaconConfig().AltairForkEpoch * primitives.Epoch(params.BeaconConfig().SlotsPerEpoch)).Add(1)
+
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = slot
+	signedBlock, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	header, err := signedBlock.Header()
+	require.NoError(t, err)
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	bs, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, bs.SetSlot(slot))
+	require.NoError(t, bs.SetLatestBlockHeader(header.Header))
+
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, bs)
+	require.NoError(t, err)
+	beaconDB := dbtesting.SetupDB(t)
+	require.NoError(t, beaconDB.SaveLightClientBootstrap(ctx, r, bs.Version(), bootstrap))
+
+	// The block and state are not available, the bootstrap must be served from the DB.
+	s := &Server{
+		Stater:      &testutil.MockStater{},
+		Blocker:     &testutil.MockBlocker{},
+		HeadFetcher: &mock.ChainService{Slot: &slot},
+		BeaconDB:    beaconDB,
+	}
+	muxVars := make(map[string]string)
+	muxVars["block_root"] = hexutil.Encode(r[:])
+	request := httptest.NewRequest("GET", "http://foo.com/", nil)
+	request = mux.SetURLVars(request, muxVars)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientBootstrap(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.LightClientBootstrapResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, "capella", resp.Version)
+	require.Equal(t, hexutil.Encode(header.Header.BodyRoot), resp.Data.Header.BodyRoot)
+	require.Equal(t, hexutil.Encode(bootstrap.Header.StateRoot), resp.Data.Header.StateRoot)
+}
+
+func saveLightClientUpdate(
+	t *testing.T,
+	ctx context.Context,
+	beaconDB db.NoHeadAccessDatabase,
+	st state.BeaconState,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState,
+) {
+	update, err := blockchain.NewLightClientUpdateFromBeaconState(ctx, st, block, attestedState, nil)
+	require.NoError(t, err)
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(update.AttestedHeader.Slot))
+	require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, attestedState.Version(), update))
+}
+
 func TestLightClientHandler_GetLightClientUpdatesByRange(t *testing.T) {
 	helpers.ClearCache()
 	ctx := context.Background()
@@ -155,6 +221,8 @@ func TestLightClientHandler_GetLightClientUpdatesByRange(t *testing.T) {
 		},
 	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	db := dbtesting.SetupDB(t)
+	saveLightClientUpdate(t, ctx, db, st, signedBlock, attestedState)
 	s := &Server{
 		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
 			slot.Sub(1): attestedState,
@@ -162,6 +230,7 @@ func TestLightClientHandler_GetLightClientUpdatesByRange(t *testing.T) {
 		}},
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    db,
 	}
 	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
 	url := fmt.Sprintf("http://foo.com/?count=1&start_period=%d", startPeriod)
@@ -257,6 +326,8 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigInputCount(t *tes
 		},
 	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	db := dbtesting.SetupDB(t)
+	saveLightClientUpdate(t, ctx, db, st, signedBlock, attestedState)
 	s := &Server{
 		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
 			slot.Sub(1): attestedState,
@@ -264,6 +335,7 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigInputCount(t *tes
 		}},
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    db,
 	}
 	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
 	count := 129 // config.MaxRequestLightClientUpdates is 128
@@ -360,6 +432,8 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooEarlyPeriod(t *testi
 		},
 	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	db := dbtesting.SetupDB(t)
+	saveLightClientUpdate(t, ctx, db, st, signedBlock, attestedState)
 	s := &Server{
 		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
 			slot.Sub(1): attestedState,
@@ -367,6 +441,7 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooEarlyPeriod(t *testi
 		}},
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    db,
 	}
 	startPeriod := 1 // very early period before Altair fork
 	count := 1
@@ -463,6 +538,8 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigCount(t *testing.
 		},
 	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	db := dbtesting.SetupDB(t)
+	saveLightClientUpdate(t, ctx, db, st, signedBlock, attestedState)
 	s := &Server{
 		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
 			slot.Sub(1): attestedState,
@@ -470,6 +547,7 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigCount(t *testing.
 		}},
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    db,
 	}
 	startPeriod := 1 // very early period before Altair fork
 	count := 10      // This is big count as we only have one period in test case.
@@ -566,6 +644,7 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_BeforeAltair(t *testing
 		},
 	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	db := dbtesting.SetupDB(t)
 	s := &Server{
 		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
 			slot.Sub(1): attestedState,
@@ -573,6 +652,7 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_BeforeAltair(t *testing
 		}},
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    db,
 	}
 	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
 	count := 1
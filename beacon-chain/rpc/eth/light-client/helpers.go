@@ -2,205 +2,27 @@ package lightclient
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
-	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
-	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	v2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v5/proto/migration"
-	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
-// createLightClientBootstrap - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_bootstrap
-// def create_light_client_bootstrap(state: BeaconState) -> LightClientBootstrap:
-//
-//	assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
-//	assert state.slot == state.latest_block_header.slot
-//
-//	return LightClientBootstrap(
-//	    header=BeaconBlockHeader(
-//	        slot=state.latest_block_header.slot,
-//	        proposer_index=state.latest_block_header.proposer_index,
-//	        parent_root=state.latest_block_header.parent_root,
-//	        state_root=hash_tree_root(state),
-//	        body_root=state.latest_block_header.body_root,
-//	    ),
-//	    current_sync_committee=state.current_sync_committee,
-//	    current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX)
-//	)
+// createLightClientBootstrap creates the JSON representation of the light client bootstrap for the given state.
 func createLightClientBootstrap(ctx context.Context, state state.BeaconState) (*structs.LightClientBootstrap, error) {
-	// assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
-	if slots.ToEpoch(state.Slot()) < params.BeaconConfig().AltairForkEpoch {
-		return nil, fmt.Errorf("light client bootstrap is not supported before Altair, invalid slot %d", state.Slot())
-	}
-
-	// assert state.slot == state.latest_block_header.slot
-	latestBlockHeader := state.LatestBlockHeader()
-	if state.Slot() != latestBlockHeader.Slot {
-		return nil, fmt.Errorf("state slot %d not equal to latest block header slot %d", state.Slot(), latestBlockHeader.Slot)
-	}
-
-	// Prepare data
-	currentSyncCommittee, err := state.CurrentSyncCommittee()
-	if err != nil {
-		return nil, fmt.Errorf("could not get current sync committee: %s", err.Error())
-	}
-
-	committee := structs.SyncCommitteeFromConsensus(currentSyncCommittee)
-
-	currentSyncCommitteeProof, err := state.CurrentSyncCommitteeProof(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not get current sync committee proof: %s", err.Error())
-	}
-
-	branch := make([]string, fieldparams.NextSyncCommitteeBranchDepth)
-	for i, proof := range currentSyncCommitteeProof {
-		branch[i] = hexutil.Encode(proof)
-	}
-
-	header := structs.BeaconBlockHeaderFromConsensus(latestBlockHeader)
-	if header == nil {
-		return nil, fmt.Errorf("could not get beacon block header")
-	}
-
-	// Above shared util function won't calculate state root, so we need to do it manually
-	stateRoot, err := state.HashTreeRoot(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not get state root: %s", err.Error())
-	}
-	header.StateRoot = hexutil.Encode(stateRoot[:])
-
-	// Return result
-	result := &structs.LightClientBootstrap{
-		Header:                     header,
-		CurrentSyncCommittee:       committee,
-		CurrentSyncCommitteeBranch: branch,
-	}
-
-	return result, nil
-}
-
-// createLightClientUpdate - implements https://github.
-// com/ethereum/consensus-specs/blob/d70dcd9926a4bbe987f1b4e65c3e05bd029fcfb8/specs/altair/light-client/full-node.md#create_light_client_update
-// def create_light_client_update(state: BeaconState,
-//
-//	                           block: SignedBeaconBlock,
-//	                           attested_state: BeaconState,
-//	                           finalized_block: Optional[SignedBeaconBlock]) -> LightClientUpdate:
-//	assert compute_epoch_at_slot(attested_state.slot) >= ALTAIR_FORK_EPOCH
-//	assert sum(block.message.body.sync_aggregate.sync_committee_bits) >= MIN_SYNC_COMMITTEE_PARTICIPANTS
-//
-//	assert state.slot == state.latest_block_header.slot
-//	header = state.latest_block_header.copy()
-//	header.state_root = hash_tree_root(state)
-//	assert hash_tree_root(header) == hash_tree_root(block.message)
-//	update_signature_period = compute_sync_committee_period(compute_epoch_at_slot(block.message.slot))
-//
-//	assert attested_state.slot == attested_state.latest_block_header.slot
-//	attested_header = attested_state.latest_block_header.copy()
-//	attested_header.state_root = hash_tree_root(attested_state)
-//	assert hash_tree_root(attested_header) == block.message.parent_root
-//	update_attested_period = compute_sync_committee_period(compute_epoch_at_slot(attested_header.slot))
-//
-//	# `next_sync_committee` is only useful if the message is signed by the current sync committee
-//	if update_attested_period == update_signature_period:
-//	    next_sync_committee = attested_state.next_sync_committee
-//	    next_sync_committee_branch = compute_merkle_proof_for_state(attested_state, NEXT_SYNC_COMMITTEE_INDEX)
-//	else:
-//	    next_sync_committee = SyncCommittee()
-//	    next_sync_committee_branch = [Bytes32() for _ in range(floorlog2(NEXT_SYNC_COMMITTEE_INDEX))]
-//
-//	# Indicate finality whenever possible
-//	if finalized_block is not None:
-//	    if finalized_block.message.slot != GENESIS_SLOT:
-//	        finalized_header = BeaconBlockHeader(
-//	            slot=finalized_block.message.slot,
-//	            proposer_index=finalized_block.message.proposer_index,
-//	            parent_root=finalized_block.message.parent_root,
-//	            state_root=finalized_block.message.state_root,
-//	            body_root=hash_tree_root(finalized_block.message.body),
-//	        )
-//	        assert hash_tree_root(finalized_header) == attested_state.finalized_checkpoint.root
-//	    else:
-//	        assert attested_state.finalized_checkpoint.root == Bytes32()
-//	        finalized_header = BeaconBlockHeader()
-//	    finality_branch = compute_merkle_proof_for_state(attested_state, FINALIZED_ROOT_INDEX)
-//	else:
-//	    finalized_header = BeaconBlockHeader()
-//	    finality_branch = [Bytes32() for _ in range(floorlog2(FINALIZED_ROOT_INDEX))]
-//
-//	return LightClientUpdate(
-//	    attested_header=attested_header,
-//	    next_sync_committee=next_sync_committee,
-//	    next_sync_committee_branch=next_sync_committee_branch,
-//	    finalized_header=finalized_header,
-//	    finality_branch=finality_branch,
-//	    sync_aggregate=block.message.body.sync_aggregate,
-//	    signature_slot=block.message.slot,
-//	)
-func createLightClientUpdate(
-	ctx context.Context,
-	state state.BeaconState,
-	block interfaces.ReadOnlySignedBeaconBlock,
-	attestedState state.BeaconState,
-	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (*structs.LightClientUpdate, error) {
-	result, err := blockchain.NewLightClientFinalityUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, state)
 	if err != nil {
 		return nil, err
 	}
-
-	// Generate next sync committee and proof
-	var nextSyncCommittee *v2.SyncCommittee
-	var nextSyncCommitteeBranch [][]byte
-
-	// update_signature_period = compute_sync_committee_period(compute_epoch_at_slot(block.message.slot))
-	updateSignaturePeriod := slots.ToEpoch(block.Block().Slot())
-
-	// update_attested_period = compute_sync_committee_period(compute_epoch_at_slot(attested_header.slot))
-	updateAttestedPeriod := slots.ToEpoch(result.AttestedHeader.Slot)
-
-	if updateAttestedPeriod == updateSignaturePeriod {
-		tempNextSyncCommittee, err := attestedState.NextSyncCommittee()
-		if err != nil {
-			return nil, fmt.Errorf("could not get next sync committee: %s", err.Error())
-		}
-
-		nextSyncCommittee = &v2.SyncCommittee{
-			Pubkeys:         tempNextSyncCommittee.Pubkeys,
-			AggregatePubkey: tempNextSyncCommittee.AggregatePubkey,
-		}
-
-		nextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not get next sync committee proof: %s", err.Error())
-		}
-	} else {
-		syncCommitteeSize := params.BeaconConfig().SyncCommitteeSize
-		pubKeys := make([][]byte, syncCommitteeSize)
-		for i := uint64(0); i < syncCommitteeSize; i++ {
-			pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
-		}
-		nextSyncCommittee = &v2.SyncCommittee{
-			Pubkeys:         pubKeys,
-			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
-		}
-
-		nextSyncCommitteeBranch = make([][]byte, fieldparams.NextSyncCommitteeBranchDepth)
-		for i := 0; i < fieldparams.NextSyncCommitteeBranchDepth; i++ {
-			nextSyncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
-		}
-	}
-
-	result.NextSyncCommittee = nextSyncCommittee
-	result.NextSyncCommitteeBranch = nextSyncCommitteeBranch
-	return newLightClientUpdateToJSON(result), nil
+	return newLightClientBootstrapToJSON(bootstrap), nil
 }
 
 func newLightClientFinalityUpdateFromBeaconState(
@@ -253,6 +75,55 @@ func NewLightClientBootstrapFromJSON(bootstrapJSON *structs.LightClientBootstrap
 	return bootstrap, nil
 }
 
+// NewLightClientUpdateFromJSON converts the JSON representation of a light client update, as served by the
+// light client updates, finality update and optimistic update endpoints, to its protobuf representation.
+func NewLightClientUpdateFromJSON(updateJSON *structs.LightClientUpdate) (*v2.LightClientUpdate, error) {
+	if updateJSON == nil || updateJSON.AttestedHeader == nil || updateJSON.SyncAggregate == nil {
+		return nil, errors.New("light client update is missing the attested header or sync aggregate")
+	}
+	update := &v2.LightClientUpdate{}
+
+	var err error
+
+	attestedHeader, err := updateJSON.AttestedHeader.ToConsensus()
+	if err != nil {
+		return nil, err
+	}
+	update.AttestedHeader = migration.V1Alpha1HeaderToV1(attestedHeader)
+
+	if updateJSON.NextSyncCommittee != nil {
+		nextSyncCommittee, err := updateJSON.NextSyncCommittee.ToConsensus()
+		if err != nil {
+			return nil, err
+		}
+		update.NextSyncCommittee = migration.V1Alpha1SyncCommitteeToV2(nextSyncCommittee)
+	}
+	if update.NextSyncCommitteeBranch, err = branchFromJSON(updateJSON.NextSyncCommitteeBranch); err != nil {
+		return nil, err
+	}
+
+	if updateJSON.FinalizedHeader != nil {
+		finalizedHeader, err := updateJSON.FinalizedHeader.ToConsensus()
+		if err != nil {
+			return nil, err
+		}
+		update.FinalizedHeader = migration.V1Alpha1HeaderToV1(finalizedHeader)
+	}
+	if update.FinalityBranch, err = branchFromJSON(updateJSON.FinalityBranch); err != nil {
+		return nil, err
+	}
+
+	if update.SyncAggregate, err = syncAggregateFromJSON(updateJSON.SyncAggregate); err != nil {
+		return nil, err
+	}
+	signatureSlot, err := strconv.ParseUint(updateJSON.SignatureSlot, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	update.SignatureSlot = primitives.Slot(signatureSlot)
+	return update, nil
+}
+
 func branchFromJSON(branch []string) ([][]byte, error) {
 	var branchBytes [][]byte
 	for _, root := range branch {
@@ -276,6 +147,21 @@ func branchToJSON(branchBytes [][]byte) []string {
 	return branch
 }
 
+func syncAggregateFromJSON(input *structs.SyncAggregate) (*v1.SyncAggregate, error) {
+	bits, err := hexutil.Decode(input.SyncCommitteeBits)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hexutil.Decode(input.SyncCommitteeSignature)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: sig,
+	}, nil
+}
+
 func syncAggregateToJSON(input *v1.SyncAggregate) *structs.SyncAggregate {
 	if input == nil {
 		return nil
@@ -286,6 +172,18 @@ func syncAggregateToJSON(input *v1.SyncAggregate) *structs.SyncAggregate {
 	}
 }
 
+func newLightClientBootstrapToJSON(input *v2.LightClientBootstrap) *structs.LightClientBootstrap {
+	if input == nil {
+		return nil
+	}
+
+	return &structs.LightClientBootstrap{
+		Header:                     structs.BeaconBlockHeaderFromConsensus(migration.V1HeaderToV1Alpha1(input.Header)),
+		CurrentSyncCommittee:       structs.SyncCommitteeFromConsensus(migration.V2SyncCommitteeToV1Alpha1(input.CurrentSyncCommittee)),
+		CurrentSyncCommitteeBranch: branchToJSON(input.CurrentSyncCommitteeBranch),
+	}
+}
+
 func newLightClientUpdateToJSON(input *v2.LightClientUpdate) *structs.LightClientUpdate {
 	if input == nil {
 		return nil
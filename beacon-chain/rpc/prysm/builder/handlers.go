@@ -0,0 +1,123 @@
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+// GetBuilderBids returns the bids the builder relays returned for the proposal of the slot, the value of the local
+// payload, the payload the proposer chose and why, and whether the blinded block was submitted successfully.
+func (s *Server) GetBuilderBids(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "builder.GetBuilderBids")
+	defer span.End()
+
+	_, slot, ok := shared.UintFromRoute(w, r, "slot")
+	if !ok {
+		return
+	}
+	if s.BlockBuilder == nil || !s.BlockBuilder.Configured() {
+		httputil.HandleError(w, "Builder is not configured", http.StatusServiceUnavailable)
+		return
+	}
+	audit, err := s.BlockBuilder.BidAudit(ctx, primitives.Slot(slot))
+	if err != nil {
+		if db.IsNotFound(err) {
+			httputil.HandleError(w, "No builder bids recorded for the slot", http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not get builder bids: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetBuilderBidsResponse{Data: structs.BuilderBidAuditFromConsensus(audit)})
+}
+
+// GetBuilderRelays returns the health of the builder relays, whether they are quarantined and their recent incidents:
+// blinded blocks for which they did not reveal the payload, or whose block did not become canonical.
+func (s *Server) GetBuilderRelays(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "builder.GetBuilderRelays")
+	defer span.End()
+
+	if s.BlockBuilder == nil || !s.BlockBuilder.Configured() {
+		httputil.HandleError(w, "Builder is not configured", http.StatusServiceUnavailable)
+		return
+	}
+	now := time.Now()
+	states := s.BlockBuilder.RelayStates()
+	relays := make([]*structs.BuilderRelay, len(states))
+	for i, st := range states {
+		relays[i] = relayFromState(st, now)
+	}
+	httputil.WriteJson(w, &structs.GetBuilderRelaysResponse{Data: relays})
+}
+
+// SetValidatorRelays sets the builder relays permitted for the given validators, only these relays are asked for a
+// payload header when the validators propose. An empty list permits all the relays of the beacon node.
+func (s *Server) SetValidatorRelays(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "builder.SetValidatorRelays")
+	defer span.End()
+
+	if s.BlockBuilder == nil || !s.BlockBuilder.Configured() {
+		httputil.HandleError(w, "Builder is not configured", http.StatusServiceUnavailable)
+		return
+	}
+	var req []*structs.ValidatorBuilderRelays
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	relays := make(map[primitives.ValidatorIndex][]string, len(req))
+	for _, v := range req {
+		if v == nil {
+			httputil.HandleError(w, "Empty validator relays", http.StatusBadRequest)
+			return
+		}
+		index, valid := shared.ValidateUint(w, "validator_index", v.ValidatorIndex)
+		if !valid {
+			return
+		}
+		relays[primitives.ValidatorIndex(index)] = v.Relays
+	}
+	s.BlockBuilder.UpdateRelays(ctx, relays)
+}
+
+func relayFromState(st *builder.RelayState, now time.Time) *structs.BuilderRelay {
+	incidents := make([]*structs.BuilderRelayIncident, len(st.Incidents))
+	for i, in := range st.Incidents {
+		incidents[i] = &structs.BuilderRelayIncident{
+			Kind:      in.Kind,
+			Slot:      fmt.Sprintf("%d", in.Slot),
+			BlockRoot: hexutil.Encode(in.BlockRoot[:]),
+			BlockHash: hexutil.Encode(in.BlockHash[:]),
+			TimeMs:    fmt.Sprintf("%d", in.Time.UnixMilli()),
+			Error:     in.Err,
+		}
+	}
+	until := int64(0)
+	if !st.QuarantinedUntil.IsZero() {
+		until = st.QuarantinedUntil.UnixMilli()
+	}
+	return &structs.BuilderRelay{
+		Url:                st.URL,
+		Healthy:            st.Healthy,
+		Quarantined:        st.Quarantined(now),
+		QuarantinedUntilMs: fmt.Sprintf("%d", until),
+		Incidents:          incidents,
+	}
+}
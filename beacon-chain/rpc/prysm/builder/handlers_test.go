@@ -0,0 +1,221 @@
+package builder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	blockbuilder "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
+	builderTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGetBuilderBids(t *testing.T) {
+	audit := &ethpb.BuilderBidAudit{
+		Slot:          10,
+		ProposerIndex: 3,
+		ParentHash:    bytesutil.PadTo([]byte{'a'}, 32),
+		Bids: []*ethpb.BuilderBidRecord{
+			{
+				Relay:         "http://relay-1.example.com",
+				Value:         bytesutil.PadTo([]byte{0, 1}, 32),
+				BlockHash:     bytesutil.PadTo([]byte{'b'}, 32),
+				GasLimit:      30000000,
+				FeeRecipient:  bytesutil.PadTo([]byte{'c'}, 20),
+				BuilderPubkey: bytesutil.PadTo([]byte{'d'}, 48),
+				LatencyMs:     150,
+				ReceivedAtMs:  1700000000150,
+			},
+			{
+				Relay:     "http://relay-2.example.com",
+				LatencyMs: 1000,
+				Error:     "relay http://relay-2.example.com: no answer before the get header timeout",
+			},
+		},
+		LocalValue:            bytesutil.PadTo([]byte{1}, 32),
+		BuilderChosen:         true,
+		ChosenRelay:           "http://relay-1.example.com",
+		Reason:                "builder value higher",
+		BlindedBlockSubmitted: true,
+	}
+	s := &Server{BlockBuilder: &builderTest.MockBuilderService{
+		HasConfigured: true,
+		BidAudits:     map[primitives.Slot]*ethpb.BuilderBidAudit{10: audit},
+	}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/builder/bids/{slot}", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "10"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBuilderBids(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBuilderBidsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "10", resp.Data.Slot)
+		assert.Equal(t, "3", resp.Data.ProposerIndex)
+		assert.Equal(t, "1", resp.Data.LocalValue)
+		assert.Equal(t, true, resp.Data.BuilderChosen)
+		assert.Equal(t, "http://relay-1.example.com", resp.Data.ChosenRelay)
+		assert.Equal(t, "builder value higher", resp.Data.Reason)
+		assert.Equal(t, true, resp.Data.BlindedBlockSubmitted)
+		require.Equal(t, 2, len(resp.Data.Bids))
+		assert.Equal(t, "256", resp.Data.Bids[0].Value)
+		assert.Equal(t, "30000000", resp.Data.Bids[0].GasLimit)
+		assert.Equal(t, "150", resp.Data.Bids[0].LatencyMs)
+		assert.Equal(t, "10", resp.Data.Bids[1].Slot)
+		assert.Equal(t, "0", resp.Data.Bids[1].Value)
+		assert.Equal(t, audit.Bids[1].Error, resp.Data.Bids[1].Error)
+	})
+	t.Run("not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/builder/bids/{slot}", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "11"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBuilderBids(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusNotFound, e.Code)
+	})
+	t.Run("invalid slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/builder/bids/{slot}", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBuilderBids(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("builder not configured", func(t *testing.T) {
+		s := &Server{BlockBuilder: &builderTest.MockBuilderService{}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/builder/bids/{slot}", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "10"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBuilderBids(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+func TestGetBuilderRelays(t *testing.T) {
+	incidentTime := time.UnixMilli(1700000000000)
+	s := &Server{BlockBuilder: &builderTest.MockBuilderService{
+		HasConfigured: true,
+		RelayStatus: []*blockbuilder.RelayState{
+			{URL: "http://relay-1.example.com", Healthy: true},
+			{
+				URL:              "http://relay-2.example.com",
+				Healthy:          true,
+				QuarantinedUntil: time.Now().Add(time.Hour),
+				Incidents: []blockbuilder.RelayIncident{
+					{
+						Kind:      blockbuilder.IncidentPayloadWithheld,
+						Slot:      10,
+						BlockRoot: [32]byte{'r'},
+						BlockHash: [32]byte{'h'},
+						Time:      incidentTime,
+						Err:       "payload withheld",
+					},
+				},
+			},
+		},
+	}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/builder/relays", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBuilderRelays(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBuilderRelaysResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "http://relay-1.example.com", resp.Data[0].Url)
+		assert.Equal(t, false, resp.Data[0].Quarantined)
+		assert.Equal(t, "0", resp.Data[0].QuarantinedUntilMs)
+		assert.Equal(t, 0, len(resp.Data[0].Incidents))
+		assert.Equal(t, true, resp.Data[1].Quarantined)
+		require.Equal(t, 1, len(resp.Data[1].Incidents))
+		incident := resp.Data[1].Incidents[0]
+		assert.Equal(t, "payload_withheld", incident.Kind)
+		assert.Equal(t, "10", incident.Slot)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{'r'}, 32)), incident.BlockRoot)
+		assert.Equal(t, "1700000000000", incident.TimeMs)
+		assert.Equal(t, "payload withheld", incident.Error)
+	})
+	t.Run("builder not configured", func(t *testing.T) {
+		s := &Server{BlockBuilder: &builderTest.MockBuilderService{}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/builder/relays", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBuilderRelays(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+func TestSetValidatorRelays(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		bb := &builderTest.MockBuilderService{HasConfigured: true}
+		s := &Server{BlockBuilder: bb}
+		body := `[{"validator_index":"1","relays":["https://relay-a.example.com"]},{"validator_index":"2","relays":[]}]`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/builder/relays/validators", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetValidatorRelays(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.DeepEqual(t, []string{"https://relay-a.example.com"}, bb.RelaysByValidatorID(1))
+		require.Equal(t, 0, len(bb.RelaysByValidatorID(2)))
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		bb := &builderTest.MockBuilderService{HasConfigured: true}
+		s := &Server{BlockBuilder: bb}
+		body := `[{"validator_index":"foo","relays":["https://relay-a.example.com"]}]`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/builder/relays/validators", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetValidatorRelays(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.Equal(t, 0, len(bb.Relays))
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := &Server{BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/builder/relays/validators", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetValidatorRelays(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+	t.Run("builder not configured", func(t *testing.T) {
+		s := &Server{BlockBuilder: &builderTest.MockBuilderService{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/builder/relays/validators", bytes.NewBufferString(`[]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetValidatorRelays(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -0,0 +1,9 @@
+package builder
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
+)
+
+type Server struct {
+	BlockBuilder builder.BlockBuilder
+}
@@ -0,0 +1,34 @@
+package node
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+// GetExecutionEngines returns the status and latency of each execution engine endpoint, the primary engine first.
+func (s *Server) GetExecutionEngines(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetExecutionEngines")
+	defer span.End()
+
+	statuses := s.EngineStatusFetcher.EngineStatuses()
+	engines := make([]*structs.ExecutionEngine, len(statuses))
+	for i, st := range statuses {
+		var lastUpdated string
+		if !st.LastUpdated.IsZero() {
+			lastUpdated = strconv.FormatInt(st.LastUpdated.Unix(), 10)
+		}
+		engines[i] = &structs.ExecutionEngine{
+			Endpoint:    st.Endpoint,
+			Primary:     st.Primary,
+			Status:      string(st.Status),
+			LatencyMs:   strconv.FormatInt(st.Latency.Milliseconds(), 10),
+			LastUpdated: lastUpdated,
+			LastError:   st.LastError,
+		}
+	}
+	httputil.WriteJson(w, &structs.GetExecutionEnginesResponse{Data: engines})
+}
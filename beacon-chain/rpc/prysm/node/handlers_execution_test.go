@@ -0,0 +1,59 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockEngineStatusFetcher struct {
+	statuses []*execution.EngineStatus
+}
+
+func (m *mockEngineStatusFetcher) EngineStatuses() []*execution.EngineStatus {
+	return m.statuses
+}
+
+func TestGetExecutionEngines(t *testing.T) {
+	s := Server{EngineStatusFetcher: &mockEngineStatusFetcher{statuses: []*execution.EngineStatus{
+		{
+			Endpoint:    "http://localhost:8551",
+			Primary:     true,
+			Status:      execution.EngineStatusSynced,
+			Latency:     25 * time.Millisecond,
+			LastUpdated: time.Unix(1700000000, 0),
+		},
+		{
+			Endpoint:  "http://backup:8551",
+			Status:    execution.EngineStatusOffline,
+			LastError: "connection refused",
+		},
+	}}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/execution_engines", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetExecutionEngines(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetExecutionEnginesResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, "http://localhost:8551", resp.Data[0].Endpoint)
+	assert.Equal(t, true, resp.Data[0].Primary)
+	assert.Equal(t, "synced", resp.Data[0].Status)
+	assert.Equal(t, "25", resp.Data[0].LatencyMs)
+	assert.Equal(t, "1700000000", resp.Data[0].LastUpdated)
+	assert.Equal(t, false, resp.Data[1].Primary)
+	assert.Equal(t, "offline", resp.Data[1].Status)
+	assert.Equal(t, "", resp.Data[1].LastUpdated)
+	assert.Equal(t, "connection refused", resp.Data[1].LastError)
+}
@@ -0,0 +1,199 @@
+package node
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+// GetPeerScore returns the score of a peer broken down by scorer, with the data the scorers base it on and the
+// reasons the peer is considered bad, if it is.
+func (s *Server) GetPeerScore(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetPeerScore")
+	defer span.End()
+
+	rawId := mux.Vars(r)["peer_id"]
+	if rawId == "" {
+		httputil.HandleError(w, "peer_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	id, err := peer.Decode(rawId)
+	if err != nil {
+		httputil.HandleError(w, "Invalid peer ID: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	peerStatus := s.PeersFetcher.Peers()
+	b, err := peerStatus.ScoreBreakdown(id)
+	if err != nil {
+		if errors.Is(err, peerdata.ErrPeerUnknown) {
+			httputil.HandleError(w, "Peer not found: "+err.Error(), http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not get peer score: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	topicScores := make(map[string]*structs.TopicScore, len(b.TopicScores))
+	for topic, snapshot := range b.TopicScores {
+		topicScores[topic] = &structs.TopicScore{
+			TimeInMeshMs:             strconv.FormatUint(snapshot.TimeInMesh, 10),
+			FirstMessageDeliveries:   strconv.FormatFloat(float64(snapshot.FirstMessageDeliveries), 'f', -1, 32),
+			MeshMessageDeliveries:    strconv.FormatFloat(float64(snapshot.MeshMessageDeliveries), 'f', -1, 32),
+			InvalidMessageDeliveries: strconv.FormatFloat(float64(snapshot.InvalidMessageDeliveries), 'f', -1, 32),
+		}
+	}
+	var validationError string
+	if b.ValidationError != nil {
+		validationError = b.ValidationError.Error()
+	}
+	reasons := b.BadPeerReasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	httputil.WriteJson(w, &structs.GetPeerScoreResponse{
+		Data: &structs.PeerScore{
+			PeerId: id.String(),
+			Score:  formatFloat(b.Score),
+			Scorers: []*structs.ScorerScore{
+				scorerScore("bad_responses", b.BadResponses),
+				scorerScore("block_provider", b.BlockProvider),
+				scorerScore("peer_status", b.PeerStatus),
+				scorerScore("gossip", b.Gossip),
+			},
+			BadResponses:     strconv.Itoa(b.BadResponsesCount),
+			ProcessedBlocks:  strconv.FormatUint(b.ProcessedBlocks, 10),
+			BehaviourPenalty: formatFloat(b.BehaviourPenalty),
+			TopicScores:      topicScores,
+			ValidationError:  validationError,
+			IsBad:            peerStatus.IsBad(id),
+			BadPeerReasons:   reasons,
+		},
+	})
+}
+
+// GetPeerScoring returns the weights of the peer scorers and the thresholds past which peers are considered bad.
+func (s *Server) GetPeerScoring(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetPeerScoring")
+	defer span.End()
+
+	scorerService := s.PeersFetcher.Peers().Scorers()
+	httputil.WriteJson(w, &structs.GetPeerScoringResponse{Data: peerScoring(scorerService.Weights(), scorerService.Thresholds())})
+}
+
+// SetPeerScoring updates the weights of the peer scorers and the thresholds past which peers are considered bad.
+// The weights and thresholds which are not submitted keep their current value.
+func (s *Server) SetPeerScoring(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.SetPeerScoring")
+	defer span.End()
+
+	var req structs.PeerScoring
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The submitted values are parsed first, then applied to the current ones and validated under the scorers lock.
+	var weights [4]*float64
+	if req.Weights != nil {
+		for i, f := range []struct {
+			name  string
+			value string
+		}{
+			{"bad_responses", req.Weights.BadResponses},
+			{"block_provider", req.Weights.BlockProvider},
+			{"peer_status", req.Weights.PeerStatus},
+			{"gossip", req.Weights.Gossip},
+		} {
+			if f.value == "" {
+				continue
+			}
+			v, err := strconv.ParseFloat(f.value, 64)
+			if err != nil {
+				httputil.HandleError(w, "Invalid "+f.name+" weight: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			weights[i] = &v
+		}
+	}
+	var badResponsesThreshold *int
+	var gossipThreshold *float64
+	if req.Thresholds != nil {
+		if req.Thresholds.BadResponses != "" {
+			v, err := strconv.Atoi(req.Thresholds.BadResponses)
+			if err != nil {
+				httputil.HandleError(w, "Invalid bad_responses threshold: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			badResponsesThreshold = &v
+		}
+		if req.Thresholds.Gossip != "" {
+			v, err := strconv.ParseFloat(req.Thresholds.Gossip, 64)
+			if err != nil {
+				httputil.HandleError(w, "Invalid gossip threshold: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			gossipThreshold = &v
+		}
+	}
+	newWeights, newThresholds, err := s.PeersFetcher.Peers().Scorers().UpdateScoring(func(w *scorers.Weights, t *scorers.Thresholds) {
+		for i, dst := range []*float64{&w.BadResponses, &w.BlockProvider, &w.PeerStatus, &w.Gossip} {
+			if weights[i] != nil {
+				*dst = *weights[i]
+			}
+		}
+		if badResponsesThreshold != nil {
+			t.BadResponses = *badResponsesThreshold
+		}
+		if gossipThreshold != nil {
+			t.Gossip = *gossipThreshold
+		}
+	})
+	if err != nil {
+		httputil.HandleError(w, "Invalid peer scoring: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPeerScoringResponse{Data: peerScoring(newWeights, newThresholds)})
+}
+
+func peerScoring(weights *scorers.Weights, thresholds *scorers.Thresholds) *structs.PeerScoring {
+	return &structs.PeerScoring{
+		Weights: &structs.PeerScorerWeights{
+			BadResponses:  formatFloat(weights.BadResponses),
+			BlockProvider: formatFloat(weights.BlockProvider),
+			PeerStatus:    formatFloat(weights.PeerStatus),
+			Gossip:        formatFloat(weights.Gossip),
+		},
+		Thresholds: &structs.PeerScorerThresholds{
+			BadResponses: strconv.Itoa(thresholds.BadResponses),
+			Gossip:       formatFloat(thresholds.Gossip),
+		},
+	}
+}
+
+func scorerScore(name string, b scorers.ScorerBreakdown) *structs.ScorerScore {
+	return &structs.ScorerScore{
+		Name:         name,
+		Score:        formatFloat(b.Score),
+		Weight:       formatFloat(b.Weight),
+		Contribution: formatFloat(b.Contribution),
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
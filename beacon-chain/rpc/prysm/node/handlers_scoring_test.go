@@ -0,0 +1,169 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corenet "github.com/libp2p/go-libp2p/core/network"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGetPeerScore(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(2)
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	peerStatus := peerFetcher.Peers()
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	peerStatus.Add(nil, ids[0], addr, corenet.DirInbound)
+	for i := 0; i < 5; i++ {
+		peerStatus.Scorers().BadResponsesScorer().Increment(ids[0])
+	}
+	peerStatus.Scorers().BlockProviderScorer().IncrementProcessedBlocks(ids[0], 64)
+	peerStatus.Scorers().GossipScorer().SetGossipData(ids[0], 3, 0.5, map[string]*ethpb.TopicScoreSnapshot{
+		"beacon_block": {TimeInMesh: 1200, FirstMessageDeliveries: 4},
+	})
+	s := Server{PeersFetcher: peerFetcher}
+
+	t.Run("bad peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peers/"+ids[0].String()+"/score", nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": ids[0].String()})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeerScore(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPeerScoreResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, ids[0].String(), resp.Data.PeerId)
+		assert.Equal(t, "5", resp.Data.BadResponses)
+		assert.Equal(t, "64", resp.Data.ProcessedBlocks)
+		assert.Equal(t, "0.5", resp.Data.BehaviourPenalty)
+		assert.Equal(t, true, resp.Data.IsBad)
+		require.Equal(t, 1, len(resp.Data.BadPeerReasons))
+		assert.Equal(t, "5 bad responses, threshold is 5", resp.Data.BadPeerReasons[0])
+		require.Equal(t, 4, len(resp.Data.Scorers))
+		assert.Equal(t, "bad_responses", resp.Data.Scorers[0].Name)
+		assert.Equal(t, "-100", resp.Data.Scorers[0].Score)
+		assert.Equal(t, "0.3", resp.Data.Scorers[0].Weight)
+		assert.Equal(t, "gossip", resp.Data.Scorers[3].Name)
+		assert.Equal(t, "3", resp.Data.Scorers[3].Score)
+		topicScore, ok := resp.Data.TopicScores["beacon_block"]
+		require.Equal(t, true, ok)
+		assert.Equal(t, "1200", topicScore.TimeInMeshMs)
+		assert.Equal(t, "4", topicScore.FirstMessageDeliveries)
+	})
+	t.Run("unknown peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peers/"+ids[1].String()+"/score", nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": ids[1].String()})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeerScore(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Peer not found", e.Message)
+	})
+	t.Run("invalid peer ID", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peers/foo/score", nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeerScore(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestSetPeerScoring(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	s := Server{PeersFetcher: peerFetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peers/scoring", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetPeerScoring(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetPeerScoringResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "0.3", resp.Data.Weights.BadResponses)
+	assert.Equal(t, "0.4", resp.Data.Weights.Gossip)
+	assert.Equal(t, "5", resp.Data.Thresholds.BadResponses)
+	assert.Equal(t, "-100", resp.Data.Thresholds.Gossip)
+
+	t.Run("partial update", func(t *testing.T) {
+		body := `{"weights":{"gossip":"0.7"},"thresholds":{"bad_responses":"10"}}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/peers/scoring", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetPeerScoring(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPeerScoringResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0.3", resp.Data.Weights.BadResponses)
+		assert.Equal(t, "0.7", resp.Data.Weights.Gossip)
+		assert.Equal(t, "10", resp.Data.Thresholds.BadResponses)
+		assert.Equal(t, "-100", resp.Data.Thresholds.Gossip)
+		assert.Equal(t, 10, peerFetcher.Peers().Scorers().BadResponsesScorer().Params().Threshold)
+	})
+	t.Run("invalid request changes nothing", func(t *testing.T) {
+		body := `{"weights":{"gossip":"0.1"},"thresholds":{"gossip":"5"}}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/peers/scoring", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetPeerScoring(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "gossip threshold must be negative", e.Message)
+		assert.Equal(t, 0.7, peerFetcher.Peers().Scorers().Weights().Gossip)
+	})
+	t.Run("not a number", func(t *testing.T) {
+		body := `{"weights":{"gossip":"NaN"}}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/peers/scoring", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetPeerScoring(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "scorer weights must be finite numbers", e.Message)
+		assert.Equal(t, 0.7, peerFetcher.Peers().Scorers().Weights().Gossip)
+	})
+	t.Run("malformed weight", func(t *testing.T) {
+		body := `{"weights":{"peer_status":"foo"}}`
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/peers/scoring", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetPeerScoring(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid peer_status weight", e.Message)
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/peers/scoring", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetPeerScoring(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
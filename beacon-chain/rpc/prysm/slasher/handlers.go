@@ -0,0 +1,253 @@
+package slasher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// AttesterSlashingTopic is the event of an attester slashing detected by the slasher.
+	AttesterSlashingTopic = "attester_slashing"
+	// ProposerSlashingTopic is the event of a proposer slashing detected by the slasher.
+	ProposerSlashingTopic = "proposer_slashing"
+)
+
+const chanBuffer = 100
+
+// GetAttesterSlashings returns the attester slashings detected by the slasher whose target epoch is between the
+// start and end epochs, both included.
+func (s *Server) GetAttesterSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetAttesterSlashings")
+	defer span.End()
+
+	if s.SlashingChecker == nil {
+		httputil.HandleError(w, "Slasher is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	start, end, ok := epochRange(w, r)
+	if !ok {
+		return
+	}
+	slashings, err := s.SlashingChecker.AttesterSlashings(ctx, start, end)
+	if err != nil {
+		httputil.HandleError(w, "Could not get attester slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetSlasherAttesterSlashingsResponse{Data: structs.AttesterSlashingsFromConsensus(slashings)})
+}
+
+// GetProposerSlashings returns the proposer slashings detected by the slasher whose slot is between the start and
+// end epochs, both included.
+func (s *Server) GetProposerSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetProposerSlashings")
+	defer span.End()
+
+	if s.SlashingChecker == nil {
+		httputil.HandleError(w, "Slasher is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	start, end, ok := epochRange(w, r)
+	if !ok {
+		return
+	}
+	slashings, err := s.SlashingChecker.ProposerSlashings(ctx, start, end)
+	if err != nil {
+		httputil.HandleError(w, "Could not get proposer slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetSlasherProposerSlashingsResponse{Data: structs.ProposerSlashingsFromConsensus(slashings)})
+}
+
+// IsSlashableAttestation returns the attester slashings the submitted indexed attestation would cause against the
+// attestations seen by the slasher. The attestation is not recorded.
+func (s *Server) IsSlashableAttestation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if s.SlashingChecker == nil {
+		httputil.HandleError(w, "Slasher is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	var req structs.IndexedAttestation
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	att, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request attestation to consensus attestation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	slashings, err := s.SlashingChecker.IsSlashableAttestation(ctx, att)
+	if err != nil {
+		if errors.Is(err, slasher.ErrInvalidAttestation) {
+			httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not check attestation: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.IsSlashableAttestationResponse{
+		Data: &structs.SlashableAttestation{
+			Slashable:         len(slashings) > 0,
+			AttesterSlashings: structs.AttesterSlashingsFromConsensus(slashings),
+		},
+	})
+}
+
+// IsSlashableBlock returns the proposer slashing the submitted signed block header would cause against the blocks
+// seen by the slasher, if any. The block header is not recorded.
+func (s *Server) IsSlashableBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if s.SlashingChecker == nil {
+		httputil.HandleError(w, "Slasher is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	var req structs.SignedBeaconBlockHeader
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	header, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request header to consensus header: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	slashing, err := s.SlashingChecker.IsSlashableBlock(ctx, header)
+	if err != nil {
+		if errors.Is(err, slasher.ErrInvalidBlockHeader) {
+			httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not check block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &structs.SlashableBlock{Slashable: slashing != nil}
+	if slashing != nil {
+		resp.ProposerSlashing = structs.ProposerSlashingFromConsensus(slashing)
+	}
+	httputil.WriteJson(w, &structs.IsSlashableBlockResponse{Data: resp})
+}
+
+// StreamSlashings streams the slashings detected by the slasher as Server-Sent-Events, with the attester_slashing
+// and proposer_slashing event names. A keepalive comment is sent every slot.
+func (s *Server) StreamSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.StreamSlashings")
+	defer span.End()
+
+	if s.SlashingChecker == nil {
+		httputil.HandleError(w, "Slasher is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httputil.HandleError(w, "Streaming unsupported!", http.StatusInternalServerError)
+		return
+	}
+
+	detections := make(chan *slasher.Detection, chanBuffer)
+	sub := s.SlashingChecker.SubscribeDetections(detections)
+	defer sub.Unsubscribe()
+
+	w.Header().Set("Content-Type", api.EventStreamMediaType)
+	w.Header().Set("Connection", api.KeepAlive)
+
+	if err := sendKeepalive(w, flusher); err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	keepaliveTicker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer keepaliveTicker.Stop()
+
+	for {
+		select {
+		case d := <-detections:
+			var err error
+			switch {
+			case d.AttesterSlashing != nil:
+				err = send(w, flusher, AttesterSlashingTopic, structs.AttesterSlashingFromConsensus(d.AttesterSlashing))
+			case d.ProposerSlashing != nil:
+				err = send(w, flusher, ProposerSlashingTopic, structs.ProposerSlashingFromConsensus(d.ProposerSlashing))
+			}
+			if err != nil {
+				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case <-keepaliveTicker.C:
+			if err := sendKeepalive(w, flusher); err != nil {
+				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case err := <-sub.Err():
+			if err != nil {
+				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// epochRange reads the required start_epoch and end_epoch query parameters.
+func epochRange(w http.ResponseWriter, r *http.Request) (primitives.Epoch, primitives.Epoch, bool) {
+	_, start, ok := shared.UintFromQuery(w, r, "start_epoch", true)
+	if !ok {
+		return 0, 0, false
+	}
+	_, end, ok := shared.UintFromQuery(w, r, "end_epoch", true)
+	if !ok {
+		return 0, 0, false
+	}
+	if start > end {
+		httputil.HandleError(w, fmt.Sprintf("Start epoch %d is after end epoch %d", start, end), http.StatusBadRequest)
+		return 0, 0, false
+	}
+	return primitives.Epoch(start), primitives.Epoch(end), true
+}
+
+func send(w http.ResponseWriter, flusher http.Flusher, name string, data interface{}) error {
+	j, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal event to JSON")
+	}
+	return write(w, flusher, "event: %s\ndata: %s\n\n", name, string(j))
+}
+
+func sendKeepalive(w http.ResponseWriter, flusher http.Flusher) error {
+	return write(w, flusher, ":\n\n")
+}
+
+func write(w http.ResponseWriter, flusher http.Flusher, format string, a ...any) error {
+	_, err := fmt.Fprintf(w, format, a...)
+	if err != nil {
+		return errors.Wrap(err, "could not write to response writer")
+	}
+	flusher.Flush()
+	return nil
+}
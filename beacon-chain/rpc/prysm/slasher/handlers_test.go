@@ -0,0 +1,227 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/mock"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type flushableResponseRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (f *flushableResponseRecorder) Flush() {}
+
+func indexedAttestation(source, target primitives.Epoch, root byte) *ethpb.IndexedAttestation {
+	att := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	att.Data.Source.Epoch = source
+	att.Data.Target.Epoch = target
+	att.Data.BeaconBlockRoot[0] = root
+	return att
+}
+
+func attesterSlashing(target primitives.Epoch) *ethpb.AttesterSlashing {
+	return &ethpb.AttesterSlashing{
+		Attestation_1: indexedAttestation(target-1, target, 1),
+		Attestation_2: indexedAttestation(target-1, target, 2),
+	}
+}
+
+func signedHeader(slot primitives.Slot, root byte) *ethpb.SignedBeaconBlockHeader {
+	h := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{})
+	h.Header.Slot = slot
+	h.Header.ProposerIndex = 1
+	h.Header.BodyRoot[0] = root
+	return h
+}
+
+func proposerSlashing(slot primitives.Slot) *ethpb.ProposerSlashing {
+	return &ethpb.ProposerSlashing{Header_1: signedHeader(slot, 1), Header_2: signedHeader(slot, 2)}
+}
+
+func TestGetAttesterSlashings(t *testing.T) {
+	s := &Server{SlashingChecker: &mock.MockSlashingChecker{
+		AttSlashings: []*ethpb.AttesterSlashing{attesterSlashing(2), attesterSlashing(5), attesterSlashing(9)},
+	}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/attester_slashings?start_epoch=2&end_epoch=5", nil)
+		writer := httptest.NewRecorder()
+		s.GetAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlasherAttesterSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "2", resp.Data[0].Attestation2.Data.Target.Epoch)
+		assert.Equal(t, "5", resp.Data[1].Attestation2.Data.Target.Epoch)
+	})
+	t.Run("missing end epoch", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/attester_slashings?start_epoch=2", nil)
+		writer := httptest.NewRecorder()
+		s.GetAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("start after end", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/attester_slashings?start_epoch=6&end_epoch=5", nil)
+		writer := httptest.NewRecorder()
+		s.GetAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Start epoch 6 is after end epoch 5", e.Message)
+	})
+	t.Run("slasher not enabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/attester_slashings?start_epoch=2&end_epoch=5", nil)
+		writer := httptest.NewRecorder()
+		(&Server{}).GetAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+func TestGetProposerSlashings(t *testing.T) {
+	s := &Server{SlashingChecker: &mock.MockSlashingChecker{
+		PropSlashings: []*ethpb.ProposerSlashing{proposerSlashing(1), proposerSlashing(100)},
+	}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/proposer_slashings?start_epoch=0&end_epoch=1", nil)
+	writer := httptest.NewRecorder()
+	s.GetProposerSlashings(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetSlasherProposerSlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, "1", resp.Data[0].SignedHeader1.Message.Slot)
+}
+
+func TestIsSlashableAttestation(t *testing.T) {
+	att := structs.AttesterSlashingFromConsensus(attesterSlashing(2)).Attestation1
+	body, err := json.Marshal(att)
+	require.NoError(t, err)
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{
+			SlashableAttSlashings: []*ethpb.AttesterSlashing{attesterSlashing(2)},
+		}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Data.Slashable)
+		require.Equal(t, 1, len(resp.Data.AttesterSlashings))
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, false, resp.Data.Slashable)
+		assert.Equal(t, 0, len(resp.Data.AttesterSlashings))
+	})
+	t.Run("invalid attestation", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{Err: slasher.ErrInvalidAttestation}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", nil)
+		writer := httptest.NewRecorder()
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+}
+
+func TestIsSlashableBlock(t *testing.T) {
+	body, err := json.Marshal(structs.SignedBeaconBlockHeaderFromConsensus(signedHeader(3, 3)))
+	require.NoError(t, err)
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{SlashableBlockSlashing: proposerSlashing(3)}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/blocks/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Data.Slashable)
+		require.NotNil(t, resp.Data.ProposerSlashing)
+		assert.Equal(t, "3", resp.Data.ProposerSlashing.SignedHeader2.Message.Slot)
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/blocks/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, false, resp.Data.Slashable)
+		assert.Equal(t, true, resp.Data.ProposerSlashing == nil)
+	})
+	t.Run("invalid header", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/blocks/slashable", strings.NewReader(`{"message":{"slot":"a"}}`))
+		writer := httptest.NewRecorder()
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestStreamSlashings(t *testing.T) {
+	checker := &mock.MockSlashingChecker{}
+	s := &Server{SlashingChecker: checker}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/events", nil).WithContext(ctx)
+	w := &flushableResponseRecorder{ResponseRecorder: httptest.NewRecorder()}
+	done := make(chan struct{})
+	go func() {
+		s.StreamSlashings(w, request)
+		close(done)
+	}()
+
+	// Wait for the stream to subscribe to the detections.
+	detection := &slasher.Detection{AttesterSlashing: attesterSlashing(2)}
+	for checker.Feed.Send(detection) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	checker.Feed.Send(&slasher.Detection{ProposerSlashing: proposerSlashing(3)})
+	// The detections are buffered, give the stream time to write them.
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	<-done
+
+	attJson, err := json.Marshal(structs.AttesterSlashingFromConsensus(attesterSlashing(2)))
+	require.NoError(t, err)
+	propJson, err := json.Marshal(structs.ProposerSlashingFromConsensus(proposerSlashing(3)))
+	require.NoError(t, err)
+	expected := ":\n\n" +
+		"event: attester_slashing\ndata: " + string(attJson) + "\n\n" +
+		"event: proposer_slashing\ndata: " + string(propJson) + "\n\n"
+	assert.Equal(t, expected, w.Body.String())
+}
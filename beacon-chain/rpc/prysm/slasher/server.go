@@ -0,0 +1,9 @@
+package slasher
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+)
+
+type Server struct {
+	SlashingChecker slasher.SlashingChecker
+}
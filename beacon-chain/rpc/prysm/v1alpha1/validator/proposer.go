@@ -232,24 +232,54 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 	// There's no reason to try to get a builder bid if local override is true.
 	var builderPayload interfaces.ExecutionData
 	var builderKzgCommitments [][]byte
+	var builderErr error
 	overrideBuilder = overrideBuilder || skipMevBoost // Skip using mev-boost if requested by the caller.
 	if !overrideBuilder {
-		builderPayload, builderKzgCommitments, err = vs.getBuilderPayloadAndBlobs(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
-		if err != nil {
+		builderPayload, builderKzgCommitments, builderErr = vs.getBuilderPayloadAndBlobs(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
+		switch {
+		case errors.Is(builderErr, builder.ErrRelaysQuarantined):
+			log.WithError(builderErr).Warn("Using local payload while the builder relays are quarantined")
+		case builderErr != nil:
 			builderGetPayloadMissCount.Inc()
-			log.WithError(err).Error("Could not get builder payload")
+			log.WithError(builderErr).Error("Could not get builder payload")
 		}
 	}
 
-	if err := setExecutionData(ctx, sBlk, localPayload, builderPayload, builderKzgCommitments, builderBoostFactor); err != nil {
+	reason, err := setExecutionData(ctx, sBlk, localPayload, builderPayload, builderKzgCommitments, builderBoostFactor)
+	if err != nil {
 		return status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 	}
+	switch {
+	case reason != payloadReasonNoBuilderPayload:
+	case overrideBuilder:
+		reason = payloadReasonBuilderSkipped
+	case builderErr != nil:
+		reason = fmt.Sprintf("%s: %v", reason, builderErr)
+	}
+	vs.recordPayloadChoice(ctx, sBlk, localPayload, reason)
 
 	wg.Wait() // Wait until block is built via consensus and execution fields.
 
 	return nil
 }
 
+// recordPayloadChoice records the choice between the local payload and the builder payload in the builder bid audit.
+func (vs *Server) recordPayloadChoice(ctx context.Context, blk interfaces.SignedBeaconBlock, localPayload interfaces.ExecutionData, reason string) {
+	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() || reason == "" {
+		return
+	}
+	choice := &builder.PayloadChoice{
+		Slot:          blk.Block().Slot(),
+		ProposerIndex: blk.Block().ProposerIndex(),
+		BuilderChosen: blk.IsBlinded(),
+		Reason:        reason,
+	}
+	if v, err := localPayload.ValueInWei(); err == nil && v != nil {
+		choice.LocalValue = v
+	}
+	vs.BlockBuilder.RecordPayloadChoice(ctx, choice)
+}
+
 // ProposeBeaconBlock handles the proposal of beacon blocks.
 func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.ProposeBeaconBlock")
@@ -383,11 +413,12 @@ func (vs *Server) broadcastAndReceiveBlobs(ctx context.Context, sidecars []*ethp
 	return nil
 }
 
-// PrepareBeaconProposer caches and updates the fee recipient for the given proposer.
+// PrepareBeaconProposer caches and updates the fee recipient and the permitted builder relays for the given proposer.
 func (vs *Server) PrepareBeaconProposer(
-	_ context.Context, request *ethpb.PrepareBeaconProposerRequest,
+	ctx context.Context, request *ethpb.PrepareBeaconProposerRequest,
 ) (*emptypb.Empty, error) {
 	var validatorIndices []primitives.ValidatorIndex
+	relays := make(map[primitives.ValidatorIndex][]string)
 
 	for _, r := range request.Recipients {
 		recipient := hexutil.Encode(r.FeeRecipient)
@@ -409,6 +440,10 @@ func (vs *Server) PrepareBeaconProposer(
 		}
 		vs.TrackedValidatorsCache.Set(val)
 		validatorIndices = append(validatorIndices, r.ValidatorIndex)
+		relays[r.ValidatorIndex] = r.Relays
+	}
+	if vs.BlockBuilder != nil && vs.BlockBuilder.Configured() {
+		vs.BlockBuilder.UpdateRelays(ctx, relays)
 	}
 	if len(validatorIndices) != 0 {
 		log.WithFields(logrus.Fields{
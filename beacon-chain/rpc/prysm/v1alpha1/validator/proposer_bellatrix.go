@@ -50,23 +50,35 @@ var emptyTransactionsRoot = [32]byte{127, 254, 36, 30, 166, 1, 135, 253, 176, 24
 // block request. This value is known as `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
 const blockBuilderTimeout = 1 * time.Second
 
+// Reasons of the choice between the local payload and the builder payload, recorded in the builder bid audit.
+const (
+	payloadReasonNoBuilderPayload      = "no builder payload"
+	payloadReasonBuilderSkipped        = "builder skipped"
+	payloadReasonBuilderValueHigher    = "builder value higher"
+	payloadReasonLocalValueHigher      = "local value higher"
+	payloadReasonWithdrawalsMismatch   = "builder withdrawals mismatch"
+	payloadReasonInvalidBuilderPayload = "invalid builder payload"
+	payloadReasonBuilderBellatrix      = "builder payload, values are not compared before capella"
+)
+
 // Sets the execution data for the block. Execution data can come from local EL client or remote builder depends on validator registration and circuit breaker conditions.
-func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, localPayload, builderPayload interfaces.ExecutionData, builderKzgCommitments [][]byte, builderBoostFactor uint64) error {
+// It returns the reason of the choice between the local payload and the builder payload.
+func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, localPayload, builderPayload interfaces.ExecutionData, builderKzgCommitments [][]byte, builderBoostFactor uint64) (string, error) {
 	_, span := trace.StartSpan(ctx, "ProposerServer.setExecutionData")
 	defer span.End()
 
 	slot := blk.Block().Slot()
 	if slots.ToEpoch(slot) < params.BeaconConfig().BellatrixForkEpoch {
-		return nil
+		return "", nil
 	}
 
 	if localPayload == nil {
-		return errors.New("local payload is nil")
+		return "", errors.New("local payload is nil")
 	}
 
 	// Use local payload if builder payload is nil.
 	if builderPayload == nil {
-		return setLocalExecution(blk, localPayload)
+		return payloadReasonNoBuilderPayload, setLocalExecution(blk, localPayload)
 	}
 
 	switch {
@@ -74,19 +86,19 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		// Compare payload values between local and builder. Default to the local value if it is higher.
 		localValueGwei, err := localPayload.ValueInGwei()
 		if err != nil {
-			return errors.Wrap(err, "failed to get local payload value")
+			return "", errors.Wrap(err, "failed to get local payload value")
 		}
 		builderValueGwei, err := builderPayload.ValueInGwei()
 		if err != nil {
 			log.WithError(err).Warn("Proposer: failed to get builder payload value") // Default to local if can't get builder value.
-			return setLocalExecution(blk, localPayload)
+			return payloadReasonInvalidBuilderPayload, setLocalExecution(blk, localPayload)
 		}
 
 		withdrawalsMatched, err := matchingWithdrawalsRoot(localPayload, builderPayload)
 		if err != nil {
 			tracing.AnnotateError(span, err)
 			log.WithError(err).Warn("Proposer: failed to match withdrawals root")
-			return setLocalExecution(blk, localPayload)
+			return payloadReasonInvalidBuilderPayload, setLocalExecution(blk, localPayload)
 		}
 
 		// Use builder payload if the following in true:
@@ -108,9 +120,9 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		if higherValueBuilder && withdrawalsMatched { // Builder value is higher and withdrawals match.
 			if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 				log.WithError(err).Warn("Proposer: failed to set builder payload")
-				return setLocalExecution(blk, localPayload)
+				return payloadReasonInvalidBuilderPayload, setLocalExecution(blk, localPayload)
 			} else {
-				return nil
+				return payloadReasonBuilderValueHigher, nil
 			}
 		}
 		if !higherValueBuilder {
@@ -128,13 +140,16 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 			trace.Int64Attribute("builderGweiValue", int64(builderValueGwei)),     // lint:ignore uintcast -- This is OK for tracing.
 			trace.Int64Attribute("builderBoostFactor", int64(builderBoostFactor)), // lint:ignore uintcast -- This is OK for tracing.
 		)
-		return setLocalExecution(blk, localPayload)
+		if !higherValueBuilder {
+			return payloadReasonLocalValueHigher, setLocalExecution(blk, localPayload)
+		}
+		return payloadReasonWithdrawalsMismatch, setLocalExecution(blk, localPayload)
 	default: // Bellatrix case.
 		if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 			log.WithError(err).Warn("Proposer: failed to set builder payload")
-			return setLocalExecution(blk, localPayload)
+			return payloadReasonInvalidBuilderPayload, setLocalExecution(blk, localPayload)
 		} else {
-			return nil
+			return payloadReasonBuilderBellatrix, nil
 		}
 	}
 }
@@ -166,7 +181,9 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
 	defer cancel()
 
-	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk)
+	// Only request the relays the proposer permits.
+	relays := vs.BlockBuilder.RelaysByValidatorID(idx)
+	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk, relays)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2714,6 +2714,31 @@ func TestProposer_PrepareBeaconProposer(t *testing.T) {
 	}
 }
 
+func TestProposer_PrepareBeaconProposer_Relays(t *testing.T) {
+	relays := []string{"https://relay-a.example.com"}
+	bb := &builderTest.MockBuilderService{HasConfigured: true}
+	proposerServer := &Server{
+		BlockBuilder:           bb,
+		TrackedValidatorsCache: cache.NewTrackedValidatorsCache(),
+	}
+	_, err := proposerServer.PrepareBeaconProposer(context.Background(), &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{
+				FeeRecipient:   make([]byte, fieldparams.FeeRecipientLength),
+				ValidatorIndex: 1,
+				Relays:         relays,
+			},
+			{
+				FeeRecipient:   make([]byte, fieldparams.FeeRecipientLength),
+				ValidatorIndex: 2,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, relays, bb.RelaysByValidatorID(1))
+	require.Equal(t, 0, len(bb.RelaysByValidatorID(2)))
+}
+
 func TestProposer_PrepareBeaconProposerOverlapping(t *testing.T) {
 	hook := logTest.NewGlobal()
 	db := dbutil.SetupDB(t)
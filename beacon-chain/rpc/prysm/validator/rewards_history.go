@@ -0,0 +1,137 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// maxRewardsHistoryEpochs is the maximum number of epochs a single rewards history request can span.
+	maxRewardsHistoryEpochs = 1024
+	// maxRewardsHistoryValidators is the maximum number of validators a single rewards history request can ask for.
+	maxRewardsHistoryValidators = 1024
+)
+
+// GetValidatorRewardsHistory returns the rewards and penalties of the requested validators over a range of epochs,
+// as recorded by the rewards indexer. The end epoch defaults to the last indexed epoch and the start epoch to the end epoch.
+func (s *Server) GetValidatorRewardsHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetValidatorRewardsHistory")
+	defer span.End()
+
+	indices, ok := s.rewardsHistoryIndices(w, r)
+	if !ok {
+		return
+	}
+	rawStart, start, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return
+	}
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return
+	}
+
+	lastIndexed, err := s.BeaconDB.LastIndexedRewardsEpoch(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			httputil.HandleError(w, "No rewards have been indexed, the rewards indexer may be disabled", http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not get last indexed rewards epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	endEpoch := lastIndexed
+	if rawEnd != "" {
+		endEpoch = primitives.Epoch(end)
+	}
+	startEpoch := endEpoch
+	if rawStart != "" {
+		startEpoch = primitives.Epoch(start)
+	}
+	if startEpoch > endEpoch {
+		httputil.HandleError(w, fmt.Sprintf("Start epoch %d is after end epoch %d", startEpoch, endEpoch), http.StatusBadRequest)
+		return
+	}
+	if endEpoch-startEpoch >= maxRewardsHistoryEpochs {
+		httputil.HandleError(w, fmt.Sprintf("Requested range exceeds the maximum of %d epochs", maxRewardsHistoryEpochs), http.StatusBadRequest)
+		return
+	}
+
+	rewards, err := s.BeaconDB.ValidatorRewards(ctx, indices, startEpoch, endEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator rewards: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*structs.ValidatorEpochRewards, len(rewards))
+	for i, rw := range rewards {
+		data[i] = &structs.ValidatorEpochRewards{
+			ValidatorIndex: strconv.FormatUint(uint64(rw.ValidatorIndex), 10),
+			Epoch:          strconv.FormatUint(uint64(rw.Epoch), 10),
+			Head:           strconv.FormatInt(rw.Head, 10),
+			Source:         strconv.FormatInt(rw.Source, 10),
+			Target:         strconv.FormatInt(rw.Target, 10),
+			Inactivity:     strconv.FormatInt(rw.Inactivity, 10),
+			Proposer:       strconv.FormatInt(rw.Proposer, 10),
+			SyncCommittee:  strconv.FormatInt(rw.SyncCommittee, 10),
+			Total:          strconv.FormatInt(rw.Total(), 10),
+		}
+	}
+	httputil.WriteJson(w, &structs.ValidatorRewardsHistoryResponse{Data: data})
+}
+
+// rewardsHistoryIndices parses the comma separated validator indices or public keys of the request path.
+// Public keys are resolved against the head state.
+func (s *Server) rewardsHistoryIndices(w http.ResponseWriter, r *http.Request) ([]primitives.ValidatorIndex, bool) {
+	rawIds := strings.Split(mux.Vars(r)["ids"], ",")
+	if len(rawIds) > maxRewardsHistoryValidators {
+		httputil.HandleError(w, fmt.Sprintf("Requested validators exceed the maximum of %d", maxRewardsHistoryValidators), http.StatusBadRequest)
+		return nil, false
+	}
+	indices := make([]primitives.ValidatorIndex, 0, len(rawIds))
+	for _, id := range rawIds {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err == nil {
+			indices = append(indices, primitives.ValidatorIndex(index))
+			continue
+		}
+		pubkey, err := hexutil.Decode(id)
+		if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+			httputil.HandleError(w, fmt.Sprintf("%s is not a validator index or pubkey", id), http.StatusBadRequest)
+			return nil, false
+		}
+		st, err := s.HeadFetcher.HeadStateReadOnly(r.Context())
+		if err != nil {
+			httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+			return nil, false
+		}
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			httputil.HandleError(w, fmt.Sprintf("No validator index found for pubkey %#x", pubkey), http.StatusNotFound)
+			return nil, false
+		}
+		indices = append(indices, idx)
+	}
+	if len(indices) == 0 {
+		httputil.HandleError(w, "At least one validator index or pubkey is required", http.StatusBadRequest)
+		return nil, false
+	}
+	return indices, true
+}
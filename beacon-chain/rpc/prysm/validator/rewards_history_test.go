@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetValidatorRewardsHistory(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 4)
+	beaconDB := dbtest.SetupDB(t)
+	s := &Server{
+		BeaconDB:    beaconDB,
+		HeadFetcher: &mock.ChainService{State: st},
+	}
+
+	request := func(ids, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/rewards/validators/"+ids+query, nil)
+		req = mux.SetURLVars(req, map[string]string{"ids": ids})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorRewardsHistory(writer, req)
+		return writer
+	}
+
+	t.Run("nothing indexed", func(t *testing.T) {
+		writer := request("1", "")
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		rewards := make([]*validator.EpochRewards, 0, 4)
+		for i := primitives.ValidatorIndex(0); i < 4; i++ {
+			rewards = append(rewards, &validator.EpochRewards{
+				ValidatorIndex: i,
+				Epoch:          epoch,
+				Head:           int64(epoch),
+				Source:         10,
+				Target:         -5,
+				Proposer:       int64(i),
+			})
+		}
+		require.NoError(t, beaconDB.SaveValidatorRewards(ctx, epoch, rewards))
+	}
+
+	t.Run("defaults to last indexed epoch", func(t *testing.T) {
+		writer := request("1", "")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ValidatorRewardsHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, &structs.ValidatorEpochRewards{
+			ValidatorIndex: "1",
+			Epoch:          "3",
+			Head:           "3",
+			Source:         "10",
+			Target:         "-5",
+			Inactivity:     "0",
+			Proposer:       "1",
+			SyncCommittee:  "0",
+			Total:          "9",
+		}, resp.Data[0])
+	})
+	t.Run("range with indices and pubkeys", func(t *testing.T) {
+		pubkey := hexutil.Encode(st.Validators()[2].PublicKey)
+		writer := request("0,"+pubkey, "?start_epoch=1&end_epoch=2")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ValidatorRewardsHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Data))
+		assert.Equal(t, "0", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, "1", resp.Data[0].Epoch)
+		assert.Equal(t, "2", resp.Data[1].ValidatorIndex)
+		assert.Equal(t, "1", resp.Data[1].Epoch)
+		assert.Equal(t, "2", resp.Data[3].ValidatorIndex)
+		assert.Equal(t, "2", resp.Data[3].Epoch)
+	})
+	t.Run("start after end", func(t *testing.T) {
+		writer := request("1", "?start_epoch=3&end_epoch=2")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("range too large", func(t *testing.T) {
+		writer := request("1", "?start_epoch=0&end_epoch=1024")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid id", func(t *testing.T) {
+		writer := request("foo", "")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("unknown pubkey", func(t *testing.T) {
+		writer := request(hexutil.Encode(make([]byte, 48)), "")
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
@@ -0,0 +1,205 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"go.opencensus.io/trace"
+)
+
+// maxMonitorValidatorsPerRequest is the maximum number of validators tracked or untracked by a single request.
+const maxMonitorValidatorsPerRequest = 512
+
+// GetValidatorMonitorPerformance returns the per-epoch performance history the validator monitor recorded
+// for the tracked validators given by the `indices` query parameter, or for all tracked validators.
+func (s *Server) GetValidatorMonitorPerformance(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetValidatorMonitorPerformance")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not available", http.StatusServiceUnavailable)
+		return
+	}
+	indices, ok := parseMonitorIndices(w, r.URL.Query()["indices"])
+	if !ok {
+		return
+	}
+	history := s.ValidatorMonitor.PerformanceHistory(indices)
+	data := make([]*structs.ValidatorEpochPerformance, len(history))
+	for i, p := range history {
+		data[i] = structs.ValidatorEpochPerformanceFromConsensus(p)
+	}
+	httputil.WriteJson(w, &structs.GetValidatorMonitorPerformanceResponse{Data: data})
+}
+
+// GetValidatorMonitorIndices returns the indices of the validators tracked by the validator monitor.
+func (s *Server) GetValidatorMonitorIndices(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetValidatorMonitorIndices")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not available", http.StatusServiceUnavailable)
+		return
+	}
+	tracked := s.ValidatorMonitor.TrackedIndices()
+	data := make([]string, len(tracked))
+	for i, idx := range tracked {
+		data[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	httputil.WriteJson(w, &structs.ValidatorMonitorIndicesResponse{Data: data})
+}
+
+// AddValidatorMonitorIndices starts tracking the given validators in the validator monitor.
+func (s *Server) AddValidatorMonitorIndices(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.AddValidatorMonitorIndices")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not available", http.StatusServiceUnavailable)
+		return
+	}
+	indices, ok := decodeMonitorIndicesRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := s.ValidatorMonitor.TrackValidators(ctx, indices); err != nil {
+		httputil.HandleError(w, "Could not track validators: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+}
+
+// RemoveValidatorMonitorIndices stops tracking the given validators in the validator monitor.
+func (s *Server) RemoveValidatorMonitorIndices(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.RemoveValidatorMonitorIndices")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not available", http.StatusServiceUnavailable)
+		return
+	}
+	indices, ok := decodeMonitorIndicesRequest(w, r)
+	if !ok {
+		return
+	}
+	s.ValidatorMonitor.UntrackValidators(indices)
+}
+
+// AddValidatorMonitorPubkeys starts tracking the validators with the given public keys in the validator monitor,
+// and returns their indices. Public keys without a validator in the head state are ignored.
+func (s *Server) AddValidatorMonitorPubkeys(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.AddValidatorMonitorPubkeys")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not available", http.StatusServiceUnavailable)
+		return
+	}
+	indices, ok := s.decodeMonitorPubkeysRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := s.ValidatorMonitor.TrackValidators(ctx, indices); err != nil {
+		httputil.HandleError(w, "Could not track validators: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	data := make([]string, len(indices))
+	for i, idx := range indices {
+		data[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	httputil.WriteJson(w, &structs.ValidatorMonitorIndicesResponse{Data: data})
+}
+
+// RemoveValidatorMonitorPubkeys stops tracking the validators with the given public keys in the validator monitor.
+func (s *Server) RemoveValidatorMonitorPubkeys(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.RemoveValidatorMonitorPubkeys")
+	defer span.End()
+
+	if s.ValidatorMonitor == nil {
+		httputil.HandleError(w, "Validator monitor is not available", http.StatusServiceUnavailable)
+		return
+	}
+	indices, ok := s.decodeMonitorPubkeysRequest(w, r)
+	if !ok {
+		return
+	}
+	s.ValidatorMonitor.UntrackValidators(indices)
+}
+
+func (s *Server) decodeMonitorPubkeysRequest(w http.ResponseWriter, r *http.Request) ([]primitives.ValidatorIndex, bool) {
+	var req structs.ValidatorMonitorPubkeysRequest
+	if r.Body == http.NoBody {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return nil, false
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	if len(req.Pubkeys) == 0 {
+		httputil.HandleError(w, "No validator public keys submitted", http.StatusBadRequest)
+		return nil, false
+	}
+	if len(req.Pubkeys) > maxMonitorValidatorsPerRequest {
+		httputil.HandleError(w, fmt.Sprintf("Too many validator public keys, at most %d are allowed", maxMonitorValidatorsPerRequest), http.StatusBadRequest)
+		return nil, false
+	}
+	st, err := s.HeadFetcher.HeadStateReadOnly(r.Context())
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	indices := make([]primitives.ValidatorIndex, 0, len(req.Pubkeys))
+	for _, raw := range req.Pubkeys {
+		pubkey, err := hexutil.Decode(raw)
+		if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+			httputil.HandleError(w, fmt.Sprintf("Invalid validator public key %s", raw), http.StatusBadRequest)
+			return nil, false
+		}
+		if idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey)); ok {
+			indices = append(indices, idx)
+		}
+	}
+	return indices, true
+}
+
+func decodeMonitorIndicesRequest(w http.ResponseWriter, r *http.Request) ([]primitives.ValidatorIndex, bool) {
+	var req structs.ValidatorMonitorIndicesRequest
+	if r.Body == http.NoBody {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return nil, false
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	if len(req.Indices) == 0 {
+		httputil.HandleError(w, "No validator indices submitted", http.StatusBadRequest)
+		return nil, false
+	}
+	if len(req.Indices) > maxMonitorValidatorsPerRequest {
+		httputil.HandleError(w, fmt.Sprintf("Too many validator indices, at most %d are allowed", maxMonitorValidatorsPerRequest), http.StatusBadRequest)
+		return nil, false
+	}
+	return parseMonitorIndices(w, req.Indices)
+}
+
+func parseMonitorIndices(w http.ResponseWriter, rawIndices []string) ([]primitives.ValidatorIndex, bool) {
+	indices := make([]primitives.ValidatorIndex, len(rawIndices))
+	for i, raw := range rawIndices {
+		idx, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Invalid validator index %s: %s", raw, err.Error()), http.StatusBadRequest)
+			return nil, false
+		}
+		indices[i] = primitives.ValidatorIndex(idx)
+	}
+	return indices, true
+}
@@ -0,0 +1,205 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockPerformanceTracker struct {
+	tracked map[primitives.ValidatorIndex]bool
+	history []*validator.EpochPerformance
+}
+
+func (m *mockPerformanceTracker) TrackedIndices() []primitives.ValidatorIndex {
+	indices := make([]primitives.ValidatorIndex, 0, len(m.tracked))
+	for idx := range m.tracked {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
+
+func (m *mockPerformanceTracker) PerformanceHistory(indices []primitives.ValidatorIndex) []*validator.EpochPerformance {
+	if len(indices) == 0 {
+		return m.history
+	}
+	history := make([]*validator.EpochPerformance, 0)
+	for _, p := range m.history {
+		for _, idx := range indices {
+			if p.ValidatorIndex == idx {
+				history = append(history, p)
+			}
+		}
+	}
+	return history
+}
+
+func (m *mockPerformanceTracker) TrackValidators(_ context.Context, indices []primitives.ValidatorIndex) error {
+	for _, idx := range indices {
+		if idx >= 100 {
+			return errors.New("validator index does not exist")
+		}
+	}
+	for _, idx := range indices {
+		m.tracked[idx] = true
+	}
+	return nil
+}
+
+func (m *mockPerformanceTracker) UntrackValidators(indices []primitives.ValidatorIndex) {
+	for _, idx := range indices {
+		delete(m.tracked, idx)
+	}
+}
+
+func TestServer_GetValidatorMonitorPerformance(t *testing.T) {
+	s := &Server{
+		ValidatorMonitor: &mockPerformanceTracker{
+			history: []*validator.EpochPerformance{
+				{ValidatorIndex: 1, Epoch: 3, AttestationIncluded: true, CorrectSource: true, CorrectTarget: true, CorrectHead: true, InclusionDistance: 1},
+				{ValidatorIndex: 1, Epoch: 4, ProposerDuties: 1},
+				{ValidatorIndex: 2, Epoch: 3},
+			},
+		},
+	}
+
+	t.Run("all tracked validators", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorMonitorPerformance(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorMonitorPerformanceResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, false, resp.Data[0].MissedHead)
+		assert.Equal(t, "1", resp.Data[1].MissedProposals)
+	})
+	t.Run("filtered", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor?indices=2", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorMonitorPerformance(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorMonitorPerformanceResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "2", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, true, resp.Data[0].MissedHead)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor?indices=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorMonitorPerformance(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("monitor not available", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		(&Server{}).GetValidatorMonitorPerformance(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+func TestServer_ValidatorMonitorIndices(t *testing.T) {
+	tracker := &mockPerformanceTracker{tracked: map[primitives.ValidatorIndex]bool{1: true}}
+	s := &Server{ValidatorMonitor: tracker}
+
+	indicesRequest := func(method string, indices ...string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(&structs.ValidatorMonitorIndicesRequest{Indices: indices})
+		require.NoError(t, err)
+		request := httptest.NewRequest(method, "http://example.com/prysm/v1/validators/monitor/indices", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		switch method {
+		case http.MethodPost:
+			s.AddValidatorMonitorIndices(writer, request)
+		case http.MethodDelete:
+			s.RemoveValidatorMonitorIndices(writer, request)
+		default:
+			s.GetValidatorMonitorIndices(writer, request)
+		}
+		return writer
+	}
+	trackedIndices := func() []string {
+		writer := indicesRequest(http.MethodGet)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ValidatorMonitorIndicesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp.Data
+	}
+
+	require.DeepEqual(t, []string{"1"}, trackedIndices())
+	require.Equal(t, http.StatusOK, indicesRequest(http.MethodPost, "2", "3").Code)
+	require.DeepEqual(t, []string{"1", "2", "3"}, trackedIndices())
+	require.Equal(t, http.StatusOK, indicesRequest(http.MethodDelete, "1", "3").Code)
+	require.DeepEqual(t, []string{"2"}, trackedIndices())
+
+	assert.Equal(t, http.StatusBadRequest, indicesRequest(http.MethodPost).Code)
+	assert.Equal(t, http.StatusBadRequest, indicesRequest(http.MethodPost, "foo").Code)
+	assert.Equal(t, http.StatusBadRequest, indicesRequest(http.MethodPost, "100").Code)
+	tooMany := make([]string, maxMonitorValidatorsPerRequest+1)
+	for i := range tooMany {
+		tooMany[i] = "2"
+	}
+	assert.Equal(t, http.StatusBadRequest, indicesRequest(http.MethodPost, tooMany...).Code)
+	require.DeepEqual(t, []string{"2"}, trackedIndices())
+}
+
+func TestServer_ValidatorMonitorPubkeys(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 8)
+	tracker := &mockPerformanceTracker{tracked: map[primitives.ValidatorIndex]bool{}}
+	s := &Server{ValidatorMonitor: tracker, HeadFetcher: &mock.ChainService{State: st}}
+
+	pubkeysRequest := func(method string, pubkeys ...string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(&structs.ValidatorMonitorPubkeysRequest{Pubkeys: pubkeys})
+		require.NoError(t, err)
+		request := httptest.NewRequest(method, "http://example.com/prysm/v1/validators/monitor/pubkeys", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		if method == http.MethodPost {
+			s.AddValidatorMonitorPubkeys(writer, request)
+		} else {
+			s.RemoveValidatorMonitorPubkeys(writer, request)
+		}
+		return writer
+	}
+	pubkey := func(idx primitives.ValidatorIndex) string {
+		pk := st.PubkeyAtIndex(idx)
+		return hexutil.Encode(pk[:])
+	}
+	unknown := hexutil.Encode(make([]byte, fieldparams.BLSPubkeyLength))
+
+	writer := pubkeysRequest(http.MethodPost, pubkey(2), pubkey(5), unknown)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.ValidatorMonitorIndicesResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.DeepEqual(t, []string{"2", "5"}, resp.Data)
+	require.DeepEqual(t, []primitives.ValidatorIndex{2, 5}, tracker.TrackedIndices())
+
+	require.Equal(t, http.StatusOK, pubkeysRequest(http.MethodDelete, pubkey(2), unknown).Code)
+	require.DeepEqual(t, []primitives.ValidatorIndex{5}, tracker.TrackedIndices())
+
+	assert.Equal(t, http.StatusBadRequest, pubkeysRequest(http.MethodPost).Code)
+	assert.Equal(t, http.StatusBadRequest, pubkeysRequest(http.MethodPost, "0x1234").Code)
+	assert.Equal(t, http.StatusBadRequest, pubkeysRequest(http.MethodDelete, "foo").Code)
+	require.DeepEqual(t, []primitives.ValidatorIndex{5}, tracker.TrackedIndices())
+}
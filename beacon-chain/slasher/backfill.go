@@ -0,0 +1,263 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// BackfillSource provides the historical blocks and attestations the slasher database is backfilled with.
+type BackfillSource interface {
+	// BlocksAndAttestations returns the headers of the blocks proposed during the epoch, including the blocks which
+	// are not canonical, and the attestations these blocks include.
+	BlocksAndAttestations(ctx context.Context, epoch primitives.Epoch) ([]*ethpb.SignedBeaconBlockHeader, []*ethpb.IndexedAttestation, error)
+}
+
+// BackfillResult sums up the data processed by a backfill and the slashings it found.
+type BackfillResult struct {
+	NumBlocks         int
+	NumAttestations   int
+	AttesterSlashings []*ethpb.AttesterSlashing
+	ProposerSlashings []*ethpb.ProposerSlashing
+}
+
+// Backfill runs the slashing detection over the blocks and attestations of the epochs from start to end, both
+// included, filling the slasher database as if the slasher had been running during these epochs. The database must
+// not hold data of epochs after the start epoch.
+//
+// The slashings found are saved in the database, but they are neither verified nor submitted to an operations pool,
+// as the slashable offenses are old and were likely already included in the chain.
+func Backfill(
+	ctx context.Context, database db.SlasherDatabase, source BackfillSource, startEpoch, endEpoch primitives.Epoch,
+) (*BackfillResult, error) {
+	if startEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is after end epoch %d", startEpoch, endEpoch)
+	}
+	highest, ok, err := database.HighestLastEpochWritten(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get highest epoch written")
+	}
+	if ok && highest > startEpoch {
+		return nil, errors.Errorf("slasher database holds data up to epoch %d, after start epoch %d", highest, startEpoch)
+	}
+	s := &Service{
+		params:                         DefaultParams(),
+		serviceCfg:                     &ServiceConfig{Database: database},
+		latestEpochUpdatedForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+	loadedValidatorChunks := make(map[uint64]bool)
+	result := &BackfillResult{}
+	var deferred []*slashertypes.IndexedAttestationWrapper
+
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		headers, atts, err := source.BlocksAndAttestations(ctx, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get blocks and attestations of epoch %d", epoch)
+		}
+		result.NumBlocks += len(headers)
+		result.NumAttestations += len(atts)
+
+		attWrappers := deferred
+		for _, att := range atts {
+			if !validateAttestationIntegrity(att) {
+				continue
+			}
+			dataRoot, err := att.Data.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get hash tree root of attestation")
+			}
+			attWrappers = append(attWrappers, &slashertypes.IndexedAttestationWrapper{IndexedAttestation: att, DataRoot: dataRoot})
+		}
+		valid, validInFuture, _ := s.filterAttestations(attWrappers, epoch)
+		deferred = validInFuture
+		if err := s.loadLatestEpochsUpdated(ctx, valid, loadedValidatorChunks); err != nil {
+			return nil, err
+		}
+		attSlashings, err := s.checkSlashableAttestations(ctx, epoch, valid)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not check slashable attestations of epoch %d", epoch)
+		}
+		s.recordAttesterSlashings(ctx, maps.Values(attSlashings))
+		result.AttesterSlashings = append(result.AttesterSlashings, maps.Values(attSlashings)...)
+
+		proposals := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(headers))
+		for _, header := range headers {
+			if !validateBlockHeaderIntegrity(header) {
+				continue
+			}
+			headerRoot, err := header.Header.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get hash tree root of signed block header")
+			}
+			proposals = append(proposals, &slashertypes.SignedBlockHeaderWrapper{SignedBeaconBlockHeader: header, HeaderRoot: headerRoot})
+		}
+		propSlashings, err := s.detectProposerSlashings(ctx, proposals)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect proposer slashings of epoch %d", epoch)
+		}
+		s.recordProposerSlashings(ctx, propSlashings)
+		result.ProposerSlashings = append(result.ProposerSlashings, propSlashings...)
+
+		log.WithFields(logrus.Fields{
+			"epoch":                epoch,
+			"numBlocks":            len(headers),
+			"numAttestations":      len(atts),
+			"numAttesterSlashings": len(attSlashings),
+			"numProposerSlashings": len(propSlashings),
+		}).Info("Backfilled epoch")
+	}
+
+	if err := database.SaveLastEpochWrittenForValidators(ctx, s.latestEpochUpdatedForValidator); err != nil {
+		return nil, errors.Wrap(err, "could not save last epoch written for validators")
+	}
+	return result, nil
+}
+
+// loadLatestEpochsUpdated reads from the database the latest epoch written for the validators of the chunks the
+// attestations belong to, the first time these chunks are met.
+func (s *Service) loadLatestEpochsUpdated(
+	ctx context.Context, atts []*slashertypes.IndexedAttestationWrapper, loaded map[uint64]bool,
+) error {
+	var indices []primitives.ValidatorIndex
+	for validatorChunkIndex := range s.groupByValidatorChunkIndex(atts) {
+		if loaded[validatorChunkIndex] {
+			continue
+		}
+		loaded[validatorChunkIndex] = true
+		indices = append(indices, s.params.ValidatorIndexesInChunk(validatorChunkIndex)...)
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+	epochsByValidator, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, indices)
+	if err != nil {
+		return errors.Wrap(err, "could not get last epoch written for validators")
+	}
+	for _, item := range epochsByValidator {
+		s.latestEpochUpdatedForValidator[item.ValidatorIndex] = item.Epoch
+	}
+	return nil
+}
+
+type targetKey struct {
+	root  [32]byte
+	epoch primitives.Epoch
+}
+
+// beaconDBSource provides the blocks of a beacon node database, indexing their attestations with the committees
+// computed from the target states of the attestations.
+type beaconDBSource struct {
+	beaconDB     db.ReadOnlyDatabase
+	stateGen     StateByRootFetcher
+	targetStates map[targetKey]state.ReadOnlyBeaconState
+}
+
+// NewBeaconDBSource provides the blocks saved in a beacon node database to a backfill. The target states of the
+// attestations are regenerated with the given state generator, so the database does not need to be an archive.
+func NewBeaconDBSource(beaconDB db.ReadOnlyDatabase, stateGen StateByRootFetcher) BackfillSource {
+	return &beaconDBSource{
+		beaconDB:     beaconDB,
+		stateGen:     stateGen,
+		targetStates: make(map[targetKey]state.ReadOnlyBeaconState),
+	}
+}
+
+// BlocksAndAttestations returns the headers of the blocks of the epoch saved in the beacon node database, and the
+// attestations these blocks include.
+func (b *beaconDBSource) BlocksAndAttestations(
+	ctx context.Context, epoch primitives.Epoch,
+) ([]*ethpb.SignedBeaconBlockHeader, []*ethpb.IndexedAttestation, error) {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch - 1
+	blks, _, err := b.beaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get blocks")
+	}
+
+	headers := make([]*ethpb.SignedBeaconBlockHeader, 0, len(blks))
+	var atts []*ethpb.IndexedAttestation
+	for _, blk := range blks {
+		header, err := blk.Header()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get block header")
+		}
+		headers = append(headers, header)
+		for _, att := range blk.Block().Body().Attestations() {
+			indexed, err := b.indexedAttestation(ctx, att)
+			if err != nil {
+				log.WithError(err).WithField("slot", att.Data.Slot).Warn("Could not index attestation included in block")
+				continue
+			}
+			atts = append(atts, indexed)
+		}
+	}
+	b.pruneTargetStates(epoch)
+	return headers, atts, nil
+}
+
+func (b *beaconDBSource) indexedAttestation(ctx context.Context, att *ethpb.Attestation) (*ethpb.IndexedAttestation, error) {
+	st, err := b.targetState(ctx, att.Data.Target)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon committee")
+	}
+	return attestation.ConvertToIndexed(ctx, att, committee)
+}
+
+// targetState returns the state of the target block advanced to the start of the target epoch, from which the
+// committees of the target epoch are computed.
+func (b *beaconDBSource) targetState(ctx context.Context, target *ethpb.Checkpoint) (state.ReadOnlyBeaconState, error) {
+	key := targetKey{root: bytesutil.ToBytes32(target.Root), epoch: target.Epoch}
+	if st, ok := b.targetStates[key]; ok {
+		return st, nil
+	}
+	st, err := b.stateGen.StateByRoot(ctx, key.root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of target block %#x", key.root)
+	}
+	epochStart, err := slots.EpochStart(target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() < epochStart {
+		st, err = transition.ProcessSlots(ctx, st, epochStart)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", epochStart)
+		}
+	}
+	b.targetStates[key] = st
+	return st, nil
+}
+
+// pruneTargetStates removes the target states of the epochs whose attestations can no longer be included in the
+// blocks of the next epochs.
+func (b *beaconDBSource) pruneTargetStates(epoch primitives.Epoch) {
+	for key := range b.targetStates {
+		if key.epoch+1 < epoch {
+			delete(b.targetStates, key)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type epochData struct {
+	headers []*ethpb.SignedBeaconBlockHeader
+	atts    []*ethpb.IndexedAttestation
+}
+
+type mockBackfillSource map[primitives.Epoch]epochData
+
+func (m mockBackfillSource) BlocksAndAttestations(
+	_ context.Context, epoch primitives.Epoch,
+) ([]*ethpb.SignedBeaconBlockHeader, []*ethpb.IndexedAttestation, error) {
+	data := m[epoch]
+	return data.headers, data.atts, nil
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	source := mockBackfillSource{
+		1: {
+			headers: []*ethpb.SignedBeaconBlockHeader{createProposalWrapper(t, 32, 1, []byte{1}).SignedBeaconBlockHeader},
+			atts: []*ethpb.IndexedAttestation{
+				createAttestationWrapperEmptySig(t, 0, 1, []uint64{1}, []byte{1}).IndexedAttestation,
+				createAttestationWrapperEmptySig(t, 1, 2, []uint64{2}, []byte{1}).IndexedAttestation,
+			},
+		},
+		3: {
+			// A block proposed again for slot 32, and a double vote of validator 1.
+			headers: []*ethpb.SignedBeaconBlockHeader{createProposalWrapper(t, 32, 1, []byte{2}).SignedBeaconBlockHeader},
+			atts: []*ethpb.IndexedAttestation{
+				createAttestationWrapperEmptySig(t, 0, 1, []uint64{1}, []byte{2}).IndexedAttestation,
+			},
+		},
+		4: {
+			// A vote of validator 2 surrounding its vote of epoch 1.
+			atts: []*ethpb.IndexedAttestation{
+				createAttestationWrapperEmptySig(t, 0, 4, []uint64{2}, []byte{1}).IndexedAttestation,
+			},
+		},
+	}
+
+	result, err := Backfill(ctx, slasherDB, source, 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.NumBlocks)
+	assert.Equal(t, 4, result.NumAttestations)
+	require.Equal(t, 2, len(result.AttesterSlashings))
+	require.Equal(t, 1, len(result.ProposerSlashings))
+
+	attSlashings, err := slasherDB.AttesterSlashings(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(attSlashings))
+	propSlashings, err := slasherDB.ProposerSlashings(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(propSlashings))
+	assert.Equal(t, primitives.Slot(32), propSlashings[0].Header_1.Header.Slot)
+
+	epochs, err := slasherDB.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(epochs))
+	assert.Equal(t, primitives.Epoch(4), epochs[0].Epoch)
+
+	// The database now holds data of epochs after the start epoch.
+	_, err = Backfill(ctx, slasherDB, source, 1, 4)
+	require.ErrorContains(t, "slasher database holds data up to epoch 4, after start epoch 1", err)
+
+	// The backfill can go on from the last epoch written.
+	_, err = Backfill(ctx, slasherDB, source, 4, 5)
+	require.NoError(t, err)
+
+	_, err = Backfill(ctx, slasherDB, source, 5, 4)
+	require.ErrorContains(t, "start epoch 5 is after end epoch 4", err)
+}
+
+func TestBeaconDBSource_BlocksAndAttestations(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+
+	genesisState, _ := util.DeterministicGenesisState(t, 64)
+	genesis := util.NewBeaconBlock()
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis.Block.StateRoot = stateRoot[:]
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, genesisRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	committee, err := helpers.BeaconCommitteeFromState(ctx, genesisState, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(1, true)
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits})
+	att.Data.Target.Root = genesisRoot[:]
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ParentRoot = genesisRoot[:]
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+	util.SaveBlock(t, ctx, beaconDB, blk)
+
+	source := NewBeaconDBSource(beaconDB, stategen.New(beaconDB, doublylinkedtree.New()))
+	headers, atts, err := source.BlocksAndAttestations(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(headers))
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, []uint64{uint64(committee[1])}, atts[0].AttestingIndices)
+
+	headers, atts, err = source.BlocksAndAttestations(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(headers))
+	assert.Equal(t, 0, len(atts))
+}
@@ -230,8 +230,6 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 		return nil, nil
 	}
 
-	surroundingVotesTotal.Inc()
-
 	slashing := &ethpb.AttesterSlashing{
 		Attestation_1: existingAttWrapper.IndexedAttestation,
 		Attestation_2: incomingAttWrapper.IndexedAttestation,
@@ -309,8 +307,6 @@ func (m *MaxSpanChunksSlice) CheckSlashable(
 		return nil, nil
 	}
 
-	surroundedVotesTotal.Inc()
-
 	slashing := &ethpb.AttesterSlashing{
 		Attestation_1: existingAttWrapper.IndexedAttestation,
 		Attestation_2: incomingAttWrapper.IndexedAttestation,
@@ -193,20 +193,7 @@ func (s *Service) checkDoubleVotes(
 			// This is a double vote.
 			doubleVotesTotal.Inc()
 
-			slashing := &ethpb.AttesterSlashing{
-				Attestation_1: existingAttWrapper.IndexedAttestation,
-				Attestation_2: incomingAttWrapper.IndexedAttestation,
-			}
-
-			// Ensure the attestation with the lower data root is the first attestation.
-			// It will be useful for comparing with other double votes.
-			if bytes.Compare(existingAttWrapper.DataRoot[:], incomingAttWrapper.DataRoot[:]) > 0 {
-				slashing = &ethpb.AttesterSlashing{
-					Attestation_1: incomingAttWrapper.IndexedAttestation,
-					Attestation_2: existingAttWrapper.IndexedAttestation,
-				}
-			}
-
+			slashing := doubleVoteSlashing(existingAttWrapper, incomingAttWrapper)
 			root, err := slashing.HashTreeRoot()
 			if err != nil {
 				return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
@@ -226,22 +213,7 @@ func (s *Service) checkDoubleVotes(
 	for _, doubleVote := range doubleVotes {
 		doubleVotesTotal.Inc()
 
-		wrapper_1 := doubleVote.Wrapper_1
-		wrapper_2 := doubleVote.Wrapper_2
-
-		slashing := &ethpb.AttesterSlashing{
-			Attestation_1: wrapper_1.IndexedAttestation,
-			Attestation_2: wrapper_2.IndexedAttestation,
-		}
-
-		// Ensure the attestation with the lower data root is the first attestation.
-		if bytes.Compare(wrapper_1.DataRoot[:], wrapper_2.DataRoot[:]) > 0 {
-			slashing = &ethpb.AttesterSlashing{
-				Attestation_1: wrapper_2.IndexedAttestation,
-				Attestation_2: wrapper_1.IndexedAttestation,
-			}
-		}
-
+		slashing := doubleVoteSlashing(doubleVote.Wrapper_1, doubleVote.Wrapper_2)
 		root, err := slashing.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
@@ -253,6 +225,18 @@ func (s *Service) checkDoubleVotes(
 	return slashings, nil
 }
 
+// doubleVoteSlashing builds the slashing of two attestations with the same target. The attestation with the lower
+// data root is the first attestation, which is useful for comparing double votes with each other.
+func doubleVoteSlashing(wrapper_1, wrapper_2 *slashertypes.IndexedAttestationWrapper) *ethpb.AttesterSlashing {
+	if bytes.Compare(wrapper_1.DataRoot[:], wrapper_2.DataRoot[:]) > 0 {
+		wrapper_1, wrapper_2 = wrapper_2, wrapper_1
+	}
+	return &ethpb.AttesterSlashing{
+		Attestation_1: wrapper_1.IndexedAttestation,
+		Attestation_2: wrapper_2.IndexedAttestation,
+	}
+}
+
 // updatedChunkByChunkIndex loads the chunks from the database for validators corresponding to
 // the `validatorChunkIndex`.
 // It then updates the chunks with the neutral element for corresponding validators from
@@ -528,6 +512,13 @@ func (s *Service) applyAttestationForValidator(
 		)
 	}
 	if slashing != nil {
+		// The metrics are not updated by the chunks, so that they can also be used for dry runs.
+		switch chunkKind {
+		case slashertypes.MinSpan:
+			surroundingVotesTotal.Inc()
+		case slashertypes.MaxSpan:
+			surroundedVotesTotal.Inc()
+		}
 		return slashing, nil
 	}
 
@@ -716,3 +707,64 @@ func (s *Service) saveChunksToDisk(
 	// Save the chunks to disk.
 	return s.serviceCfg.Database.SaveSlasherChunks(ctx, chunkKind, chunkKeys, chunks)
 }
+
+// IsSlashableAttestation checks, in dry-run mode, whether an indexed attestation is slashable with respect to the
+// attestations in the slasher database: double votes, then surrounding and surrounded votes. Neither the attestation
+// nor the updated spans are saved.
+func (s *Service) IsSlashableAttestation(
+	ctx context.Context, att *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if !validateAttestationIntegrity(att) {
+		return nil, ErrInvalidAttestation
+	}
+	dataRoot, err := att.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation data")
+	}
+	attWrapper := &slashertypes.IndexedAttestationWrapper{IndexedAttestation: att, DataRoot: dataRoot}
+
+	doubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, []*slashertypes.IndexedAttestationWrapper{attWrapper})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve potential double votes from disk")
+	}
+	slashings := map[[fieldparams.RootLength]byte]*ethpb.AttesterSlashing{}
+	for _, doubleVote := range doubleVotes {
+		slashing := doubleVoteSlashing(doubleVote.Wrapper_1, doubleVote.Wrapper_2)
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
+		}
+		slashings[root] = slashing
+	}
+	if len(slashings) > 0 {
+		return maps.Values(slashings), nil
+	}
+
+	chunkIndex := s.params.chunkIndex(att.Data.Source.Epoch)
+	for _, idx := range att.AttestingIndices {
+		validatorIndex := primitives.ValidatorIndex(idx)
+		validatorChunkIndex := s.params.validatorChunkIndex(validatorIndex)
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			chunk, err := s.getChunkFromDatabase(ctx, kind, validatorChunkIndex, chunkIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get %s chunk at index %d", kind, chunkIndex)
+			}
+			slashing, err := chunk.CheckSlashable(ctx, s.serviceCfg.Database, validatorIndex, attWrapper)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not check if attestation for validator index %d is slashable", validatorIndex)
+			}
+			if slashing == nil {
+				continue
+			}
+			root, err := slashing.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
+			}
+			slashings[root] = slashing
+		}
+	}
+	return maps.Values(slashings), nil
+}
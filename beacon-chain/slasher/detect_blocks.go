@@ -79,3 +79,29 @@ func proposalKey(proposal *slashertypes.SignedBlockHeaderWrapper) string {
 
 	return slotKey + ":" + proposerIndexKey
 }
+
+// IsSlashableBlock checks, in dry-run mode, whether a signed block header is a double proposal with respect to the
+// proposals in the slasher database. The proposal is not saved.
+func (s *Service) IsSlashableBlock(
+	ctx context.Context, header *ethpb.SignedBeaconBlockHeader,
+) (*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if header == nil || header.Header == nil {
+		return nil, ErrInvalidBlockHeader
+	}
+	headerRoot, err := header.Header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block header")
+	}
+	proposal := &slashertypes.SignedBlockHeaderWrapper{SignedBeaconBlockHeader: header, HeaderRoot: headerRoot}
+	slashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{proposal})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	if len(slashings) == 0 {
+		return nil, nil
+	}
+	return slashings[0], nil
+}
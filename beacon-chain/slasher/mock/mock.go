@@ -0,0 +1,69 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// MockSlashingChecker is a fake implementation of slasher.SlashingChecker.
+type MockSlashingChecker struct {
+	// AttSlashings are the detected attester slashings, filtered by the target epoch of their second attestation.
+	AttSlashings []*ethpb.AttesterSlashing
+	// PropSlashings are the detected proposer slashings, filtered by the epoch of their first header.
+	PropSlashings []*ethpb.ProposerSlashing
+	// SlashableAttSlashings are returned when checking any attestation.
+	SlashableAttSlashings []*ethpb.AttesterSlashing
+	// SlashableBlockSlashing is returned when checking any block header.
+	SlashableBlockSlashing *ethpb.ProposerSlashing
+	// Feed sends the detections to the subscribers.
+	Feed event.Feed
+	Err  error
+}
+
+// IsSlashableAttestation --
+func (m *MockSlashingChecker) IsSlashableAttestation(_ context.Context, _ *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error) {
+	return m.SlashableAttSlashings, m.Err
+}
+
+// IsSlashableBlock --
+func (m *MockSlashingChecker) IsSlashableBlock(_ context.Context, _ *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error) {
+	return m.SlashableBlockSlashing, m.Err
+}
+
+// AttesterSlashings --
+func (m *MockSlashingChecker) AttesterSlashings(_ context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.AttesterSlashing, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for _, s := range m.AttSlashings {
+		if e := s.Attestation_2.Data.Target.Epoch; e >= startEpoch && e <= endEpoch {
+			slashings = append(slashings, s)
+		}
+	}
+	return slashings, nil
+}
+
+// ProposerSlashings --
+func (m *MockSlashingChecker) ProposerSlashings(_ context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.ProposerSlashing, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	for _, s := range m.PropSlashings {
+		if e := slots.ToEpoch(s.Header_1.Header.Slot); e >= startEpoch && e <= endEpoch {
+			slashings = append(slashings, s)
+		}
+	}
+	return slashings, nil
+}
+
+// SubscribeDetections --
+func (m *MockSlashingChecker) SubscribeDetections(ch chan<- *slasher.Detection) event.Subscription {
+	return m.Feed.Subscribe(ch)
+}
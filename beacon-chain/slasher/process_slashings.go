@@ -8,6 +8,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"golang.org/x/exp/maps"
 )
 
 // Verifies attester slashings, logs them, and submits them to the slashing operations pool
@@ -56,6 +57,7 @@ func (s *Service) processAttesterSlashings(
 		processedSlashings[root] = slashing
 	}
 
+	s.recordAttesterSlashings(ctx, maps.Values(processedSlashings))
 	return processedSlashings, nil
 }
 
@@ -73,6 +75,7 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		return err
 	}
 
+	processedSlashings := make([]*ethpb.ProposerSlashing, 0, len(slashings))
 	for _, slashing := range slashings {
 		// Verify the signature of the first block.
 		if err := s.verifyBlockSignature(ctx, slashing.Header_1); err != nil {
@@ -97,8 +100,11 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
 		}
+
+		processedSlashings = append(processedSlashings, slashing)
 	}
 
+	s.recordProposerSlashings(ctx, processedSlashings)
 	return nil
 }
 
@@ -0,0 +1,106 @@
+package remote
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// chain serves the chain data the slasher needs from the beacon node API. The states are downloaded from the debug
+// endpoints of the beacon node, which is only done when starting and when verifying the slashings found.
+type chain struct {
+	client      *beacon.Client
+	genesisTime time.Time
+	timeout     time.Duration
+}
+
+// HeadSlot returns the current slot, which the beacon node head is expected to be at when it is synced.
+func (c *chain) HeadSlot() primitives.Slot {
+	return slots.CurrentSlot(uint64(c.genesisTime.Unix()))
+}
+
+// HeadState downloads the head state of the beacon node.
+func (c *chain) HeadState(ctx context.Context) (state.BeaconState, error) {
+	return c.state(ctx, beacon.IdHead)
+}
+
+// StateByRoot downloads the post state of the block with the given root.
+func (c *chain) StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	header, err := c.client.GetBlockHeader(ctx, beacon.IdFromRoot(blockRoot))
+	if err != nil {
+		return nil, err
+	}
+	if header.Header.Message == nil {
+		return nil, errors.Errorf("empty header for block %#x", blockRoot)
+	}
+	stateRoot, err := hexutil.Decode(header.Header.Message.StateRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode state root of block %#x", blockRoot)
+	}
+	return c.state(ctx, beacon.IdFromRoot(bytesutil.ToBytes32(stateRoot)))
+}
+
+// AttestationTargetState returns the state of the target block of an attestation advanced to the start of the
+// target epoch, as the beacon node does to verify attestations.
+func (c *chain) AttestationTargetState(ctx context.Context, target *ethpb.Checkpoint) (state.ReadOnlyBeaconState, error) {
+	st, err := c.StateByRoot(ctx, bytesutil.ToBytes32(target.Root))
+	if err != nil {
+		return nil, err
+	}
+	epochStart, err := slots.EpochStart(target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() < epochStart {
+		st, err = transition.ProcessSlots(ctx, st, epochStart)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", epochStart)
+		}
+	}
+	return st, nil
+}
+
+// Syncing returns true if the beacon node is syncing, or if its sync status cannot be retrieved.
+func (c *chain) Syncing() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	status, err := c.client.GetSyncStatus(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not get sync status of the beacon node")
+		return true
+	}
+	return status.IsSyncing
+}
+
+// InsertAttesterSlashing submits the attester slashing to the operations pool of the beacon node.
+func (c *chain) InsertAttesterSlashing(ctx context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.AttesterSlashing) error {
+	return c.client.SubmitAttesterSlashing(ctx, structs.AttesterSlashingFromConsensus(slashing))
+}
+
+// InsertProposerSlashing submits the proposer slashing to the operations pool of the beacon node.
+func (c *chain) InsertProposerSlashing(ctx context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing) error {
+	return c.client.SubmitProposerSlashing(ctx, structs.ProposerSlashingFromConsensus(slashing))
+}
+
+func (c *chain) state(ctx context.Context, id beacon.StateOrBlockId) (state.BeaconState, error) {
+	b, err := c.client.GetState(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	vu, err := detect.FromState(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not detect the fork of state %s", id)
+	}
+	return vu.UnmarshalBeaconState(b)
+}
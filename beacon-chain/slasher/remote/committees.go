@@ -0,0 +1,92 @@
+package remote
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// committeeEpochs is the number of epochs whose committees are kept. Attestations of the previous epoch are still
+// propagated and included in blocks, so the committees of the current and previous epochs are needed.
+const committeeEpochs = 3
+
+type committeeKey struct {
+	slot  primitives.Slot
+	index primitives.CommitteeIndex
+}
+
+// committees caches the beacon committees retrieved from the beacon node, to index the attestations it streams.
+// It is not safe for concurrent use.
+type committees struct {
+	client *beacon.Client
+	epochs map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex
+}
+
+func newCommittees(client *beacon.Client) *committees {
+	return &committees{
+		client: client,
+		epochs: make(map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex),
+	}
+}
+
+// committee returns the beacon committee of the given slot and index, retrieving the committees of its epoch from
+// the beacon node if they are not cached.
+func (c *committees) committee(ctx context.Context, slot primitives.Slot, index primitives.CommitteeIndex) ([]primitives.ValidatorIndex, error) {
+	epoch := slots.ToEpoch(slot)
+	byKey, ok := c.epochs[epoch]
+	if !ok {
+		var err error
+		byKey, err = c.fetch(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		c.epochs[epoch] = byKey
+		c.prune(epoch)
+	}
+	committee, ok := byKey[committeeKey{slot: slot, index: index}]
+	if !ok {
+		return nil, errors.Errorf("no committee %d at slot %d", index, slot)
+	}
+	return committee, nil
+}
+
+func (c *committees) fetch(ctx context.Context, epoch primitives.Epoch) (map[committeeKey][]primitives.ValidatorIndex, error) {
+	resp, err := c.client.GetCommittees(ctx, beacon.IdHead, epoch)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[committeeKey][]primitives.ValidatorIndex, len(resp))
+	for _, cm := range resp {
+		slot, err := strconv.ParseUint(cm.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse committee slot %s", cm.Slot)
+		}
+		index, err := strconv.ParseUint(cm.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse committee index %s", cm.Index)
+		}
+		validators := make([]primitives.ValidatorIndex, len(cm.Validators))
+		for i, v := range cm.Validators {
+			vi, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse validator index %s", v)
+			}
+			validators[i] = primitives.ValidatorIndex(vi)
+		}
+		byKey[committeeKey{slot: primitives.Slot(slot), index: primitives.CommitteeIndex(index)}] = validators
+	}
+	return byKey, nil
+}
+
+// prune removes the committees of the epochs too old to be needed, given the latest epoch retrieved.
+func (c *committees) prune(latest primitives.Epoch) {
+	for epoch := range c.epochs {
+		if epoch+committeeEpochs <= latest {
+			delete(c.epochs, epoch)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+/*
+Package remote runs the slasher apart from the beacon node, to detect slashable offenses without doubling the
+resources of the beacon nodes of an operator.
+
+The blocks and attestations are received from the event stream of a beacon node API, converted into the signed
+block headers and indexed attestations the slasher works with, and the slashings found are submitted back to the
+operations pool of the beacon node, which verifies and broadcasts them.
+*/
+package remote
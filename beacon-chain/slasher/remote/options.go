@@ -0,0 +1,31 @@
+package remote
+
+import "time"
+
+type Option func(s *Service) error
+
+// WithBeaconNodeHost sets the beacon node API the slasher follows the chain from and submits its slashings to.
+func WithBeaconNodeHost(host string) Option {
+	return func(s *Service) error {
+		s.cfg.beaconNodeHost = host
+		return nil
+	}
+}
+
+// WithDataDir sets the directory of the slasher database. It is compatible with the slasher data directory of a
+// beacon node, so that a slasher can be moved out of a beacon node without losing its history.
+func WithDataDir(dir string) Option {
+	return func(s *Service) error {
+		s.cfg.dataDir = dir
+		return nil
+	}
+}
+
+// WithRequestTimeout sets the timeout of requests made to the beacon node API. It does not apply to the event
+// stream, which is kept open.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.requestTimeout = timeout
+		return nil
+	}
+}
@@ -0,0 +1,302 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	apievent "github.com/prysmaticlabs/prysm/v5/api/client/event"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+)
+
+// eventsBuffer is the number of events of the beacon node kept while the previous ones are being processed.
+const eventsBuffer = 1000
+
+type config struct {
+	beaconNodeHost string
+	dataDir        string
+	requestTimeout time.Duration
+}
+
+// Service runs a slasher fed by the blocks and attestations streamed by a beacon node API, and submits the
+// slashings it finds to the operations pool of that beacon node.
+type Service struct {
+	cfg              *config
+	ctx              context.Context
+	cancel           context.CancelFunc
+	client           *beacon.Client
+	db               *slasherkv.Store
+	chain            *chain
+	clock            *startup.ClockSynchronizer
+	slasher          *slasher.Service
+	indexedAttsFeed  *event.Feed
+	blockHeadersFeed *event.Feed
+	committees       *committees
+}
+
+// NewService opens the slasher database and creates a slasher following the configured beacon node.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg: &config{
+			beaconNodeHost: "localhost:3500",
+			requestTimeout: 2 * time.Minute,
+		},
+		ctx:              ctx,
+		cancel:           cancel,
+		clock:            startup.NewClockSynchronizer(),
+		indexedAttsFeed:  new(event.Feed),
+		blockHeadersFeed: new(event.Feed),
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.dataDir == "" {
+		cancel()
+		return nil, errors.New("a data directory is required for the slasher database")
+	}
+	c, err := beacon.NewClient(s.cfg.beaconNodeHost, client.WithTimeout(s.cfg.requestTimeout))
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create beacon node client")
+	}
+	s.client = c
+	s.chain = &chain{client: c, timeout: s.cfg.requestTimeout}
+	s.committees = newCommittees(c)
+
+	dbPath := filepath.Join(s.cfg.dataDir, kv.BeaconNodeDbDirName)
+	log.WithField("databasePath", dbPath).Info("Checking DB")
+	d, err := slasherkv.NewKVStore(ctx, dbPath)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not open slasher database")
+	}
+	s.db = d
+
+	s.slasher, err = slasher.New(ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: s.indexedAttsFeed,
+		BeaconBlockHeadersFeed:  s.blockHeadersFeed,
+		Database:                d,
+		AttestationStateFetcher: s.chain,
+		StateGen:                s.chain,
+		SlashingPoolInserter:    s.chain,
+		HeadStateFetcher:        s.chain,
+		SyncChecker:             s.chain,
+		ClockWaiter:             s.clock,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start waits for the genesis of the chain, then starts the slasher and feeds it with the events of the beacon node.
+func (s *Service) Start() {
+	s.slasher.Start()
+	go s.run()
+}
+
+// Stop the slasher and close its database.
+func (s *Service) Stop() error {
+	s.cancel()
+	if err := s.slasher.Stop(); err != nil {
+		return err
+	}
+	return s.db.Close()
+}
+
+// Status of the slasher.
+func (s *Service) Status() error {
+	return s.slasher.Status()
+}
+
+// SlashingChecker returns the slasher, to serve the slashings it detected.
+func (s *Service) SlashingChecker() slasher.SlashingChecker {
+	return s.slasher
+}
+
+func (s *Service) run() {
+	if err := s.waitForGenesis(); err != nil {
+		log.WithError(err).Error("Could not get genesis of the beacon node")
+		return
+	}
+	s.followEvents()
+}
+
+// waitForGenesis retrieves the genesis of the chain from the beacon node, retrying until the beacon node answers,
+// and sets the clock of the slasher.
+func (s *Service) waitForGenesis() error {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		genesis, err := s.client.GetGenesis(s.ctx)
+		if err == nil {
+			return s.setClock(genesis)
+		}
+		log.WithError(err).Warn("Could not get genesis of the beacon node, retrying")
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+func (s *Service) setClock(genesis *structs.Genesis) error {
+	genesisTime, err := strconv.ParseUint(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "could not parse genesis time %s", genesis.GenesisTime)
+	}
+	root, err := hexutil.Decode(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode genesis validators root %s", genesis.GenesisValidatorsRoot)
+	}
+	s.chain.genesisTime = time.Unix(int64(genesisTime), 0)
+	return s.clock.SetClock(startup.NewClock(s.chain.genesisTime, bytesutil.ToBytes32(root)))
+}
+
+// followEvents subscribes to the block and attestation events of the beacon node, subscribing again a slot after
+// the stream is closed.
+func (s *Service) followEvents() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		// The events are streamed by a client without timeout, the stream being kept open.
+		stream, err := apievent.NewEventStream(s.ctx, &http.Client{}, s.client.NodeURL(), []string{apievent.EventBlock, apievent.EventAttestation})
+		if err != nil {
+			log.WithError(err).Error("Could not create event stream")
+			return
+		}
+		events := make(chan *apievent.Event, eventsBuffer)
+		done := make(chan struct{})
+		go func() {
+			stream.Subscribe(events)
+			close(done)
+		}()
+		s.receiveEvents(events, done)
+
+		select {
+		case <-ticker.C:
+			log.Info("Subscribing again to the events of the beacon node")
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// receiveEvents handles the events until the stream is closed.
+func (s *Service) receiveEvents(events <-chan *apievent.Event, done <-chan struct{}) {
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := s.handleEvent(s.ctx, e); err != nil {
+				log.WithError(err).WithField("event", e.EventType).Error("Could not handle event of the beacon node")
+			}
+		case <-done:
+			// Handle the events received before the stream was closed.
+			for len(events) > 0 {
+				if err := s.handleEvent(s.ctx, <-events); err != nil {
+					log.WithError(err).Error("Could not handle event of the beacon node")
+				}
+			}
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) handleEvent(ctx context.Context, e *apievent.Event) error {
+	switch e.EventType {
+	case apievent.EventBlock:
+		blockEvent := &structs.BlockEvent{}
+		if err := json.Unmarshal(e.Data, blockEvent); err != nil {
+			return errors.Wrap(err, "could not decode block event")
+		}
+		root, err := hexutil.Decode(blockEvent.Block)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode block root %s", blockEvent.Block)
+		}
+		return s.receiveBlock(ctx, bytesutil.ToBytes32(root))
+	case apievent.EventAttestation:
+		att := &structs.Attestation{}
+		if err := json.Unmarshal(e.Data, att); err != nil {
+			return errors.Wrap(err, "could not decode attestation event")
+		}
+		consensusAtt, err := att.ToConsensus()
+		if err != nil {
+			return errors.Wrap(err, "could not convert attestation event")
+		}
+		return s.receiveAttestation(ctx, consensusAtt)
+	case apievent.EventConnectionError, apievent.EventError:
+		return errors.Errorf("event stream error: %s", string(e.Data))
+	default:
+		return nil
+	}
+}
+
+// receiveBlock sends the header of the block to the slasher, as well as the attestations the block includes, which
+// the beacon node may not have received individually.
+func (s *Service) receiveBlock(ctx context.Context, root [32]byte) error {
+	b, err := s.client.GetBlock(ctx, beacon.IdFromRoot(root))
+	if err != nil {
+		return err
+	}
+	vu, err := detect.FromBlock(b)
+	if err != nil {
+		return errors.Wrapf(err, "could not detect the fork of block %#x", root)
+	}
+	blk, err := vu.UnmarshalBeaconBlock(b)
+	if err != nil {
+		return errors.Wrapf(err, "could not unmarshal block %#x", root)
+	}
+	header, err := blk.Header()
+	if err != nil {
+		return errors.Wrapf(err, "could not get header of block %#x", root)
+	}
+	s.blockHeadersFeed.Send(header)
+	for _, att := range blk.Block().Body().Attestations() {
+		if err := s.receiveAttestation(ctx, att); err != nil {
+			log.WithError(err).WithField("slot", att.Data.Slot).Debug("Could not index attestation included in block")
+		}
+	}
+	return nil
+}
+
+// receiveAttestation indexes the attestation with its beacon committee and sends it to the slasher.
+func (s *Service) receiveAttestation(ctx context.Context, att *ethpb.Attestation) error {
+	committee, err := s.committees.committee(ctx, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get committee of attestation")
+	}
+	indexed, err := attestation.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return errors.Wrap(err, "could not index attestation")
+	}
+	s.indexedAttsFeed.Send(indexed)
+	return nil
+}
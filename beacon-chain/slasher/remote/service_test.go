@@ -0,0 +1,221 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	apievent "github.com/prysmaticlabs/prysm/v5/api/client/event"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// beaconNode mocks the endpoints of the beacon node API the remote slasher uses.
+type beaconNode struct {
+	sync.Mutex
+	blocks             map[string][]byte
+	committeeRequests  map[string]int
+	attesterSlashings  []*structs.AttesterSlashing
+	proposerSlashings  []*structs.ProposerSlashing
+	committeeValidator []string
+}
+
+func newBeaconNode(t *testing.T) (*beaconNode, *beacon.Client) {
+	bn := &beaconNode{
+		blocks:             make(map[string][]byte),
+		committeeRequests:  make(map[string]int),
+		committeeValidator: []string{"5", "7", "9"},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(bn.serve))
+	t.Cleanup(srv.Close)
+	c, err := beacon.NewClient(srv.URL)
+	require.NoError(t, err)
+	return bn, c
+}
+
+func (bn *beaconNode) serve(w http.ResponseWriter, r *http.Request) {
+	bn.Lock()
+	defer bn.Unlock()
+	switch {
+	case r.URL.Path == "/eth/v1/beacon/states/head/committees":
+		epoch := r.URL.Query().Get("epoch")
+		bn.committeeRequests[epoch]++
+		var e primitives.Epoch
+		if _, err := fmt.Sscan(epoch, &e); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		start := uint64(e) * uint64(params.BeaconConfig().SlotsPerEpoch)
+		var data []*structs.Committee
+		for i := uint64(0); i < uint64(params.BeaconConfig().SlotsPerEpoch); i++ {
+			data = append(data, &structs.Committee{Index: "0", Slot: fmt.Sprintf("%d", start+i), Validators: bn.committeeValidator})
+		}
+		writeJSON(w, &structs.GetCommitteesResponse{Data: data})
+	case strings.HasPrefix(r.URL.Path, "/eth/v2/beacon/blocks/"):
+		b, ok := bn.blocks[strings.TrimPrefix(r.URL.Path, "/eth/v2/beacon/blocks/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Eth-Consensus-Version", "phase0")
+		_, _ = w.Write(b)
+	case r.URL.Path == "/eth/v1/beacon/pool/attester_slashings":
+		slashing := &structs.AttesterSlashing{}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, slashing); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		bn.attesterSlashings = append(bn.attesterSlashings, slashing)
+	case r.URL.Path == "/eth/v1/beacon/pool/proposer_slashings":
+		slashing := &structs.ProposerSlashing{}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, slashing); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		bn.proposerSlashings = append(bn.proposerSlashings, slashing)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func testAttestation(slot primitives.Slot) *ethpb.Attestation {
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}})
+	att.Data.Slot = slot
+	return att
+}
+
+func testService(t *testing.T) (*Service, *beaconNode) {
+	bn, c := newBeaconNode(t)
+	return &Service{
+		ctx:              context.Background(),
+		client:           c,
+		chain:            &chain{client: c},
+		committees:       newCommittees(c),
+		indexedAttsFeed:  new(event.Feed),
+		blockHeadersFeed: new(event.Feed),
+	}, bn
+}
+
+func TestCommittees(t *testing.T) {
+	bn, c := newBeaconNode(t)
+	cm := newCommittees(c)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	committee, err := cm.committee(ctx, 1, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.ValidatorIndex{5, 7, 9}, committee)
+	_, err = cm.committee(ctx, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, bn.committeeRequests["0"], "Committees of the epoch were not cached")
+
+	_, err = cm.committee(ctx, 1, 1)
+	require.ErrorContains(t, "no committee 1 at slot 1", err)
+
+	_, err = cm.committee(ctx, 3*slotsPerEpoch, 0)
+	require.NoError(t, err)
+	_, ok := cm.epochs[0]
+	assert.Equal(t, false, ok, "Committees of old epochs were not pruned")
+	_, ok = cm.epochs[3]
+	assert.Equal(t, true, ok)
+}
+
+func TestService_HandleAttestationEvent(t *testing.T) {
+	s, _ := testService(t)
+	ch := make(chan *ethpb.IndexedAttestation, 1)
+	sub := s.indexedAttsFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	data, err := json.Marshal(structs.AttFromConsensus(testAttestation(1)))
+	require.NoError(t, err)
+	require.NoError(t, s.handleEvent(context.Background(), &apievent.Event{EventType: apievent.EventAttestation, Data: data}))
+	indexed := <-ch
+	assert.DeepEqual(t, []uint64{5, 9}, indexed.AttestingIndices)
+	assert.Equal(t, primitives.Slot(1), indexed.Data.Slot)
+
+	err = s.handleEvent(context.Background(), &apievent.Event{EventType: apievent.EventAttestation, Data: []byte("{")})
+	require.ErrorContains(t, "could not decode attestation event", err)
+}
+
+func TestService_HandleBlockEvent(t *testing.T) {
+	s, bn := testService(t)
+	headersCh := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	headersSub := s.blockHeadersFeed.Subscribe(headersCh)
+	defer headersSub.Unsubscribe()
+	attsCh := make(chan *ethpb.IndexedAttestation, 1)
+	attsSub := s.indexedAttsFeed.Subscribe(attsCh)
+	defer attsSub.Unsubscribe()
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 2
+	b.Block.ProposerIndex = 7
+	b.Block.Body.Attestations = []*ethpb.Attestation{testAttestation(1)}
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	bn.blocks[fmt.Sprintf("%#x", root)], err = b.MarshalSSZ()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(&structs.BlockEvent{Slot: "2", Block: fmt.Sprintf("%#x", root)})
+	require.NoError(t, err)
+	require.NoError(t, s.handleEvent(context.Background(), &apievent.Event{EventType: apievent.EventBlock, Data: data}))
+	header := <-headersCh
+	assert.Equal(t, primitives.Slot(2), header.Header.Slot)
+	assert.Equal(t, primitives.ValidatorIndex(7), header.Header.ProposerIndex)
+	headerRoot, err := header.Header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, headerRoot)
+	indexed := <-attsCh
+	assert.DeepEqual(t, []uint64{5, 9}, indexed.AttestingIndices)
+
+	data, err = json.Marshal(&structs.BlockEvent{Slot: "3", Block: fmt.Sprintf("%#x", [32]byte{1})})
+	require.NoError(t, err)
+	err = s.handleEvent(context.Background(), &apievent.Event{EventType: apievent.EventBlock, Data: data})
+	require.ErrorContains(t, "error requesting", err)
+}
+
+func TestChain_InsertSlashings(t *testing.T) {
+	s, bn := testService(t)
+	ctx := context.Background()
+
+	att1 := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	att2 := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	att2.Data.BeaconBlockRoot[0] = 1
+	require.NoError(t, s.chain.InsertAttesterSlashing(ctx, nil, &ethpb.AttesterSlashing{Attestation_1: att1, Attestation_2: att2}))
+	require.Equal(t, 1, len(bn.attesterSlashings))
+	assert.DeepEqual(t, []string{"1"}, bn.attesterSlashings[0].Attestation1.AttestingIndices)
+
+	h1 := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{})
+	h1.Header.Slot = 4
+	h2 := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{})
+	h2.Header.Slot = 4
+	h2.Header.BodyRoot[0] = 1
+	require.NoError(t, s.chain.InsertProposerSlashing(ctx, nil, &ethpb.ProposerSlashing{Header_1: h1, Header_2: h2}))
+	require.Equal(t, 1, len(bn.proposerSlashings))
+	assert.Equal(t, "4", bn.proposerSlashings[0].SignedHeader2.Message.Slot)
+}
+
+func TestNewService_RequiresDataDir(t *testing.T) {
+	_, err := NewService(context.Background(), WithBeaconNodeHost("localhost:3500"))
+	require.ErrorContains(t, "a data directory is required", err)
+}
@@ -0,0 +1,74 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+var (
+	// ErrInvalidAttestation is returned when an attestation is malformed and cannot be checked for slashings.
+	ErrInvalidAttestation = errors.New("invalid indexed attestation")
+	// ErrInvalidBlockHeader is returned when a block header is malformed and cannot be checked for slashings.
+	ErrInvalidBlockHeader = errors.New("invalid signed block header")
+)
+
+// SlashingChecker defines the queries the slasher answers about the slashings it detected and about the
+// slashings incoming attestations and blocks would cause.
+type SlashingChecker interface {
+	IsSlashableAttestation(ctx context.Context, att *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error)
+	IsSlashableBlock(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
+	AttesterSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.AttesterSlashing, error)
+	ProposerSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.ProposerSlashing, error)
+	SubscribeDetections(ch chan<- *Detection) event.Subscription
+}
+
+// Detection is a slashing detected by the slasher. Only one of the slashings is set.
+type Detection struct {
+	AttesterSlashing *ethpb.AttesterSlashing
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// AttesterSlashings returns the attester slashings detected between the start and end epochs, both included.
+func (s *Service) AttesterSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.AttesterSlashing, error) {
+	return s.serviceCfg.Database.AttesterSlashings(ctx, startEpoch, endEpoch)
+}
+
+// ProposerSlashings returns the proposer slashings detected between the start and end epochs, both included.
+func (s *Service) ProposerSlashings(ctx context.Context, startEpoch, endEpoch primitives.Epoch) ([]*ethpb.ProposerSlashing, error) {
+	return s.serviceCfg.Database.ProposerSlashings(ctx, startEpoch, endEpoch)
+}
+
+// SubscribeDetections sends the slashings detected from now on to the channel.
+func (s *Service) SubscribeDetections(ch chan<- *Detection) event.Subscription {
+	return s.detectionsFeed.Subscribe(ch)
+}
+
+// recordAttesterSlashings saves the detected attester slashings and notifies the subscribers.
+func (s *Service) recordAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	if err := s.serviceCfg.Database.SaveAttesterSlashings(ctx, slashings); err != nil {
+		log.WithError(err).Error("Could not save attester slashings")
+	}
+	for _, slashing := range slashings {
+		s.detectionsFeed.Send(&Detection{AttesterSlashing: slashing})
+	}
+}
+
+// recordProposerSlashings saves the detected proposer slashings and notifies the subscribers.
+func (s *Service) recordProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	if err := s.serviceCfg.Database.SaveProposerSlashings(ctx, slashings); err != nil {
+		log.WithError(err).Error("Could not save proposer slashings")
+	}
+	for _, slashing := range slashings {
+		s.detectionsFeed.Send(&Detection{ProposerSlashing: slashing})
+	}
+}
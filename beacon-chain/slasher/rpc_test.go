@@ -0,0 +1,140 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_IsSlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	srv, err := New(ctx, &ServiceConfig{
+		Database:      slasherDB,
+		StateNotifier: &mock.MockStateNotifier{},
+		ClockWaiter:   startup.NewClockSynchronizer(),
+	})
+	require.NoError(t, err)
+
+	// The slasher saw an attestation (source 1, target 2) of validator 0.
+	existing := createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{1})
+	_, err = srv.checkSlashableAttestations(ctx, 3, []*slashertypes.IndexedAttestationWrapper{existing})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		att       *slashertypes.IndexedAttestationWrapper
+		slashable bool
+	}{
+		{name: "same attestation", att: existing},
+		{name: "double vote", att: createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{2}), slashable: true},
+		{name: "surrounding vote", att: createAttestationWrapperEmptySig(t, 0, 3, []uint64{0}, []byte{1}), slashable: true},
+		{name: "next attestation", att: createAttestationWrapperEmptySig(t, 2, 3, []uint64{0}, []byte{1})},
+		{name: "other validator", att: createAttestationWrapperEmptySig(t, 0, 3, []uint64{1}, []byte{1})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashings, err := srv.IsSlashableAttestation(ctx, tt.att.IndexedAttestation)
+			require.NoError(t, err)
+			if !tt.slashable {
+				require.Equal(t, 0, len(slashings))
+				return
+			}
+			require.Equal(t, 1, len(slashings))
+			assert.DeepSSZEqual(t, doubleVoteSlashing(existing, tt.att), slashings[0])
+		})
+	}
+
+	// The checked attestations were not recorded.
+	record, err := slasherDB.AttestationRecordForValidator(ctx, 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, true, record == nil)
+
+	_, err = srv.IsSlashableAttestation(ctx, createAttestationWrapperEmptySig(t, 3, 2, []uint64{0}, nil).IndexedAttestation)
+	require.ErrorIs(t, err, ErrInvalidAttestation)
+}
+
+func TestService_IsSlashableBlock(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	srv, err := New(ctx, &ServiceConfig{
+		Database:      slasherDB,
+		StateNotifier: &mock.MockStateNotifier{},
+		ClockWaiter:   startup.NewClockSynchronizer(),
+	})
+	require.NoError(t, err)
+
+	existing := createProposalWrapper(t, 4, 1, []byte{1})
+	require.NoError(t, slasherDB.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{existing}))
+
+	slashing, err := srv.IsSlashableBlock(ctx, createProposalWrapper(t, 4, 1, []byte{2}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepSSZEqual(t, existing.SignedBeaconBlockHeader, slashing.Header_1)
+
+	slashing, err = srv.IsSlashableBlock(ctx, existing.SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// The checked proposal was not recorded.
+	other := createProposalWrapper(t, 5, 1, []byte{2})
+	_, err = srv.IsSlashableBlock(ctx, other.SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	record, err := slasherDB.BlockProposalForValidator(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, true, record == nil)
+
+	_, err = srv.IsSlashableBlock(ctx, &ethpb.SignedBeaconBlockHeader{})
+	require.ErrorIs(t, err, ErrInvalidBlockHeader)
+}
+
+func TestService_RecordSlashings(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	srv, err := New(ctx, &ServiceConfig{
+		Database:      slasherDB,
+		StateNotifier: &mock.MockStateNotifier{},
+		ClockWaiter:   startup.NewClockSynchronizer(),
+	})
+	require.NoError(t, err)
+
+	detections := make(chan *Detection, 2)
+	sub := srv.SubscribeDetections(detections)
+	defer sub.Unsubscribe()
+
+	attesterSlashing := doubleVoteSlashing(
+		createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{1}),
+		createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{2}),
+	)
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 4, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 4, 1, []byte{2}).SignedBeaconBlockHeader,
+	}
+	srv.recordAttesterSlashings(ctx, []*ethpb.AttesterSlashing{attesterSlashing})
+	srv.recordProposerSlashings(ctx, []*ethpb.ProposerSlashing{proposerSlashing})
+
+	d := <-detections
+	assert.DeepSSZEqual(t, attesterSlashing, d.AttesterSlashing)
+	assert.Equal(t, true, d.ProposerSlashing == nil)
+	d = <-detections
+	assert.DeepSSZEqual(t, proposerSlashing, d.ProposerSlashing)
+
+	attesterSlashings, err := srv.AttesterSlashings(ctx, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(attesterSlashings))
+	assert.DeepSSZEqual(t, attesterSlashing, attesterSlashings[0])
+	attesterSlashings, err = srv.AttesterSlashings(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(attesterSlashings))
+	proposerSlashings, err := srv.ProposerSlashings(ctx, 0, primitives.Epoch(0))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposerSlashings))
+}
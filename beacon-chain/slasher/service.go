@@ -15,8 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
-	beaconChainSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -36,13 +35,29 @@ type ServiceConfig struct {
 	Database                db.SlasherDatabase
 	StateNotifier           statefeed.Notifier
 	AttestationStateFetcher blockchain.AttestationStateFetcher
-	StateGen                stategen.StateManager
+	StateGen                StateByRootFetcher
 	SlashingPoolInserter    slashings.PoolInserter
-	HeadStateFetcher        blockchain.HeadFetcher
-	SyncChecker             beaconChainSync.Checker
+	HeadStateFetcher        HeadStateFetcher
+	SyncChecker             SyncChecker
 	ClockWaiter             startup.ClockWaiter
 }
 
+// HeadStateFetcher is the part of the chain the slasher reads the head from.
+type HeadStateFetcher interface {
+	HeadSlot() primitives.Slot
+	HeadState(ctx context.Context) (state.BeaconState, error)
+}
+
+// StateByRootFetcher retrieves the post state of a block, to verify the signatures of slashable blocks.
+type StateByRootFetcher interface {
+	StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// SyncChecker tells whether the beacon node is syncing, in which case the slasher waits for it.
+type SyncChecker interface {
+	Syncing() bool
+}
+
 // Service defining a slasher implementation as part of
 // the beacon node, able to detect eth2 slashable offenses.
 type Service struct {
@@ -59,6 +74,7 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochUpdatedForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	detectionsFeed                 event.Feed
 	wg                             sync.WaitGroup
 }
 
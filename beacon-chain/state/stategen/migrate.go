@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -37,6 +38,13 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		return nil
 	}
 
+	// When the state diff storage is enabled, its points replace the archived points.
+	slotsPerArchivedPoint := s.slotsPerArchivedPoint
+	diffInterval := s.beaconDB.StateDiffInterval()
+	if diffInterval > 0 {
+		slotsPerArchivedPoint = diffInterval
+	}
+
 	// Start at previous finalized slot, stop at current finalized slot (it will be handled in the next migration).
 	// If the slot is on archived point, save the state of that slot to the DB.
 	for slot := oldFSlot; slot < fSlot; slot++ {
@@ -44,7 +52,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			return ctx.Err()
 		}
 
-		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
+		if slot%slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
 				return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
@@ -81,6 +89,13 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				}
 			}
 
+			if diffInterval > 0 {
+				if err := s.saveStateDiff(ctx, slot, aRoot, aState); err != nil {
+					return err
+				}
+				continue
+			}
+
 			if s.beaconDB.HasState(ctx, aRoot) {
 				// If you are migrating a state and its already part of the hot state cache saved to the db,
 				// you can just remove it from the hot state cache as it becomes redundant.
@@ -120,3 +135,25 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	return nil
 }
+
+// saveStateDiff saves the state of the block root at a point of the state diff storage. The state is regenerated
+// when it was not found in the epoch boundary cache.
+func (s *State) saveStateDiff(ctx context.Context, slot primitives.Slot, aRoot [32]byte, aState state.BeaconState) error {
+	if aState == nil {
+		var err error
+		aState, err = s.StateByRoot(ctx, aRoot)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, slot, aState, aRoot); err != nil {
+		return err
+	}
+	log.WithFields(
+		logrus.Fields{
+			"slot":      slot,
+			"stateSlot": aState.Slot(),
+			"root":      hex.EncodeToString(bytesutil.Trunc(aRoot[:])),
+		}).Info("Saved state diff in DB")
+	return nil
+}
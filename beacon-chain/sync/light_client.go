@@ -0,0 +1,182 @@
+package sync
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientCache holds the latest locally computed light client updates, along with the headers of
+// the updates already forwarded over gossip.
+type lightClientCache struct {
+	lock                           sync.RWMutex
+	finalityUpdate                 *ethpbv2.LightClientFinalityUpdate
+	optimisticUpdate               *ethpbv2.LightClientOptimisticUpdate
+	forwardedFinalitySlot          *primitives.Slot
+	forwardedFinalitySupermajority bool
+	forwardedOptimisticSlot        *primitives.Slot
+}
+
+// lightClientUpdatesRoutine keeps track of the latest light client finality and optimistic updates
+// computed by the blockchain service, so that they can be served to peers and used to validate the
+// updates received over gossip, and publishes them to the network.
+func (s *Service) lightClientUpdatesRoutine() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	for {
+		select {
+		case e := <-stateChannel:
+			switch e.Type {
+			case statefeed.LightClientFinalityUpdate:
+				data, ok := e.Data.(*ethpbv2.LightClientFinalityUpdateWithVersion)
+				if !ok || data.Data == nil {
+					log.Errorf("Received light client finality update event of unexpected type %T", e.Data)
+					continue
+				}
+				s.setLightClientFinalityUpdate(data.Data)
+				go s.publishLightClientUpdate(data.Data, data.Data.SignatureSlot)
+			case statefeed.LightClientOptimisticUpdate:
+				data, ok := e.Data.(*ethpbv2.LightClientOptimisticUpdateWithVersion)
+				if !ok || data.Data == nil {
+					log.Errorf("Received light client optimistic update event of unexpected type %T", e.Data)
+					continue
+				}
+				s.setLightClientOptimisticUpdate(data.Data)
+				go s.publishLightClientUpdate(data.Data, data.Data.SignatureSlot)
+			}
+		case <-s.ctx.Done():
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// publishLightClientUpdate broadcasts a locally computed light client update once the block at its
+// signature slot had enough time to propagate, as peers ignore updates received before that.
+func (s *Service) publishLightClientUpdate(update proto.Message, signatureSlot primitives.Slot) {
+	if s.cfg.initialSync.Syncing() {
+		return
+	}
+	select {
+	case <-time.After(time.Until(s.lightClientUpdateDue(signatureSlot))):
+	case <-s.ctx.Done():
+		return
+	}
+	switch u := update.(type) {
+	case *ethpbv2.LightClientFinalityUpdate:
+		if !s.markLightClientFinalityUpdateForwarded(u) {
+			return
+		}
+	case *ethpbv2.LightClientOptimisticUpdate:
+		if !s.markLightClientOptimisticUpdateForwarded(u) {
+			return
+		}
+	}
+	if err := s.cfg.p2p.Broadcast(s.ctx, update); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client update")
+	}
+}
+
+// lightClientUpdateDue returns the time from which a light client update signed at the given slot
+// may be propagated, which is one third into the signature slot.
+func (s *Service) lightClientUpdateDue(signatureSlot primitives.Slot) time.Time {
+	return s.cfg.clock.SlotStart(signatureSlot).Add(slots.DivideSlotBy(int64(params.BeaconConfig().IntervalsPerSlot)))
+}
+
+func (s *Service) setLightClientFinalityUpdate(update *ethpbv2.LightClientFinalityUpdate) {
+	s.lightClient.lock.Lock()
+	defer s.lightClient.lock.Unlock()
+	s.lightClient.finalityUpdate = update
+}
+
+func (s *Service) setLightClientOptimisticUpdate(update *ethpbv2.LightClientOptimisticUpdate) {
+	s.lightClient.lock.Lock()
+	defer s.lightClient.lock.Unlock()
+	s.lightClient.optimisticUpdate = update
+}
+
+func (s *Service) latestLightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdate {
+	s.lightClient.lock.RLock()
+	defer s.lightClient.lock.RUnlock()
+	return s.lightClient.finalityUpdate
+}
+
+func (s *Service) latestLightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate {
+	s.lightClient.lock.RLock()
+	defer s.lightClient.lock.RUnlock()
+	return s.lightClient.optimisticUpdate
+}
+
+// markLightClientFinalityUpdateForwarded records the given finality update as forwarded, and reports
+// whether it was worth forwarding: its finalized header must be newer than the one of all previously
+// forwarded updates, or be the same but with a sync committee supermajority the previous one lacked.
+func (s *Service) markLightClientFinalityUpdateForwarded(update *ethpbv2.LightClientFinalityUpdate) bool {
+	if update.FinalizedHeader == nil {
+		return false
+	}
+	slot := update.FinalizedHeader.Slot
+	supermajority := hasSyncCommitteeSupermajority(update.SyncAggregate)
+
+	s.lightClient.lock.Lock()
+	defer s.lightClient.lock.Unlock()
+	if s.lightClient.forwardedFinalitySlot != nil {
+		forwarded := *s.lightClient.forwardedFinalitySlot
+		if slot < forwarded || (slot == forwarded && (!supermajority || s.lightClient.forwardedFinalitySupermajority)) {
+			return false
+		}
+	}
+	s.lightClient.forwardedFinalitySlot = &slot
+	s.lightClient.forwardedFinalitySupermajority = supermajority
+	return true
+}
+
+// markLightClientOptimisticUpdateForwarded records the given optimistic update as forwarded, and reports
+// whether its attested header is newer than the one of all previously forwarded updates.
+func (s *Service) markLightClientOptimisticUpdateForwarded(update *ethpbv2.LightClientOptimisticUpdate) bool {
+	if update.AttestedHeader == nil {
+		return false
+	}
+	slot := update.AttestedHeader.Slot
+
+	s.lightClient.lock.Lock()
+	defer s.lightClient.lock.Unlock()
+	if s.lightClient.forwardedOptimisticSlot != nil && slot <= *s.lightClient.forwardedOptimisticSlot {
+		return false
+	}
+	s.lightClient.forwardedOptimisticSlot = &slot
+	return true
+}
+
+func hasSyncCommitteeSupermajority(aggregate *ethpbv1.SyncAggregate) bool {
+	if aggregate == nil {
+		return false
+	}
+	return aggregate.SyncCommitteeBits.Count()*3 > params.BeaconConfig().SyncCommitteeSize*2
+}
+
+// sszEqual reports whether both objects have the same SSZ encoding. Comparing encodings rather than
+// protobuf messages makes empty and zero-filled fields, which have the same meaning, compare equal.
+func sszEqual(a, b interface{ MarshalSSZ() ([]byte, error) }) bool {
+	encA, err := a.MarshalSSZ()
+	if err != nil {
+		return false
+	}
+	encB, err := b.MarshalSSZ()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(encA, encB)
+}
@@ -59,6 +59,7 @@ func (s *Service) disconnectBadPeer(ctx context.Context, id peer.ID) {
 	if !s.cfg.p2p.Peers().IsBad(id) {
 		return
 	}
+	s.logBadPeer(id)
 	err := s.cfg.p2p.Peers().Scorers().ValidationError(id)
 	goodbyeCode := p2ptypes.ErrToGoodbyeCode(err)
 	if err == nil {
@@ -69,6 +70,15 @@ func (s *Service) disconnectBadPeer(ctx context.Context, id peer.ID) {
 	}
 }
 
+// logBadPeer explains with the score breakdown of the peer why it is disconnected for being bad.
+func (s *Service) logBadPeer(id peer.ID) {
+	logger := log.WithField("peer", id)
+	if b, err := s.cfg.p2p.Peers().ScoreBreakdown(id); err == nil {
+		logger = logger.WithFields(b.LogFields())
+	}
+	logger.Debug("Disconnecting bad peer")
+}
+
 // A custom goodbye method that is used by our connection handler, in the
 // event we receive bad peers.
 func (s *Service) sendGoodbye(ctx context.Context, id peer.ID) error {
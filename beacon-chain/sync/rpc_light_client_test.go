@@ -0,0 +1,276 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func testLightClientHeader(slot primitives.Slot) *ethpbv1.BeaconBlockHeader {
+	return &ethpbv1.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: 1,
+		ParentRoot:    bytesutil.PadTo([]byte("parent"), fieldparams.RootLength),
+		StateRoot:     bytesutil.PadTo([]byte("state"), fieldparams.RootLength),
+		BodyRoot:      bytesutil.PadTo([]byte("body"), fieldparams.RootLength),
+	}
+}
+
+func testLightClientSyncAggregate(participants int) *ethpbv1.SyncAggregate {
+	bits := make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength)
+	for i := 0; i < participants; i++ {
+		bits[i/8] |= 1 << (i % 8)
+	}
+	return &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+func testLightClientSyncCommittee() *ethpbv2.SyncCommittee {
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpbv2.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: bytesutil.PadTo([]byte("agg"), fieldparams.BLSPubkeyLength),
+	}
+}
+
+func testLightClientBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = bytesutil.PadTo([]byte{byte(i + 1)}, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func setupLightClientRPCTest(t *testing.T, topic string) (*Service, *p2ptest.TestP2P, *p2ptest.TestP2P, protocol.ID) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	r := &Service{
+		cfg: &config{
+			beaconDB: db.SetupDB(t),
+			p2p:      p1,
+			clock:    startup.NewClock(time.Now(), [32]byte{'A'}),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	pcl := protocol.ID(topic)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10, 10, time.Second, false)
+	return r, p1, p2, pcl
+}
+
+// readLightClientChunk reads a successful response chunk from the stream, checking its context bytes
+// against the fork digest of the given slot.
+func readLightClientChunk(t *testing.T, r *Service, stream network.Stream, slot primitives.Slot, obj interface {
+	UnmarshalSSZ([]byte) error
+}) {
+	expectSuccess(t, stream)
+	ctxBytes, err := readContextFromStream(stream)
+	require.NoError(t, err)
+	valRoot := r.cfg.clock.GenesisValidatorsRoot()
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), valRoot[:])
+	require.NoError(t, err)
+	assert.DeepEqual(t, digest[:], ctxBytes)
+	require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, obj))
+}
+
+func TestLightClientBootstrapRPCHandler(t *testing.T) {
+	r, p1, p2, pcl := setupLightClientRPCTest(t, p2p.RPCLightClientBootstrapTopicV1)
+	ctx := context.Background()
+
+	root := [32]byte{'r', 'o', 'o', 't'}
+	bootstrap := &ethpbv2.LightClientBootstrap{
+		Header:                     testLightClientHeader(64),
+		CurrentSyncCommittee:       testLightClientSyncCommittee(),
+		CurrentSyncCommitteeBranch: testLightClientBranch(fieldparams.CurrentSyncCommitteeBranchDepth),
+	}
+	require.NoError(t, r.cfg.beaconDB.SaveLightClientBootstrap(ctx, root, version.Altair, bootstrap))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		received := &ethpbv2.LightClientBootstrap{}
+		readLightClientChunk(t, r, stream, bootstrap.Header.Slot, received)
+		assert.DeepEqual(t, bootstrap, received)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.LightClientBootstrapReq(root)
+	require.NoError(t, r.lightClientBootstrapRPCHandler(ctx, &req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientBootstrapRPCHandler_NotFound(t *testing.T) {
+	r, p1, p2, pcl := setupLightClientRPCTest(t, p2p.RPCLightClientBootstrapTopicV1)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.LightClientBootstrapReq{'r', 'o', 'o', 't'}
+	require.ErrorIs(t, r.lightClientBootstrapRPCHandler(ctx, &req, stream), p2ptypes.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	r, p1, p2, pcl := setupLightClientRPCTest(t, p2p.RPCLightClientUpdatesByRangeTopicV1)
+	ctx := context.Background()
+
+	// Periods 2 and 3 are available, period 5 is not served because period 4 is missing.
+	updates := make(map[uint64]*ethpbv2.LightClientUpdate)
+	for _, period := range []uint64{2, 3, 5} {
+		updates[period] = &ethpbv2.LightClientUpdate{
+			AttestedHeader:          testLightClientHeader(primitives.Slot(period * 100)),
+			NextSyncCommittee:       testLightClientSyncCommittee(),
+			NextSyncCommitteeBranch: testLightClientBranch(fieldparams.NextSyncCommitteeBranchDepth),
+			FinalizedHeader:         testLightClientHeader(primitives.Slot(period*100 - 10)),
+			FinalityBranch:          testLightClientBranch(fieldparams.FinalityBranchDepth),
+			SyncAggregate:           testLightClientSyncAggregate(400),
+			SignatureSlot:           primitives.Slot(period*100 + 1),
+		}
+		require.NoError(t, r.cfg.beaconDB.SaveLightClientUpdate(ctx, period, version.Altair, updates[period]))
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, period := range []uint64{2, 3} {
+			received := &ethpbv2.LightClientUpdate{}
+			readLightClientChunk(t, r, stream, updates[period].AttestedHeader.Slot, received)
+			assert.DeepEqual(t, updates[period], received)
+		}
+		_, _, err := ReadStatusCode(stream, r.cfg.p2p.Encoding())
+		require.ErrorContains(t, "EOF", err)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(ctx, &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 2, Count: 10}, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_InvalidCount(t *testing.T) {
+	r, p1, p2, pcl := setupLightClientRPCTest(t, p2p.RPCLightClientUpdatesByRangeTopicV1)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, p2ptypes.ErrInvalidRequest.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	err = r.lightClientUpdatesByRangeRPCHandler(ctx, &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 2}, stream)
+	require.ErrorIs(t, err, p2ptypes.ErrInvalidRequest)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientFinalityUpdateRPCHandler(t *testing.T) {
+	r, p1, p2, pcl := setupLightClientRPCTest(t, p2p.RPCLightClientFinalityUpdateTopicV1)
+	ctx := context.Background()
+
+	// No update is available yet.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.lightClientFinalityUpdateRPCHandler(ctx, nil, stream), p2ptypes.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	update := &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  testLightClientHeader(100),
+		FinalizedHeader: testLightClientHeader(64),
+		FinalityBranch:  testLightClientBranch(fieldparams.FinalityBranchDepth),
+		SyncAggregate:   testLightClientSyncAggregate(400),
+		SignatureSlot:   101,
+	}
+	r.setLightClientFinalityUpdate(update)
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		received := &ethpbv2.LightClientFinalityUpdate{}
+		readLightClientChunk(t, r, stream, update.AttestedHeader.Slot, received)
+		assert.DeepEqual(t, update, received)
+	})
+	stream, err = p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientFinalityUpdateRPCHandler(ctx, nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientOptimisticUpdateRPCHandler(t *testing.T) {
+	r, p1, p2, pcl := setupLightClientRPCTest(t, p2p.RPCLightClientOptimisticUpdateTopicV1)
+	ctx := context.Background()
+
+	update := &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(100),
+		SyncAggregate:  testLightClientSyncAggregate(10),
+		SignatureSlot:  101,
+	}
+	r.setLightClientOptimisticUpdate(update)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		received := &ethpbv2.LightClientOptimisticUpdate{}
+		readLightClientChunk(t, r, stream, update.AttestedHeader.Slot, received)
+		assert.DeepEqual(t, update, received)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(ctx, nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
@@ -125,6 +125,22 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 		}
 	}
 
+	// Light client topics, served from altair onwards when light client support is enabled.
+	if epoch >= params.BeaconConfig().AltairForkEpoch && features.Get().EnableLightClient {
+		s.subscribe(
+			p2p.LightClientFinalityUpdateTopicFormat,
+			s.validateLightClientFinalityUpdate,
+			s.lightClientUpdateSubscriber,
+			digest,
+		)
+		s.subscribe(
+			p2p.LightClientOptimisticUpdateTopicFormat,
+			s.validateLightClientOptimisticUpdate,
+			s.lightClientUpdateSubscriber,
+			digest,
+		)
+	}
+
 	// New Gossip Topic in Capella
 	if epoch >= params.BeaconConfig().CapellaForkEpoch {
 		s.subscribe(
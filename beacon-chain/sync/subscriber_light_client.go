@@ -0,0 +1,14 @@
+package sync
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientUpdateSubscriber handles the light client updates received over gossip. Accepted updates
+// are identical to the ones computed locally, which are already served and published by this node, so
+// there is nothing left to do once they have been validated and forwarded.
+func (_ *Service) lightClientUpdateSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
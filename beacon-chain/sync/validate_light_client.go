@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"context"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"go.opencensus.io/trace"
+)
+
+// validateLightClientFinalityUpdate validates a light client finality update received over gossip.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// The update can't match a locally computed one while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	update, ok := m.(*ethpbv2.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	// [IGNORE] The finality_update is received after the block at signature_slot was given enough time
+	// to propagate through the network.
+	if !s.lightClientUpdateTimely(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The received finality_update matches the locally computed one exactly.
+	local := s.latestLightClientFinalityUpdate()
+	if local == nil || !sszEqual(update, local) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The finalized_header.beacon.slot is greater than that of all previously forwarded
+	// finality_updates, or it matches the highest previously forwarded slot and also has a sync_aggregate
+	// indicating supermajority (> 2/3) sync committee participation while the previously forwarded
+	// finality_update for that slot did not indicate supermajority.
+	if !s.markLightClientFinalityUpdateForwarded(update) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate validates a light client optimistic update received over gossip.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// The update can't match a locally computed one while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	update, ok := m.(*ethpbv2.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	// [IGNORE] The optimistic_update is received after the block at signature_slot was given enough time
+	// to propagate through the network.
+	if !s.lightClientUpdateTimely(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The received optimistic_update matches the locally computed one exactly.
+	local := s.latestLightClientOptimisticUpdate()
+	if local == nil || !sszEqual(update, local) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The attested_header.beacon.slot is greater than that of all previously forwarded optimistic_updates.
+	if !s.markLightClientOptimisticUpdateForwarded(update) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdateTimely reports whether a light client update signed at the given slot may be
+// propagated at the current time, accounting for the maximum gossip clock disparity.
+func (s *Service) lightClientUpdateTimely(signatureSlot primitives.Slot) bool {
+	now := s.cfg.clock.Now().Add(params.BeaconConfig().MaximumGossipClockDisparityDuration())
+	return !now.Before(s.lightClientUpdateDue(signatureSlot))
+}
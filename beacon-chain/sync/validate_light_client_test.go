@@ -0,0 +1,174 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func setupLightClientValidationTest(t *testing.T, syncing bool) *Service {
+	// Start the chain ten slots ago, so that updates signed up to the previous slot are timely.
+	genesis := time.Now().Add(-10 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	return &Service{
+		cfg: &config{
+			p2p:         mockp2p.NewTestP2P(t),
+			initialSync: &mockSync.Sync{IsSyncing: syncing},
+			clock:       startup.NewClock(genesis, [32]byte{'A'}),
+		},
+	}
+}
+
+func lightClientPubsubMessage(t *testing.T, topicFormat string, obj interface{ MarshalSSZ() ([]byte, error) }) *pubsub.Message {
+	enc, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	topic := fmt.Sprintf(topicFormat, []byte{0xAB, 0x00, 0xCC, 0x9E}) + "/" + encoder.ProtocolSuffixSSZSnappy
+	return &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  snappy.Encode(nil, enc),
+			Topic: &topic,
+		},
+	}
+}
+
+func testLightClientFinalityUpdate(finalizedSlot, signatureSlot primitives.Slot, participants int) *ethpbv2.LightClientFinalityUpdate {
+	return &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  testLightClientHeader(signatureSlot - 1),
+		FinalizedHeader: testLightClientHeader(finalizedSlot),
+		FinalityBranch:  testLightClientBranch(fieldparams.FinalityBranchDepth),
+		SyncAggregate:   testLightClientSyncAggregate(participants),
+		SignatureSlot:   signatureSlot,
+	}
+}
+
+func testLightClientOptimisticUpdate(signatureSlot primitives.Slot) *ethpbv2.LightClientOptimisticUpdate {
+	return &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(signatureSlot - 1),
+		SyncAggregate:  testLightClientSyncAggregate(400),
+		SignatureSlot:  signatureSlot,
+	}
+}
+
+func TestValidateLightClientFinalityUpdate(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		syncing bool
+		local   *ethpbv2.LightClientFinalityUpdate
+		update  *ethpbv2.LightClientFinalityUpdate
+		want    pubsub.ValidationResult
+	}{
+		{
+			name:    "syncing",
+			syncing: true,
+			local:   testLightClientFinalityUpdate(5, 9, 400),
+			update:  testLightClientFinalityUpdate(5, 9, 400),
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name:   "no local update",
+			update: testLightClientFinalityUpdate(5, 9, 400),
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name:   "does not match local update",
+			local:  testLightClientFinalityUpdate(5, 9, 400),
+			update: testLightClientFinalityUpdate(5, 9, 300),
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name:   "signature slot too recent",
+			local:  testLightClientFinalityUpdate(5, 12, 400),
+			update: testLightClientFinalityUpdate(5, 12, 400),
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name:   "matches local update",
+			local:  testLightClientFinalityUpdate(5, 9, 400),
+			update: testLightClientFinalityUpdate(5, 9, 400),
+			want:   pubsub.ValidationAccept,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupLightClientValidationTest(t, tt.syncing)
+			if tt.local != nil {
+				s.setLightClientFinalityUpdate(tt.local)
+			}
+			msg := lightClientPubsubMessage(t, p2p.LightClientFinalityUpdateTopicFormat, tt.update)
+			got, err := s.validateLightClientFinalityUpdate(ctx, "peer", msg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateLightClientFinalityUpdate_AlreadyForwarded(t *testing.T) {
+	ctx := context.Background()
+	s := setupLightClientValidationTest(t, false)
+	update := testLightClientFinalityUpdate(5, 9, 400)
+	s.setLightClientFinalityUpdate(update)
+
+	got, err := s.validateLightClientFinalityUpdate(ctx, "peer", lightClientPubsubMessage(t, p2p.LightClientFinalityUpdateTopicFormat, update))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, got)
+	got, err = s.validateLightClientFinalityUpdate(ctx, "peer", lightClientPubsubMessage(t, p2p.LightClientFinalityUpdateTopicFormat, update))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, got)
+}
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	ctx := context.Background()
+	s := setupLightClientValidationTest(t, false)
+
+	update := testLightClientOptimisticUpdate(8)
+	msg := lightClientPubsubMessage(t, p2p.LightClientOptimisticUpdateTopicFormat, update)
+	got, err := s.validateLightClientOptimisticUpdate(ctx, "peer", msg)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, got, "update without local counterpart should be ignored")
+
+	s.setLightClientOptimisticUpdate(update)
+	got, err = s.validateLightClientOptimisticUpdate(ctx, "peer", msg)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, got)
+	assert.DeepEqual(t, update, msg.ValidatorData)
+
+	got, err = s.validateLightClientOptimisticUpdate(ctx, "peer", lightClientPubsubMessage(t, p2p.LightClientOptimisticUpdateTopicFormat, update))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, got, "already forwarded update should be ignored")
+
+	newer := testLightClientOptimisticUpdate(9)
+	s.setLightClientOptimisticUpdate(newer)
+	got, err = s.validateLightClientOptimisticUpdate(ctx, "peer", lightClientPubsubMessage(t, p2p.LightClientOptimisticUpdateTopicFormat, newer))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, got)
+}
+
+func TestService_MarkLightClientFinalityUpdateForwarded(t *testing.T) {
+	s := &Service{}
+	assert.Equal(t, true, s.markLightClientFinalityUpdateForwarded(testLightClientFinalityUpdate(64, 100, 300)))
+	// Same finalized header without supermajority.
+	assert.Equal(t, false, s.markLightClientFinalityUpdateForwarded(testLightClientFinalityUpdate(64, 101, 300)))
+	// Same finalized header, now with supermajority.
+	assert.Equal(t, true, s.markLightClientFinalityUpdateForwarded(testLightClientFinalityUpdate(64, 102, 400)))
+	assert.Equal(t, false, s.markLightClientFinalityUpdateForwarded(testLightClientFinalityUpdate(64, 103, 400)))
+	// Older finalized header.
+	assert.Equal(t, false, s.markLightClientFinalityUpdateForwarded(testLightClientFinalityUpdate(32, 104, 400)))
+	// Newer finalized header.
+	assert.Equal(t, true, s.markLightClientFinalityUpdateForwarded(testLightClientFinalityUpdate(96, 105, 100)))
+}
@@ -73,6 +73,13 @@ var BackfillSidecarRequirements = requirementList(InitsyncSidecarRequirements).e
 // PendingQueueSidecarRequirements is the same as InitsyncSidecarRequirements, used by the pending blocks queue.
 var PendingQueueSidecarRequirements = requirementList(InitsyncSidecarRequirements).excluding()
 
+// OfflineSidecarRequirements is the list of verification requirements for blobs imported outside of a running node,
+// like the blobs imported by prysmctl. Without a chain to check the sidecars against, only the index, the inclusion
+// proof and the kzg proof of the sidecar itself can be verified.
+var OfflineSidecarRequirements = requirementList(InitsyncSidecarRequirements).excluding(
+	RequireValidProposerSignature,
+)
+
 var (
 	ErrBlobInvalid = errors.New("blob failed verification")
 	// ErrBlobIndexInvalid means RequireBlobIndexInBounds failed.
@@ -669,3 +669,27 @@ func pcReturnsNotFound() func(c *forkchoicetypes.Checkpoint, slot primitives.Slo
 		return 0, false
 	}
 }
+
+func TestOfflineBlobVerifier(t *testing.T) {
+	_, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	b := blobs[0]
+	v := NewOfflineBlobVerifier(b)
+	v.verifyBlobCommitment = func(...blocks.ROBlob) error { return nil }
+	_, err := v.VerifiedROBlob()
+	require.ErrorIs(t, err, ErrBlobInvalid)
+
+	require.NoError(t, v.BlobIndexInBounds())
+	require.NoError(t, v.SidecarInclusionProven())
+	require.NoError(t, v.SidecarKzgProofVerified())
+	vb, err := v.VerifiedROBlob()
+	require.NoError(t, err)
+	require.Equal(t, b.BlockRoot(), vb.BlockRoot())
+
+	v = NewOfflineBlobVerifier(b)
+	v.verifyBlobCommitment = func(...blocks.ROBlob) error { return errors.New("bad blob") }
+	require.NoError(t, v.BlobIndexInBounds())
+	require.NoError(t, v.SidecarInclusionProven())
+	require.ErrorIs(t, v.SidecarKzgProofVerified(), ErrSidecarKzgProofInvalid)
+	_, err = v.VerifiedROBlob()
+	require.ErrorIs(t, err, ErrBlobInvalid)
+}
@@ -57,6 +57,17 @@ func (ini *Initializer) NewBlobVerifier(b blocks.ROBlob, reqs []Requirement) *RO
 	}
 }
 
+// NewOfflineBlobVerifier creates a BlobVerifier for a single blob with the OfflineSidecarRequirements.
+// These requirements don't need the resources of an Initializer, so it can be used without a running node.
+func NewOfflineBlobVerifier(b blocks.ROBlob) *ROBlobVerifier {
+	return &ROBlobVerifier{
+		sharedResources:      &sharedResources{},
+		blob:                 b,
+		results:              newResults(OfflineSidecarRequirements...),
+		verifyBlobCommitment: kzg.Verify,
+	}
+}
+
 // InitializerWaiter provides an Initializer once all dependent resources are ready
 // via the WaitForInitializer method.
 type InitializerWaiter struct {
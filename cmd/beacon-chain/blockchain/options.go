@@ -19,6 +19,7 @@ func FlagOptions(c *cli.Context) ([]blockchain.Option, error) {
 	opts := []blockchain.Option{
 		blockchain.WithMaxGoroutines(maxRoutines),
 		blockchain.WithWeakSubjectivityCheckpoint(wsCheckpt),
+		blockchain.WithLightClientRetentionPeriods(c.Uint64(flags.LightClientRetentionPeriods.Name)),
 	}
 	return opts, nil
 }
@@ -31,6 +31,15 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
 	}
+	if backups := c.StringSlice(flags.ExecutionEngineBackupEndpoint.Name); len(backups) > 0 {
+		opts = append(opts, execution.WithBackupEngineEndpoints(backups, jwtSecret))
+	}
+	if c.IsSet(flags.ExecutionEngineQuorum.Name) {
+		opts = append(opts, execution.WithEngineQuorum(c.Uint64(flags.ExecutionEngineQuorum.Name)))
+	}
+	if path := c.String(flags.ExecutionEngineRecordingFile.Name); path != "" {
+		opts = append(opts, execution.WithEngineRecording(path, c.Uint64(flags.ExecutionEngineRecordingMaxSize.Name)))
+	}
 	return opts, nil
 }
 
@@ -67,7 +76,7 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 }
 
 func parseExecutionChainEndpoint(c *cli.Context) (string, error) {
-	if c.String(flags.ExecutionEngineEndpoint.Name) == "" {
+	if c.String(flags.ExecutionEngineEndpoint.Name) == "" && !c.Bool(flags.InteropMockExecutionEngineFlag.Name) {
 		return "", fmt.Errorf(
 			"you need to specify %s to provide a connection endpoint to an Ethereum execution client "+
 				"for your Prysm beacon node. This is a requirement for running a node. You can read more about "+
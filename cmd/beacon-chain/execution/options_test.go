@@ -99,3 +99,13 @@ func TestPowchainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	_, err := parseExecutionChainEndpoint(ctx)
 	assert.ErrorContains(t, "you need to specify", err)
 }
+
+func TestPowchainPreregistration_MockExecutionEngine(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "", "")
+	set.Bool(flags.InteropMockExecutionEngineFlag.Name, true, "")
+	ctx := cli.NewContext(&app, set, nil)
+	_, err := parseExecutionChainEndpoint(ctx)
+	require.NoError(t, err)
+}
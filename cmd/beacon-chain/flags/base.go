@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/urfave/cli/v2"
@@ -11,10 +13,25 @@ import (
 var (
 	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
 	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Several relays can be given as a comma-separated list: headers are requested from all of them and the highest bid wins.",
 		Value: "",
 	}
+	// MevRelayTimeout is the time the MEV builder relays have to return a bid.
+	MevRelayTimeout = &cli.DurationFlag{
+		Name:  "http-mev-relay-timeout",
+		Usage: "Time the MEV builder relays have to return a bid, the highest valid bid received by then is used.",
+		Value: time.Second,
+	}
+	// MevRelayQuarantine is the time a MEV builder relay is not used after an incident.
+	MevRelayQuarantine = &cli.DurationFlag{
+		Name: "http-mev-relay-quarantine",
+		Usage: "Time a MEV builder relay is not used after it did not reveal the payload of a signed blinded block, " +
+			"or after a block built on its payload did not become canonical. Local payloads are used while all the relays are quarantined. " +
+			"0 only records the incidents.",
+		Value: time.Hour,
+	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
 		Usage: "Number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction",
@@ -37,6 +54,32 @@ var (
 		Usage: "An execution client http endpoint. Can contain auth header as well in the format",
 		Value: "http://localhost:8551",
 	}
+	// ExecutionEngineBackupEndpoint provides HTTP access endpoints to backup execution clients.
+	ExecutionEngineBackupEndpoint = &cli.StringSliceFlag{
+		Name: "execution-backup-endpoint",
+		Usage: "A backup execution client http endpoint, authenticated with the same JWT secret as --execution-endpoint. " +
+			"Can be given several times. Payloads and forkchoice updates are sent to all execution clients, and payloads " +
+			"are built by the healthiest one.",
+	}
+	// ExecutionEngineQuorum is the number of execution clients which must agree on the validity of a payload.
+	ExecutionEngineQuorum = &cli.Uint64Flag{
+		Name: "execution-endpoint-quorum",
+		Usage: "The number of execution clients, among --execution-endpoint and --execution-backup-endpoint, which must " +
+			"agree that a payload is valid or invalid. Without a quorum, the payload is imported optimistically.",
+		Value: 1,
+	}
+	// ExecutionEngineRecordingFile is the file engine API calls are recorded to.
+	ExecutionEngineRecordingFile = &cli.StringFlag{
+		Name: "execution-recording-file",
+		Usage: "Records every engine API request sent to the execution clients, and its response, to this file. " +
+			"The recording can be replayed with the engine-replay tool to reproduce execution client issues.",
+	}
+	// ExecutionEngineRecordingMaxSize is the size in megabytes after which the engine API recording file is rotated.
+	ExecutionEngineRecordingMaxSize = &cli.Uint64Flag{
+		Name:  "execution-recording-max-size",
+		Usage: "The size in megabytes after which the --execution-recording-file is rotated. The 10 last rotated files are kept.",
+		Value: 100,
+	}
 	// ExecutionEngineHeaders defines a list of HTTP headers to send with all execution client requests.
 	ExecutionEngineHeaders = &cli.StringFlag{
 		Name: "execution-headers",
@@ -150,6 +193,13 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// StateDiffExponents enables the hierarchical state diff storage of historical states.
+	StateDiffExponents = &cli.IntSliceFlag{
+		Name: "state-diff-exponents",
+		Usage: "Stores finalized states as full snapshots and diffs at slot intervals of 2^exponent, from the coarsest " +
+			"level to the finest, for example 21,18,16,13,11,9,5. The finest interval replaces --slots-per-archive-point. " +
+			"Historical states are then loaded from a snapshot and at most one diff per level.",
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -254,6 +304,31 @@ var (
 			"WARNING: This flag should be used only if you have a clear understanding that community has decided to override the terminal block hash activation epoch. " +
 			"Incorrect usage will result in your node experience consensus failure.",
 	}
+	// LightClientRetentionPeriods defines the number of sync committee periods for which light client data is kept.
+	LightClientRetentionPeriods = &cli.Uint64Flag{
+		Name: "light-client-retention-periods",
+		Usage: "Sets the number of sync committee periods for which light client updates and bootstraps are kept in the database. " +
+			"Only used with --enable-lightclient. Default covers MIN_EPOCHS_FOR_BLOCK_REQUESTS, 0 disables pruning.",
+		Value: params.BeaconConfig().MinEpochsForBlockRequests/uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) + 1,
+	}
+	// EnableRewardsIndexer enables the indexing of validator rewards at each epoch transition.
+	EnableRewardsIndexer = &cli.BoolFlag{
+		Name: "rewards-indexer",
+		Usage: "Records the attestation, proposer and sync committee rewards of the validators given with --rewards-indexer-indices at each epoch transition, " +
+			"and serves them from /prysm/v1/rewards/validators/{ids}. Requires the blocks and states of indexed epochs to be available.",
+	}
+	// RewardsIndexerIndices defines the validator indices the rewards indexer records rewards for.
+	RewardsIndexerIndices = &cli.IntSliceFlag{
+		Name:  "rewards-indexer-indices",
+		Usage: "List of validator indices the rewards indexer records rewards for. Required with --rewards-indexer.",
+	}
+	// RewardsIndexerRetentionEpochs defines the number of epochs for which indexed rewards are kept.
+	RewardsIndexerRetentionEpochs = &cli.Uint64Flag{
+		Name: "rewards-indexer-retention-epochs",
+		Usage: "Number of epochs for which indexed validator rewards are kept in the database, about 36 days by default. " +
+			"0 keeps them forever.",
+		Value: 8192,
+	}
 	// SlasherDirFlag defines a path on disk where the slasher database is stored.
 	SlasherDirFlag = &cli.StringFlag{
 		Name:  "slasher-datadir",
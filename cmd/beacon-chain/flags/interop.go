@@ -22,4 +22,10 @@ var (
 		Name:  "interop-num-validators",
 		Usage: "Specify number of genesis validators to generate for interop. Must be used with --interop-genesis-time",
 	}
+	// InteropMockExecutionEngineFlag enables an in-process mock execution engine instead of an execution client.
+	InteropMockExecutionEngineFlag = &cli.BoolFlag{
+		Name: "interop-mock-execution-engine",
+		Usage: "Use an in-process mock execution engine building empty payloads instead of connecting to an " +
+			"execution client, for a beacon-chain-only devnet. For testing only, --execution-endpoint is ignored.",
+	}
 )
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"path"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
@@ -24,6 +25,20 @@ var (
 		Value:   uint64(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest),
 		Aliases: []string{"extend-blob-retention-epoch"},
 	}
+	// BlobStorageLayoutFlag selects how the blob files are organized in the blob storage directory.
+	BlobStorageLayoutFlag = &cli.StringFlag{
+		Name: "blob-storage-layout",
+		Usage: "Directory layout of the blob storage, one of " + strings.Join(filesystem.LayoutNames, ", ") + ". " +
+			"The " + filesystem.LayoutNameByEpoch + " layout groups blobs by epoch, making pruning cheaper. " +
+			"Existing blobs can be converted with `prysmctl db migrate-blobs`.",
+		Value: filesystem.LayoutNameFlat,
+	}
+	// BlobArchiveFlag keeps the blobs that are out of the retention period in a compressed archive instead of deleting them.
+	BlobArchiveFlag = &cli.BoolFlag{
+		Name: "blob-archive",
+		Usage: "Moves blobs older than the retention period to a compressed per-epoch archive in the blob storage " +
+			"directory instead of deleting them. Archived blobs are still served by the beacon API.",
+	}
 )
 
 // BeaconNodeOptions sets configuration values on the node.BeaconNode value at node startup.
@@ -37,6 +52,8 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	}
 	opts := []node.Option{node.WithBlobStorageOptions(
 		filesystem.WithBlobRetentionEpochs(e), filesystem.WithBasePath(blobStoragePath(c)),
+		filesystem.WithLayout(c.String(BlobStorageLayoutFlag.Name)),
+		filesystem.WithArchival(c.Bool(BlobArchiveFlag.Name)),
 	)}
 	return opts, nil
 }
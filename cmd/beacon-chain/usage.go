@@ -75,6 +75,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RestoreSourceFileFlag,
 			cmd.RestoreTargetDirFlag,
 			cmd.ValidatorMonitorIndicesFlag,
+			cmd.EnableValidatorMonitorFlag,
 			cmd.ApiTimeoutFlag,
 		},
 	},
@@ -107,10 +108,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
 			flags.ExecutionEngineEndpoint,
+			flags.ExecutionEngineBackupEndpoint,
+			flags.ExecutionEngineQuorum,
+			flags.ExecutionEngineRecordingFile,
+			flags.ExecutionEngineRecordingMaxSize,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
+			flags.StateDiffExponents,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
@@ -124,11 +130,17 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Eth1HeaderReqLimit,
 			flags.MinPeersPerSubnet,
 			flags.MevRelayEndpoint,
+			flags.MevRelayTimeout,
+			flags.MevRelayQuarantine,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
 			flags.LocalBlockValueBoost,
+			flags.LightClientRetentionPeriods,
+			flags.EnableRewardsIndexer,
+			flags.RewardsIndexerIndices,
+			flags.RewardsIndexerRetentionEpochs,
 			flags.JwtId,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
@@ -137,6 +149,8 @@ var appHelpFlagGroups = []flagGroup{
 			genesis.BeaconAPIURL,
 			storage.BlobStoragePathFlag,
 			storage.BlobRetentionEpochFlag,
+			storage.BlobStorageLayoutFlag,
+			storage.BlobArchiveFlag,
 			backfill.EnableExperimentalBackfill,
 			backfill.BackfillWorkerCount,
 			backfill.BackfillBatchSize,
@@ -187,6 +201,7 @@ var appHelpFlagGroups = []flagGroup{
 			genesis.StatePath,
 			flags.InteropGenesisTimeFlag,
 			flags.InteropNumValidatorsFlag,
+			flags.InteropMockExecutionEngineFlag,
 		},
 	},
 	{
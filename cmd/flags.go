@@ -244,7 +244,13 @@ var (
 	// track for performance updates
 	ValidatorMonitorIndicesFlag = &cli.IntSliceFlag{
 		Name:  "monitor-indices",
-		Usage: "List of validator indices to track performance",
+		Usage: "List of validator indices to track performance. Tracked validators can also be changed at runtime through the /prysm/v1/validators/monitor/indices endpoint.",
+	}
+	// EnableValidatorMonitorFlag runs the validator monitor without any validator to track at startup.
+	EnableValidatorMonitorFlag = &cli.BoolFlag{
+		Name: "enable-validator-monitor",
+		Usage: "Runs the validator monitor even when no --monitor-indices are given, so that validators can be tracked at runtime " +
+			"through the /prysm/v1/validators/monitor endpoints. The monitor is enabled by --monitor-indices otherwise.",
 	}
 
 	// RestoreSourceFileFlag specifies the filepath to the backed-up database file
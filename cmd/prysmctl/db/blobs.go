@@ -0,0 +1,242 @@
+package db
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var blobsFlags = struct {
+	Path            string
+	Layout          string
+	Archive         bool
+	RetentionEpochs uint64
+	FromSlot        uint64
+	ToSlot          uint64
+	File            string
+}{}
+
+var (
+	blobPathFlag = &cli.StringFlag{
+		Name:        "blob-path",
+		Usage:       "path to the blob storage directory, ie the --blob-path of the beacon node",
+		Destination: &blobsFlags.Path,
+		Required:    true,
+	}
+	blobLayoutFlag = &cli.StringFlag{
+		Name:        "layout",
+		Usage:       "layout of the blob storage directory, one of " + strings.Join(filesystem.LayoutNames, ", "),
+		Destination: &blobsFlags.Layout,
+		Value:       filesystem.LayoutNameFlat,
+	}
+	blobArchiveFlag = &cli.BoolFlag{
+		Name:        "blob-archive",
+		Usage:       "the blob storage runs in archival mode, ie the beacon node runs with --blob-archive",
+		Destination: &blobsFlags.Archive,
+	}
+)
+
+var blobsCmd = &cli.Command{
+	Name:  "blobs",
+	Usage: "commands to move blob sidecars between blob storage directories, the beacon node must be stopped",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "export",
+			Usage: "writes the blob sidecars of a slot range to a portable file",
+			Action: func(cliCtx *cli.Context) error {
+				if err := blobsExportAction(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not export blobs")
+				}
+				return nil
+			},
+			Flags: []cli.Flag{
+				blobPathFlag,
+				blobLayoutFlag,
+				blobArchiveFlag,
+				&cli.Uint64Flag{
+					Name:        "from-slot",
+					Usage:       "first slot of the blobs to export",
+					Destination: &blobsFlags.FromSlot,
+					Required:    true,
+				},
+				&cli.Uint64Flag{
+					Name:        "to-slot",
+					Usage:       "last slot of the blobs to export",
+					Destination: &blobsFlags.ToSlot,
+					Required:    true,
+				},
+				&cli.StringFlag{
+					Name:        "out",
+					Usage:       "path of the file the blobs are written to",
+					Destination: &blobsFlags.File,
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name: "import",
+			Usage: "verifies the kzg commitments of the blob sidecars of a file written by export, and saves them " +
+				"in the blob storage",
+			Action: func(cliCtx *cli.Context) error {
+				if err := blobsImportAction(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not import blobs")
+				}
+				return nil
+			},
+			Flags: []cli.Flag{
+				blobPathFlag,
+				blobLayoutFlag,
+				blobArchiveFlag,
+				&cli.Uint64Flag{
+					Name: "blob-retention-epochs",
+					Usage: "retention period of the blob storage, ie the --blob-retention-epochs of the beacon node. " +
+						"Imported blobs older than the retention period of the most recent imported blob are pruned",
+					Destination: &blobsFlags.RetentionEpochs,
+					Value:       uint64(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest),
+				},
+				&cli.StringFlag{
+					Name:        "in",
+					Usage:       "path of the file written by export",
+					Destination: &blobsFlags.File,
+					Required:    true,
+				},
+			},
+		},
+	},
+}
+
+func openBlobStorage(retention primitives.Epoch) (*filesystem.BlobStorage, error) {
+	flags := blobsFlags
+	return filesystem.NewBlobStorage(
+		filesystem.WithBasePath(flags.Path),
+		filesystem.WithLayout(flags.Layout),
+		filesystem.WithArchival(flags.Archive),
+		filesystem.WithBlobRetentionEpochs(retention),
+	)
+}
+
+func blobsExportAction(_ *cli.Context) error {
+	flags := blobsFlags
+	bs, err := openBlobStorage(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest)
+	if err != nil {
+		return err
+	}
+	roots, err := bs.Roots(primitives.Slot(flags.FromSlot), primitives.Slot(flags.ToSlot))
+	if err != nil {
+		return errors.Wrap(err, "could not list the blobs of the slot range")
+	}
+
+	f, err := os.OpenFile(flags.File, os.O_WRONLY|os.O_CREATE|os.O_EXCL, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrapf(err, "could not create %s", flags.File)
+	}
+	bw := bufio.NewWriter(f)
+	w, err := filesystem.NewExportWriter(bw)
+	if err != nil {
+		return err
+	}
+	exported := 0
+	for _, root := range roots {
+		indices, err := bs.Indices(root)
+		if err != nil {
+			return errors.Wrapf(err, "could not read the blob indices of root %#x", root)
+		}
+		for idx, ok := range indices {
+			if !ok {
+				continue
+			}
+			sc, err := bs.Get(root, uint64(idx))
+			if err != nil {
+				return errors.Wrapf(err, "could not read blob of root %#x index %d", root, idx)
+			}
+			if err := w.Write(sc); err != nil {
+				return err
+			}
+			exported += 1
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"file":     flags.File,
+		"fromSlot": flags.FromSlot,
+		"toSlot":   flags.ToSlot,
+		"blocks":   len(roots),
+		"blobs":    exported,
+	}).Info("Exported blobs")
+	return nil
+}
+
+func blobsImportAction(_ *cli.Context) error {
+	flags := blobsFlags
+	if err := kzg.Start(); err != nil {
+		return errors.Wrap(err, "could not initialize kzg trusted setup")
+	}
+	bs, err := openBlobStorage(primitives.Epoch(flags.RetentionEpochs))
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(flags.File) // #nosec G304 -- path provided by the operator
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close file %s", flags.File)
+		}
+	}()
+	r, err := filesystem.NewExportReader(bufio.NewReader(f))
+	if err != nil {
+		return errors.Wrapf(err, "could not read %s", flags.File)
+	}
+	imported := 0
+	for {
+		sc, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not read blob after %d imported blobs", imported)
+		}
+		v := verification.NewOfflineBlobVerifier(sc)
+		if err := v.BlobIndexInBounds(); err != nil {
+			return errors.Wrapf(err, "blob of root %#x index %d", sc.BlockRoot(), sc.Index)
+		}
+		if err := v.SidecarInclusionProven(); err != nil {
+			return errors.Wrapf(err, "blob of root %#x index %d", sc.BlockRoot(), sc.Index)
+		}
+		if err := v.SidecarKzgProofVerified(); err != nil {
+			return errors.Wrapf(err, "blob of root %#x index %d", sc.BlockRoot(), sc.Index)
+		}
+		vsc, err := v.VerifiedROBlob()
+		if err != nil {
+			return errors.Wrapf(err, "blob of root %#x index %d", sc.BlockRoot(), sc.Index)
+		}
+		if err := bs.Save(vsc); err != nil {
+			return errors.Wrapf(err, "could not save blob of root %#x index %d", sc.BlockRoot(), sc.Index)
+		}
+		imported += 1
+	}
+	log.WithFields(log.Fields{
+		"file":  flags.File,
+		"blobs": imported,
+	}).Info("Imported blobs")
+	return nil
+}
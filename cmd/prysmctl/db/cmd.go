@@ -10,6 +10,8 @@ var Commands = []*cli.Command{
 			queryCmd,
 			bucketsCmd,
 			spanCmd,
+			migrateBlobsCmd,
+			blobsCmd,
 		},
 	},
 }
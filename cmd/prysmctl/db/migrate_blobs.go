@@ -0,0 +1,54 @@
+package db
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateBlobsFlags = struct {
+	Path   string
+	Layout string
+}{}
+
+var migrateBlobsCmd = &cli.Command{
+	Name:  "migrate-blobs",
+	Usage: "converts the blob storage directory to another layout, the beacon node must be stopped",
+	Action: func(cliCtx *cli.Context) error {
+		if err := migrateBlobsAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not migrate blob storage")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "blob-path",
+			Usage:       "path to the blob storage directory, ie the --blob-path of the beacon node",
+			Destination: &migrateBlobsFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "layout",
+			Usage:       "layout to convert the blob storage to, one of " + strings.Join(filesystem.LayoutNames, ", "),
+			Destination: &migrateBlobsFlags.Layout,
+			Required:    true,
+		},
+	},
+}
+
+func migrateBlobsAction(_ *cli.Context) error {
+	flags := migrateBlobsFlags
+	moved, err := filesystem.MigrateLayout(flags.Path, flags.Layout)
+	if err != nil {
+		return errors.Wrapf(err, "migration stopped after %d block roots, run the command again to resume", moved)
+	}
+	log.WithFields(log.Fields{
+		"path":   flags.Path,
+		"layout": flags.Layout,
+		"roots":  moved,
+	}).Info("Migrated blob storage")
+	return nil
+}
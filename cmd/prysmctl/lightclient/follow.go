@@ -0,0 +1,98 @@
+package lightclient
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/light-client"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var followFlags = struct {
+	BeaconNodeHost   string
+	TrustedBlockRoot string
+	HTTPAddress      string
+	Timeout          time.Duration
+}{}
+
+var followCmd = &cli.Command{
+	Name:  "follow",
+	Usage: "Bootstrap from a trusted block root, follow the chain through the light client updates of a beacon node, and serve the verified headers.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionFollow(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run light client")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node connection",
+			Destination: &followFlags.BeaconNodeHost,
+			Value:       "localhost:3500",
+		},
+		&cli.StringFlag{
+			Name:        "trusted-block-root",
+			Usage:       "hex-encoded root of the block to bootstrap the light client from, ideally a recent finalized checkpoint block root",
+			Destination: &followFlags.TrustedBlockRoot,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "http-host-port",
+			Usage:       "host:port the verified headers are served on",
+			Destination: &followFlags.HTTPAddress,
+			Value:       "127.0.0.1:3600",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-host (uses duration format, ex: 2m31s). default: 30s",
+			Destination: &followFlags.Timeout,
+			Value:       30 * time.Second,
+		},
+		cmd.ChainConfigFileFlag,
+	},
+}
+
+func cliActionFollow(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+	}
+	f := followFlags
+
+	root, err := hexutil.Decode(f.TrustedBlockRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode trusted block root %s", f.TrustedBlockRoot)
+	}
+	if len(root) != 32 {
+		return errors.Errorf("trusted block root must be 32 bytes, got %d", len(root))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	svc, err := lightclient.NewService(
+		ctx,
+		lightclient.WithBeaconNodeHost(f.BeaconNodeHost),
+		lightclient.WithTrustedBlockRoot(bytesutil.ToBytes32(root)),
+		lightclient.WithHTTPAddress(f.HTTPAddress),
+		lightclient.WithRequestTimeout(f.Timeout),
+	)
+	if err != nil {
+		return err
+	}
+	svc.Start()
+	<-ctx.Done()
+	log.Info("Stopping light client")
+	return svc.Stop()
+}
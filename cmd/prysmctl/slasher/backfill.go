@@ -0,0 +1,104 @@
+package slasher
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var backfillFlags = struct {
+	BeaconDBPath string
+	DataDir      string
+	StartEpoch   uint64
+	EndEpoch     uint64
+}{}
+
+var backfillCmd = &cli.Command{
+	Name:  "backfill",
+	Usage: "Fill the slasher database with the slashing detection over the blocks and attestations of an epoch range of a beacon node database, so that a new slasher knows about the past. Both databases must not be in use.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionBackfill(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not backfill slasher database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-db-path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &backfillFlags.BeaconDBPath,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "directory of the slasher database, compatible with the --slasher-datadir of a beacon node",
+			Destination: &backfillFlags.DataDir,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-epoch",
+			Usage:       "first epoch to backfill. The slasher database must not hold data of later epochs",
+			Destination: &backfillFlags.StartEpoch,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-epoch",
+			Usage:       "last epoch to backfill",
+			Destination: &backfillFlags.EndEpoch,
+			Required:    true,
+		},
+		cmd.ChainConfigFileFlag,
+	},
+}
+
+func cliActionBackfill(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+	}
+	f := backfillFlags
+	ctx := cliCtx.Context
+
+	beaconDB, err := kv.NewKVStore(ctx, f.BeaconDBPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open beacon database")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon database")
+		}
+	}()
+	slasherDB, err := slasherkv.NewKVStore(ctx, filepath.Join(f.DataDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := slasherDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	source := slasher.NewBeaconDBSource(beaconDB, stategen.New(beaconDB, doublylinkedtree.New()))
+	result, err := slasher.Backfill(ctx, slasherDB, source, primitives.Epoch(f.StartEpoch), primitives.Epoch(f.EndEpoch))
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"numBlocks":            result.NumBlocks,
+		"numAttestations":      result.NumAttestations,
+		"numAttesterSlashings": len(result.AttesterSlashings),
+		"numProposerSlashings": len(result.ProposerSlashings),
+	}).Info("Backfilled slasher database")
+	return nil
+}
@@ -0,0 +1,17 @@
+package slasher
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "slasher",
+		Usage: "commands for running a slasher apart from the beacon node and managing slasher databases",
+		Subcommands: []*cli.Command{
+			runCmd,
+			backfillCmd,
+			exportCmd,
+			importCmd,
+			compactCmd,
+		},
+	},
+}
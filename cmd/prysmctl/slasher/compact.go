@@ -0,0 +1,45 @@
+package slasher
+
+import (
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var compactFlags = struct {
+	DataDir string
+}{}
+
+var compactCmd = &cli.Command{
+	Name:  "compact",
+	Usage: "Reclaim the disk space of the data pruned from a slasher database. The database must not be in use.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionCompact(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not compact slasher database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "directory of the slasher database, compatible with the --slasher-datadir of a beacon node",
+			Destination: &compactFlags.DataDir,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionCompact(_ *cli.Context) error {
+	before, after, err := slasherkv.Compact(filepath.Join(compactFlags.DataDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"sizeBefore": before,
+		"sizeAfter":  after,
+	}).Info("Compacted slasher database")
+	return nil
+}
@@ -0,0 +1,131 @@
+package slasher
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	DataDir string
+	File    string
+}{}
+
+var exportCmd = &cli.Command{
+	Name:  "export",
+	Usage: "Export the min-max spans, attestation records, proposal records and slashings of a slasher database to a file, to bootstrap new slashers with.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionExport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export slasher database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "directory of the slasher database, compatible with the --slasher-datadir of a beacon node",
+			Destination: &exportFlags.DataDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "file",
+			Usage:       "path of the export file to write",
+			Destination: &exportFlags.File,
+			Required:    true,
+		},
+	},
+}
+
+var importCmd = &cli.Command{
+	Name:  "import",
+	Usage: "Import an export file into an empty slasher database.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionImport(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import into slasher database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "directory of the slasher database, compatible with the --slasher-datadir of a beacon node",
+			Destination: &exportFlags.DataDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "file",
+			Usage:       "path of the export file to read",
+			Destination: &exportFlags.File,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionExport(cliCtx *cli.Context) error {
+	f := exportFlags
+	db, err := slasherkv.NewKVStore(cliCtx.Context, filepath.Join(f.DataDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	out, err := os.OpenFile(f.File, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not create export file")
+	}
+	numRecords, err := db.Export(cliCtx.Context, out)
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"file":       f.File,
+		"numRecords": numRecords,
+	}).Info("Exported slasher database")
+	return nil
+}
+
+func cliActionImport(cliCtx *cli.Context) error {
+	f := exportFlags
+	in, err := os.Open(f.File) // #nosec G304 -- the file is chosen by the operator.
+	if err != nil {
+		return errors.Wrap(err, "could not open export file")
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.WithError(err).Error("Could not close export file")
+		}
+	}()
+	db, err := slasherkv.NewKVStore(cliCtx.Context, filepath.Join(f.DataDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	numRecords, err := db.Import(cliCtx.Context, in)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"file":       f.File,
+		"numRecords": numRecords,
+	}).Info("Imported slasher database")
+	return nil
+}
@@ -0,0 +1,79 @@
+package slasher
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/remote"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var runFlags = struct {
+	BeaconNodeHost string
+	DataDir        string
+	Timeout        time.Duration
+}{}
+
+var runCmd = &cli.Command{
+	Name:  "run",
+	Usage: "Detect slashable offenses from the blocks and attestations streamed by a beacon node, and submit the slashings found to its operations pool. The debug endpoints of the beacon node must be enabled to verify the slashings.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionRun(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run slasher")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node connection",
+			Destination: &runFlags.BeaconNodeHost,
+			Value:       "localhost:3500",
+		},
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "directory of the slasher database, compatible with the --slasher-datadir of a beacon node",
+			Destination: &runFlags.DataDir,
+			Required:    true,
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-host, which include downloading states (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &runFlags.Timeout,
+			Value:       2 * time.Minute,
+		},
+		cmd.ChainConfigFileFlag,
+	},
+}
+
+func cliActionRun(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+	}
+	f := runFlags
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	svc, err := remote.NewService(
+		ctx,
+		remote.WithBeaconNodeHost(f.BeaconNodeHost),
+		remote.WithDataDir(f.DataDir),
+		remote.WithRequestTimeout(f.Timeout),
+	)
+	if err != nil {
+		return err
+	}
+	svc.Start()
+	<-ctx.Done()
+	log.Info("Stopping slasher")
+	return svc.Stop()
+}
@@ -42,10 +42,23 @@ var (
 	}
 	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
 	BeaconRESTApiProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rest-api-provider",
-		Usage: "Beacon node REST API provider endpoint.",
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint. A comma-separated list of endpoints fails over to the " +
+			"healthiest synced beacon node.",
 		Value: "http://127.0.0.1:3500",
 	}
+	// BeaconRESTApiBroadcastFlag enables broadcasting signed messages to all healthy beacon nodes.
+	BeaconRESTApiBroadcastFlag = &cli.BoolFlag{
+		Name: "beacon-rest-api-broadcast",
+		Usage: "Submits signed blocks, attestations, aggregates and sync committee messages to all healthy beacon " +
+			"nodes of --beacon-rest-api-provider at once, rather than only to the healthiest one.",
+	}
+	// BeaconRESTApiAttestationConsensusFlag enables requesting attestation data from all beacon nodes.
+	BeaconRESTApiAttestationConsensusFlag = &cli.BoolFlag{
+		Name: "beacon-rest-api-attestation-consensus",
+		Usage: "Requests attestation data from all beacon nodes of --beacon-rest-api-provider, and attests to the " +
+			"head most beacon nodes agree on, so that a beacon node on a minority fork does not make validators vote for it.",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
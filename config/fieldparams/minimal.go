@@ -32,5 +32,7 @@ const (
 	BlobLength                            = 131072        // BlobLength defines the byte length of a blob.
 	BlobSize                              = 131072        // defined to match blob.size in bazel ssz codegen
 	KzgCommitmentInclusionProofDepth      = 17            // Merkle proof depth for blob_kzg_commitments list item
+	CurrentSyncCommitteeBranchDepth       = 5             // CurrentSyncCommitteeBranchDepth defines the depth of the current sync committee branch.
 	NextSyncCommitteeBranchDepth          = 5             // NextSyncCommitteeBranchDepth defines the depth of the next sync committee branch.
+	FinalityBranchDepth                   = 6             // FinalityBranchDepth defines the depth of the finalized checkpoint root branch.
 )
@@ -0,0 +1,37 @@
+package validator
+
+import "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+
+// EpochPerformance holds the performance of a validator during an epoch, as observed by the validator monitor.
+type EpochPerformance struct {
+	ValidatorIndex primitives.ValidatorIndex
+	Epoch          primitives.Epoch
+	// Attestation of the validator for the epoch. The correctness flags are only known after Altair.
+	AttestationIncluded bool
+	InclusionDistance   primitives.Slot
+	CorrectSource       bool
+	CorrectTarget       bool
+	CorrectHead         bool
+	// Proposals assigned to and included from the validator during the epoch.
+	ProposerDuties uint64
+	ProposedBlocks uint64
+	// Sync committee signatures expected from and included for the validator during the epoch.
+	SyncCommitteeDuties        uint64
+	SyncCommitteeContributions uint64
+	// Balances of the validator after the first block of the epoch and after the first block of the next epoch.
+	StartBalance uint64
+	EndBalance   uint64
+}
+
+// MissedProposals returns the number of proposer duties of the epoch the validator did not fulfill.
+func (p *EpochPerformance) MissedProposals() uint64 {
+	if p.ProposedBlocks >= p.ProposerDuties {
+		return 0
+	}
+	return p.ProposerDuties - p.ProposedBlocks
+}
+
+// BalanceChange returns the balance change of the validator over the epoch, in Gwei.
+func (p *EpochPerformance) BalanceChange() int64 {
+	return int64(p.EndBalance) - int64(p.StartBalance) // lint:ignore uintcast -- Balances are far below the int64 limit.
+}
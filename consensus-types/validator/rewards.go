@@ -0,0 +1,24 @@
+package validator
+
+import "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+
+// EpochRewards holds the rewards and penalties, in Gwei, a validator received for its duties of an epoch.
+// Penalties are represented as negative values.
+type EpochRewards struct {
+	ValidatorIndex primitives.ValidatorIndex
+	Epoch          primitives.Epoch
+	// Attestation rewards and penalties, as computed for the epoch during the epoch transition.
+	Head       int64
+	Source     int64
+	Target     int64
+	Inactivity int64
+	// Proposer rewards of the blocks proposed by the validator during the epoch.
+	Proposer int64
+	// Sync committee rewards and penalties of the blocks proposed during the epoch.
+	SyncCommittee int64
+}
+
+// Total returns the sum of all rewards and penalties.
+func (r *EpochRewards) Total() int64 {
+	return r.Head + r.Source + r.Target + r.Inactivity + r.Proposer + r.SyncCommittee
+}
@@ -23,7 +23,7 @@ func (x *SyncCommittee) Equals(other *SyncCommittee) bool {
 }
 
 func FloorLog2(x uint64) int {
-	return bits.Len64(x - 1)
+	return bits.Len64(x) - 1
 }
 
 func isEmptyWithLength(bb [][]byte, length uint64) bool {
@@ -35,8 +35,11 @@ func isEmptyWithLength(bb [][]byte, length uint64) bool {
 		return false
 	}
 	for _, b := range bb {
-		if !bytes.Equal(b, []byte{}) {
-			return false
+		// Empty roots are serialized as zero-filled Bytes32 values.
+		for _, v := range b {
+			if v != 0 {
+				return false
+			}
 		}
 	}
 	return true
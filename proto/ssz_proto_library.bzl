@@ -26,6 +26,9 @@ mainnet = {
     "max_blobs_per_block.size": "6",
     "max_blob_commitments.size": "4096",
     "kzg_commitment_inclusion_proof_depth.size": "17",
+    "current_sync_committee_branch.size": "5",
+    "next_sync_committee_branch.size": "5",
+    "finality_branch.size": "6",
 }
 
 minimal = {
@@ -48,6 +51,9 @@ minimal = {
     "max_blobs_per_block.size": "6",
     "max_blob_commitments.size": "16",
     "kzg_commitment_inclusion_proof_depth.size": "9",
+    "current_sync_committee_branch.size": "5",
+    "next_sync_committee_branch.size": "5",
+    "finality_branch.size": "6",
 }
 
 ###### Rules definitions #######
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	primitives "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	validator "github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	iface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	gomock "go.uber.org/mock/gomock"
@@ -55,3 +56,45 @@ func (mr *MockPrysmBeaconChainClientMockRecorder) GetValidatorCount(arg0, arg1,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorCount", reflect.TypeOf((*MockPrysmBeaconChainClient)(nil).GetValidatorCount), arg0, arg1, arg2)
 }
+
+// SetBuilderRelays mocks base method.
+func (m *MockPrysmBeaconChainClient) SetBuilderRelays(arg0 context.Context, arg1 map[primitives.ValidatorIndex][]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBuilderRelays", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBuilderRelays indicates an expected call of SetBuilderRelays.
+func (mr *MockPrysmBeaconChainClientMockRecorder) SetBuilderRelays(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBuilderRelays", reflect.TypeOf((*MockPrysmBeaconChainClient)(nil).SetBuilderRelays), arg0, arg1)
+}
+
+// TrackValidators mocks base method.
+func (m *MockPrysmBeaconChainClient) TrackValidators(arg0 context.Context, arg1 [][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackValidators", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackValidators indicates an expected call of TrackValidators.
+func (mr *MockPrysmBeaconChainClientMockRecorder) TrackValidators(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackValidators", reflect.TypeOf((*MockPrysmBeaconChainClient)(nil).TrackValidators), arg0, arg1)
+}
+
+// UntrackValidators mocks base method.
+func (m *MockPrysmBeaconChainClient) UntrackValidators(arg0 context.Context, arg1 [][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntrackValidators", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntrackValidators indicates an expected call of UntrackValidators.
+func (mr *MockPrysmBeaconChainClientMockRecorder) UntrackValidators(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntrackValidators", reflect.TypeOf((*MockPrysmBeaconChainClient)(nil).UntrackValidators), arg0, arg1)
+}
@@ -0,0 +1,149 @@
+/*
+Tool for replaying the engine API calls recorded by a beacon node run with --execution-recording-file.
+
+The recorded calls are either sent again, in order, to an execution client, reporting the responses which differ
+from the recorded ones, or answered with the recorded responses by a stub execution client which a beacon node can
+be pointed at. The stub answers the chain ID, sync status and block requests a beacon node makes when connecting for
+the chain ID given with --chain-id.
+
+Recordings made with backup execution engines hold the calls to every engine. Only the calls to the primary engine are
+replayed or served, or the calls to the engine given with --recorded-endpoint.
+*/
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/recorder"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	recordings       = flag.String("recordings", "", "comma-separated engine API recording files, oldest first")
+	recordedEndpoint = flag.String("recorded-endpoint", "", "recorded execution engine endpoint whose calls are replayed or served, the primary engine by default")
+	endpoint         = flag.String("endpoint", "", "execution client endpoint to replay the recorded calls against")
+	jwtSecret        = flag.String("jwt-secret", "", "file containing the hex-encoded JWT secret of the execution client")
+	realTime         = flag.Bool("real-time", false, "wait between calls as long as between the recorded calls")
+	serve            = flag.String("serve", "", "host:port to serve the recorded responses at, as a stub execution client")
+	chainID          = flag.Uint64("chain-id", params.MainnetConfig().DepositChainID, "execution chain ID the stub execution client reports")
+	timeout          = flag.Duration("timeout", 30*time.Second, "timeout of each replayed call")
+)
+
+func main() {
+	flag.Parse()
+	if *recordings == "" {
+		log.Fatal("Must provide --recordings")
+	}
+	if (*endpoint == "") == (*serve == "") {
+		log.Fatal("Must provide either --endpoint or --serve")
+	}
+
+	var entries []*recorder.Entry
+	for _, path := range strings.Split(*recordings, ",") {
+		fileEntries, err := recorder.ReadFile(strings.TrimSpace(path))
+		if err != nil {
+			log.WithError(err).Fatalf("Could not read recording %s", path)
+		}
+		entries = append(entries, fileEntries...)
+	}
+	entries = recorder.Filter(entries, *recordedEndpoint)
+	if len(entries) == 0 {
+		log.WithField("recordedEndpoint", *recordedEndpoint).Fatal("No call recorded for the execution engine")
+	}
+	log.WithField("numCalls", len(entries)).Info("Read engine API recording")
+
+	if *serve != "" {
+		log.WithField("address", *serve).Info("Serving recorded engine API responses")
+		srv := &http.Server{Addr: *serve, Handler: recorder.NewStub(entries, *chainID), ReadHeaderTimeout: time.Second}
+		log.Fatal(srv.ListenAndServe())
+	}
+	if numDiffs := replay(entries); numDiffs > 0 {
+		os.Exit(1)
+	}
+}
+
+// replay sends the recorded calls to the execution client, and returns the number of responses differing from the
+// recorded ones.
+func replay(entries []*recorder.Entry) int {
+	ctx := context.Background()
+	e := network.HttpEndpoint(*endpoint)
+	if *jwtSecret != "" {
+		secret, err := readJWTSecret(*jwtSecret)
+		if err != nil {
+			log.WithError(err).Fatal("Could not read JWT secret")
+		}
+		e.Auth.Method = authorization.Bearer
+		e.Auth.Value = string(secret)
+	}
+	client, err := network.NewExecutionRPCClient(ctx, e, http.Header{})
+	if err != nil {
+		log.WithError(err).Fatal("Could not dial execution client")
+	}
+	defer client.Close()
+
+	numDiffs := 0
+	for i, entry := range entries {
+		if *realTime && i > 0 {
+			time.Sleep(entry.Time.Sub(entries[i-1].Time))
+		}
+		var params []json.RawMessage
+		if err := json.Unmarshal(entry.Params, &params); err != nil {
+			log.WithError(err).Fatalf("Could not parse parameters of recorded call %d", i)
+		}
+		args := make([]interface{}, len(params))
+		for j := range params {
+			args[j] = params[j]
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, *timeout)
+		var result json.RawMessage
+		start := time.Now()
+		err := client.CallContext(callCtx, &result, entry.Method, args...)
+		cancel()
+		fields := log.Fields{
+			"call":             i,
+			"method":           entry.Method,
+			"recordedDuration": entry.Duration,
+			"duration":         time.Since(start),
+		}
+		if diff := recorder.Diff(entry, result, err); len(diff) > 0 {
+			numDiffs++
+			fields["differingFields"] = diff
+			fields["recordedResult"] = string(entry.Result)
+			fields["result"] = string(result)
+			if entry.Error != nil {
+				fields["recordedError"] = entry.Error.Message
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			}
+			log.WithFields(fields).Warn("Response differs from the recorded response")
+			continue
+		}
+		log.WithFields(fields).Debug("Response matches the recorded response")
+	}
+	log.WithFields(log.Fields{
+		"numCalls":     len(entries),
+		"numDiffering": numDiffs,
+	}).Info("Replayed engine API recording")
+	return numDiffs
+}
+
+func readJWTSecret(path string) ([]byte, error) {
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(enc)), "0x"))
+}
@@ -191,6 +191,11 @@ func (*Validator) HasProposerSettings() bool {
 	panic("implement me")
 }
 
+// RegisterMonitoredValidators for mocking
+func (_ *Validator) RegisterMonitoredValidators(_ context.Context) {
+	panic("implement me")
+}
+
 // PushProposerSettings for mocking
 func (_ *Validator) PushProposerSettings(_ context.Context, _ keymanager.IKeymanager, _ primitives.Slot, _ time.Time) error {
 	panic("implement me")
@@ -24,15 +24,32 @@ func (c beaconApiValidatorClient) getAttestationData(
 	query := buildURL("/eth/v1/validator/attestation_data", params)
 	produceAttestationDataResponseJson := structs.GetAttestationDataResponse{}
 
+	if c.attestationDataConsensus {
+		if handler, ok := c.jsonRestHandler.(*FailoverJsonRestHandler); ok {
+			genesis, err := c.genesisProvider.GetGenesis(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get genesis")
+			}
+			genesisTime, err := strconv.ParseUint(genesis.GenesisTime, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse genesis time: %s", genesis.GenesisTime)
+			}
+			return getAttestationDataConsensus(ctx, handler, query, attestationDataDeadline(genesisTime, reqSlot))
+		}
+	}
+
 	if err := c.jsonRestHandler.Get(ctx, query, &produceAttestationDataResponseJson); err != nil {
 		return nil, err
 	}
 
-	if produceAttestationDataResponseJson.Data == nil {
+	return attestationDataFromJson(produceAttestationDataResponseJson.Data)
+}
+
+func attestationDataFromJson(attestationData *structs.AttestationData) (*ethpb.AttestationData, error) {
+	if attestationData == nil {
 		return nil, errors.New("attestation data is nil")
 	}
 
-	attestationData := produceAttestationDataResponseJson.Data
 	committeeIndex, err := strconv.ParseUint(attestationData.CommitteeIndex, 10, 64)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse attestation committee index: %s", attestationData.CommitteeIndex)
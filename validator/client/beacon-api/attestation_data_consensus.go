@@ -0,0 +1,224 @@
+package beacon_api
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// attestationDataVote is attestation data returned by one or more beacon nodes.
+type attestationDataVote struct {
+	data  *ethpb.AttestationData
+	hosts []string
+}
+
+// attestationDataTally counts the beacon nodes returning each attestation data.
+type attestationDataTally struct {
+	votes        []*attestationDataVote
+	votesByRoot  map[[32]byte]*attestationDataVote
+	numResponses int
+	maxVotes     int
+	firstErr     error
+}
+
+func newAttestationDataTally() *attestationDataTally {
+	return &attestationDataTally{votesByRoot: make(map[[32]byte]*attestationDataVote)}
+}
+
+// add counts the attestation data of a beacon node response, returning the error of a node failing to return it.
+func (t *attestationDataTally) add(r *NodeResponse) error {
+	err := func() error {
+		if r.Err != nil {
+			return r.Err
+		}
+		data, err := attestationDataFromJson(r.Resp.(*structs.GetAttestationDataResponse).Data)
+		if err != nil {
+			return err
+		}
+		root, err := data.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to compute attestation data root")
+		}
+		vote, ok := t.votesByRoot[root]
+		if !ok {
+			vote = &attestationDataVote{data: data}
+			t.votesByRoot[root] = vote
+			t.votes = append(t.votes, vote)
+		}
+		vote.hosts = append(vote.hosts, r.Host)
+		t.numResponses++
+		if len(vote.hosts) > t.maxVotes {
+			t.maxVotes = len(vote.hosts)
+		}
+		return nil
+	}()
+	if err != nil && t.firstErr == nil {
+		t.firstErr = err
+	}
+	return err
+}
+
+// attestationDataGracePeriod is how long the other beacon nodes are waited for once a node returned attestation data.
+const attestationDataGracePeriod = 500 * time.Millisecond
+
+// attestationDataDeadline returns when to stop waiting for attestation data, halfway through the slot, well before the
+// attestations of the slot are aggregated at two thirds of the slot.
+func attestationDataDeadline(genesisTime uint64, slot primitives.Slot) time.Time {
+	return slots.StartTime(genesisTime, slot).Add(slots.DivideSlotBy(2 /* half of slot duration */))
+}
+
+// getAttestationDataConsensus requests the attestation data from all the reachable beacon nodes, and returns the
+// attestation data a majority of the nodes which answered agree on. Without a majority, it returns the attestation
+// data with the highest-slot head a majority of them know about, or else the attestation data most of them agree on.
+// Once a node answered, the others are only waited for during a grace period, and never past the deadline.
+func getAttestationDataConsensus(
+	ctx context.Context,
+	handler *FailoverJsonRestHandler,
+	query string,
+	deadline time.Time,
+) (*ethpb.AttestationData, error) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	// Nodes answering first are tallied as they answer, to stop waiting for the others once a majority agrees or
+	// the grace period following the first attestation data ends.
+	getCtx, cancelGet := context.WithCancel(ctx)
+	defer cancelGet()
+	var grace *time.Timer
+	early := newAttestationDataTally()
+	responses := handler.GetAll(getCtx, query, func() interface{} {
+		return &structs.GetAttestationDataResponse{}
+	}, func(r *NodeResponse, numNodes int) bool {
+		if early.add(r) == nil && grace == nil {
+			grace = time.AfterFunc(attestationDataGracePeriod, cancelGet)
+		}
+		return early.maxVotes*2 > numNodes
+	})
+	if grace != nil {
+		grace.Stop()
+	}
+
+	// The responses are tallied again in the order of the nodes' health, for votes to be ordered by the health of
+	// the healthiest node which returned them.
+	tally := newAttestationDataTally()
+	for _, r := range responses {
+		if err := tally.add(r); err != nil {
+			log.WithError(err).WithField("host", r.Host).Debug("Could not get attestation data from beacon node")
+		}
+	}
+	if tally.numResponses == 0 {
+		if tally.firstErr == nil {
+			return nil, errors.Wrap(ctx.Err(), "no beacon node returned attestation data")
+		}
+		return nil, tally.firstErr
+	}
+	votes := tally.votes
+	if len(votes) == 1 {
+		return votes[0].data, nil
+	}
+
+	// Votes are ordered by number of nodes, then by the health of the healthiest node which returned them.
+	sort.SliceStable(votes, func(i, j int) bool {
+		return len(votes[i].hosts) > len(votes[j].hosts)
+	})
+	chosen := votes[0]
+	if len(chosen.hosts)*2 <= tally.numResponses {
+		if vote := highestKnownHead(ctx, handler, votes, tally.numResponses); vote != nil {
+			chosen = vote
+		}
+	}
+	logAttestationDataDisagreement(chosen, votes, tally.numResponses)
+	return chosen.data, nil
+}
+
+// highestKnownHead returns the vote with the highest-slot head which a majority of the nodes know about, or nil when
+// a majority of the nodes know about no head. The heads of all the votes are looked up at once, during a grace period.
+func highestKnownHead(
+	ctx context.Context,
+	handler *FailoverJsonRestHandler,
+	votes []*attestationDataVote,
+	numNodes int,
+) *attestationDataVote {
+	ctx, cancel := context.WithTimeout(ctx, attestationDataGracePeriod)
+	defer cancel()
+
+	known := make([]bool, len(votes))
+	headSlots := make([]primitives.Slot, len(votes))
+	var wg sync.WaitGroup
+	for i, vote := range votes {
+		wg.Add(1)
+		go func(i int, vote *attestationDataVote) {
+			defer wg.Done()
+			headRoot := hexutil.Encode(vote.data.BeaconBlockRoot)
+			numKnown := 0
+			handler.GetAll(ctx, "/eth/v1/beacon/headers/"+headRoot, func() interface{} {
+				return &structs.GetBlockHeaderResponse{}
+			}, func(r *NodeResponse, _ int) bool {
+				slot, ok := headerSlot(r)
+				if !ok {
+					return false
+				}
+				headSlots[i] = slot
+				numKnown++
+				return numKnown*2 > numNodes
+			})
+			known[i] = numKnown*2 > numNodes
+		}(i, vote)
+	}
+	wg.Wait()
+
+	var highest *attestationDataVote
+	var highestSlot primitives.Slot
+	for i, vote := range votes {
+		if !known[i] {
+			continue
+		}
+		if highest == nil || headSlots[i] > highestSlot {
+			highest = vote
+			highestSlot = headSlots[i]
+		}
+	}
+	return highest
+}
+
+// headerSlot returns the slot of the block header a beacon node returned, and whether it returned one.
+func headerSlot(r *NodeResponse) (primitives.Slot, bool) {
+	if r.Err != nil {
+		return 0, false
+	}
+	resp := r.Resp.(*structs.GetBlockHeaderResponse)
+	if resp.Data == nil || resp.Data.Header == nil || resp.Data.Header.Message == nil {
+		return 0, false
+	}
+	slot, err := strconv.ParseUint(resp.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return primitives.Slot(slot), true
+}
+
+func logAttestationDataDisagreement(chosen *attestationDataVote, votes []*attestationDataVote, numResponses int) {
+	for _, vote := range votes {
+		log.WithFields(logrus.Fields{
+			"slot":            chosen.data.Slot,
+			"beaconBlockRoot": hexutil.Encode(vote.data.BeaconBlockRoot),
+			"sourceEpoch":     vote.data.Source.Epoch,
+			"targetEpoch":     vote.data.Target.Epoch,
+			"targetRoot":      hexutil.Encode(vote.data.Target.Root),
+			"hosts":           vote.hosts,
+			"numNodes":        len(vote.hosts),
+			"numResponses":    numResponses,
+			"chosen":          vote == chosen,
+		}).Warn("Beacon nodes disagree on attestation data")
+	}
+}
@@ -0,0 +1,201 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	headA = "0x" + strings.Repeat("aa", 32)
+	headB = "0x" + strings.Repeat("bb", 32)
+)
+
+// newAttestationDataServer returns a beacon node voting for the given head, and knowing about the given blocks.
+func newAttestationDataServer(t *testing.T, head string, knownBlocks map[string]string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/attestation_data", func(w http.ResponseWriter, _ *http.Request) {
+		if head == "" {
+			httputil.HandleError(w, "node is syncing", http.StatusServiceUnavailable)
+			return
+		}
+		checkpoint := &structs.Checkpoint{Epoch: "1", Root: "0x" + strings.Repeat("cc", 32)}
+		httputil.WriteJson(w, &structs.GetAttestationDataResponse{Data: &structs.AttestationData{
+			Slot:            "40",
+			CommitteeIndex:  "2",
+			BeaconBlockRoot: head,
+			Source:          checkpoint,
+			Target:          checkpoint,
+		}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/headers/", func(w http.ResponseWriter, r *http.Request) {
+		slot, ok := knownBlocks[strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/headers/")]
+		if !ok {
+			httputil.HandleError(w, "block not found", http.StatusNotFound)
+			return
+		}
+		httputil.WriteJson(w, &structs.GetBlockHeaderResponse{Data: &structs.SignedBeaconBlockHeaderContainer{
+			Header: &structs.SignedBeaconBlockHeader{Message: &structs.BeaconBlockHeader{Slot: slot}},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newHangingServer returns a beacon node which does not answer until the request is cancelled, counting the requests
+// it receives.
+func newHangingServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newConsensusClient returns a validator client requesting attestation data from the given beacon nodes, for slot 40
+// to have started the given time before now.
+func newConsensusClient(
+	t *testing.T,
+	consensus bool,
+	sinceSlotStart time.Duration,
+	servers ...*httptest.Server,
+) (beaconApiValidatorClient, *FailoverJsonRestHandler) {
+	hosts := make([]string, len(servers))
+	for i, server := range servers {
+		hosts[i] = server.URL
+	}
+	ctrl := gomock.NewController(t)
+	genesisProvider := mock.NewMockGenesisProvider(ctrl)
+	slotStart := time.Now().Add(-sinceSlotStart)
+	genesisTime := slotStart.Unix() - int64(40*params.BeaconConfig().SecondsPerSlot)
+	genesisProvider.EXPECT().GetGenesis(gomock.Any()).Return(
+		&structs.Genesis{GenesisTime: strconv.FormatInt(genesisTime, 10)},
+		nil,
+	).AnyTimes()
+	handler := NewFailoverJsonRestHandler(http.Client{Timeout: 10 * time.Second}, hosts, false)
+	c := beaconApiValidatorClient{genesisProvider: genesisProvider, jsonRestHandler: handler, attestationDataConsensus: consensus}
+	return c, handler
+}
+
+func getConsensusAttestationData(t *testing.T, consensus bool, servers ...*httptest.Server) string {
+	c, _ := newConsensusClient(t, consensus, 0, servers...)
+	data, err := c.getAttestationData(context.Background(), 40, 2)
+	require.NoError(t, err)
+	return hexutil.Encode(data.BeaconBlockRoot)
+}
+
+func TestGetAttestationData_Consensus(t *testing.T) {
+	t.Run("majority", func(t *testing.T) {
+		head := getConsensusAttestationData(t, true,
+			newAttestationDataServer(t, headB, nil),
+			newAttestationDataServer(t, headA, nil),
+			newAttestationDataServer(t, headA, nil),
+		)
+		assert.Equal(t, headA, head)
+	})
+	t.Run("highest head known by a majority", func(t *testing.T) {
+		known := map[string]string{headA: "39", headB: "40"}
+		head := getConsensusAttestationData(t, true,
+			newAttestationDataServer(t, headA, map[string]string{headA: "39"}),
+			newAttestationDataServer(t, headB, known),
+			newAttestationDataServer(t, headB, known),
+			newAttestationDataServer(t, "", known),
+		)
+		assert.Equal(t, headB, head)
+
+		// A head only the node voting for it knows about is not chosen, even with a higher slot.
+		head = getConsensusAttestationData(t, true,
+			newAttestationDataServer(t, headB, map[string]string{headA: "39", headB: "40"}),
+			newAttestationDataServer(t, headA, map[string]string{headA: "39"}),
+		)
+		assert.Equal(t, headA, head)
+	})
+	t.Run("most votes when no head is known by a majority", func(t *testing.T) {
+		head := getConsensusAttestationData(t, true,
+			newAttestationDataServer(t, headB, nil),
+			newAttestationDataServer(t, headA, nil),
+		)
+		assert.Equal(t, headB, head)
+	})
+	t.Run("all nodes failing", func(t *testing.T) {
+		c, _ := newConsensusClient(t, true, 0, newAttestationDataServer(t, "", nil))
+		_, err := c.getAttestationData(context.Background(), 40, 2)
+		require.ErrorContains(t, "node is syncing", err)
+	})
+	t.Run("returns once a majority agrees", func(t *testing.T) {
+		var requests atomic.Int32
+		start := time.Now()
+		head := getConsensusAttestationData(t, true,
+			newHangingServer(t, &requests),
+			newAttestationDataServer(t, headA, nil),
+			newAttestationDataServer(t, headA, nil),
+		)
+		assert.Equal(t, headA, head)
+		assert.Equal(t, true, time.Since(start) < 5*time.Second)
+	})
+	t.Run("waits for slow nodes during a grace period", func(t *testing.T) {
+		var requests atomic.Int32
+		// Slot 40 just started, the deadline is half a slot away.
+		c, _ := newConsensusClient(t, true, 0,
+			newHangingServer(t, &requests),
+			newAttestationDataServer(t, headA, nil),
+		)
+		start := time.Now()
+		data, err := c.getAttestationData(context.Background(), 40, 2)
+		require.NoError(t, err)
+		assert.Equal(t, headA, hexutil.Encode(data.BeaconBlockRoot))
+		assert.Equal(t, true, time.Since(start) < attestationDataGracePeriod+time.Second)
+	})
+	t.Run("does not wait for nodes past the deadline", func(t *testing.T) {
+		var requests atomic.Int32
+		// Slot 40 started most of half a slot ago, leaving at most a second before the deadline.
+		c, _ := newConsensusClient(t, true, slots.DivideSlotBy(2)-time.Second, newHangingServer(t, &requests))
+		start := time.Now()
+		_, err := c.getAttestationData(context.Background(), 40, 2)
+		require.ErrorContains(t, "no beacon node returned attestation data", err)
+		assert.Equal(t, true, time.Since(start) < 2*time.Second)
+		assert.Equal(t, true, attestationDataDeadline(0, 1).Before(slots.StartTime(0, 1).Add(2*slots.DivideSlotBy(3))))
+	})
+	t.Run("skips unreachable nodes", func(t *testing.T) {
+		var requests atomic.Int32
+		c, handler := newConsensusClient(t, true, 0,
+			newAttestationDataServer(t, headA, nil),
+			newHangingServer(t, &requests),
+			newAttestationDataServer(t, headB, map[string]string{headA: "39", headB: "40"}),
+		)
+		handler.setHealth(1, nodeUnreachable, 0)
+		data, err := c.getAttestationData(context.Background(), 40, 2)
+		require.NoError(t, err)
+		// Of the two reachable nodes, only the one voting for head B knows about it.
+		assert.Equal(t, headA, hexutil.Encode(data.BeaconBlockRoot))
+		assert.Equal(t, int32(0), requests.Load())
+	})
+	t.Run("disabled", func(t *testing.T) {
+		head := getConsensusAttestationData(t, false,
+			newAttestationDataServer(t, headB, nil),
+			newAttestationDataServer(t, headA, nil),
+			newAttestationDataServer(t, headA, nil),
+		)
+		assert.Equal(t, headB, head)
+	})
+}
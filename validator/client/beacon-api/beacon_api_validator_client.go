@@ -18,13 +18,22 @@ import (
 type ValidatorClientOpt func(*beaconApiValidatorClient)
 
 type beaconApiValidatorClient struct {
-	genesisProvider         GenesisProvider
-	dutiesProvider          dutiesProvider
-	stateValidatorsProvider StateValidatorsProvider
-	jsonRestHandler         JsonRestHandler
-	beaconBlockConverter    BeaconBlockConverter
-	prysmBeaconChainCLient  iface.PrysmBeaconChainClient
-	isEventStreamRunning    bool
+	genesisProvider          GenesisProvider
+	dutiesProvider           dutiesProvider
+	stateValidatorsProvider  StateValidatorsProvider
+	jsonRestHandler          JsonRestHandler
+	beaconBlockConverter     BeaconBlockConverter
+	prysmBeaconChainCLient   iface.PrysmBeaconChainClient
+	isEventStreamRunning     bool
+	attestationDataConsensus bool
+}
+
+// WithAttestationDataConsensus requests attestation data from all the beacon nodes of a FailoverJsonRestHandler, for
+// a beacon node stuck on a minority fork not to make the validators vote for it.
+func WithAttestationDataConsensus() ValidatorClientOpt {
+	return func(c *beaconApiValidatorClient) {
+		c.attestationDataConsensus = true
+	}
 }
 
 func NewBeaconApiValidatorClient(jsonRestHandler JsonRestHandler, opts ...ValidatorClientOpt) iface.ValidatorClient {
@@ -34,7 +43,7 @@ func NewBeaconApiValidatorClient(jsonRestHandler JsonRestHandler, opts ...Valida
 		stateValidatorsProvider: beaconApiStateValidatorsProvider{jsonRestHandler: jsonRestHandler},
 		jsonRestHandler:         jsonRestHandler,
 		beaconBlockConverter:    beaconApiBeaconBlockConverter{},
-		prysmBeaconChainCLient: prysmBeaconChainClient{
+		prysmBeaconChainCLient: &prysmBeaconChainClient{
 			nodeClient:      &beaconApiNodeClient{jsonRestHandler: jsonRestHandler},
 			jsonRestHandler: jsonRestHandler,
 		},
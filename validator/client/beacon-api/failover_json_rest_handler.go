@@ -0,0 +1,411 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+// broadcastEndpoints are the endpoints a signed message is posted to on all healthy beacon nodes when broadcasting
+// is enabled, so that a duty is not missed when the node the validator client uses fails.
+var broadcastEndpoints = map[string]bool{
+	"/eth/v1/beacon/blocks":                     true,
+	"/eth/v1/beacon/blinded_blocks":             true,
+	"/eth/v1/beacon/pool/attestations":          true,
+	"/eth/v1/validator/aggregate_and_proofs":    true,
+	"/eth/v1/beacon/pool/sync_committees":       true,
+	"/eth/v1/validator/contribution_and_proofs": true,
+}
+
+// nodeHealth is the health of a beacon node, from the healthiest to the least healthy.
+type nodeHealth int
+
+const (
+	// nodeSynced is a node which is synced, and whose head is not optimistic.
+	nodeSynced nodeHealth = iota
+	// nodeSyncing is a node which answers requests, but which is syncing or whose head is optimistic.
+	nodeSyncing
+	// nodeUnreachable is a node which could not be reached, or which is not ready to serve requests.
+	nodeUnreachable
+)
+
+func (h nodeHealth) String() string {
+	switch h {
+	case nodeSynced:
+		return "synced"
+	case nodeSyncing:
+		return "syncing"
+	default:
+		return "unreachable"
+	}
+}
+
+type beaconNode struct {
+	handler      BeaconApiJsonRestHandler
+	health       nodeHealth
+	syncDistance uint64
+}
+
+// FailoverJsonRestHandler is a JsonRestHandler spreading requests over several beacon nodes. Each request is sent to
+// the healthiest node, the first configured node being preferred between equally healthy nodes, and is sent to the
+// next node when a node cannot serve it.
+type FailoverJsonRestHandler struct {
+	client    http.Client
+	broadcast bool
+	nodes     []*beaconNode
+	lock      sync.RWMutex
+}
+
+// NewFailoverJsonRestHandler returns a JsonRestHandler for the given beacon node hosts. When broadcast is set, signed
+// blocks, attestations, aggregates and sync committee messages are posted to all healthy nodes at once.
+func NewFailoverJsonRestHandler(client http.Client, hosts []string, broadcast bool) *FailoverJsonRestHandler {
+	nodes := make([]*beaconNode, len(hosts))
+	for i, host := range hosts {
+		nodes[i] = &beaconNode{
+			handler: BeaconApiJsonRestHandler{client: client, host: host},
+			// Nodes are considered synced until checked, for the first configured node to be used first.
+			health: nodeSynced,
+		}
+	}
+	return &FailoverJsonRestHandler{
+		client:    client,
+		broadcast: broadcast,
+		nodes:     nodes,
+	}
+}
+
+// NewJsonRestHandler returns a JsonRestHandler for the comma-separated list of beacon node hosts. Several hosts are
+// served by a FailoverJsonRestHandler, whose health checks run until the context is done.
+func NewJsonRestHandler(ctx context.Context, client http.Client, hosts string, broadcast bool) JsonRestHandler {
+	var endpoints []string
+	for _, host := range strings.Split(hosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			endpoints = append(endpoints, host)
+		}
+	}
+	if len(endpoints) <= 1 {
+		return NewBeaconApiJsonRestHandler(client, strings.TrimSpace(hosts))
+	}
+	handler := NewFailoverJsonRestHandler(client, endpoints, broadcast)
+	go handler.MonitorHealth(ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+	return handler
+}
+
+// HttpClient returns the underlying HTTP client of the handler
+func (c *FailoverJsonRestHandler) HttpClient() *http.Client {
+	return &c.client
+}
+
+// Host returns the host of the healthiest beacon node.
+func (c *FailoverJsonRestHandler) Host() string {
+	return c.nodes[c.orderedNodes()[0]].handler.host
+}
+
+// Get sends a GET request to the healthiest beacon node able to serve it.
+func (c *FailoverJsonRestHandler) Get(ctx context.Context, endpoint string, resp interface{}) error {
+	return c.failover(ctx, func(node BeaconApiJsonRestHandler) error {
+		return node.Get(ctx, endpoint, resp)
+	})
+}
+
+// Post sends a POST request to the healthiest beacon node able to serve it. When broadcasting is enabled, signed
+// messages are posted to all healthy nodes at once instead.
+func (c *FailoverJsonRestHandler) Post(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	data *bytes.Buffer,
+	resp interface{},
+) error {
+	if data == nil {
+		return errors.New("data is nil")
+	}
+	// The body is read by each request, so each request is given its own buffer.
+	body := data.Bytes()
+	if c.broadcast && broadcastEndpoints[apiEndpoint] {
+		return c.broadcastPost(ctx, apiEndpoint, headers, body, resp)
+	}
+	return c.failover(ctx, func(node BeaconApiJsonRestHandler) error {
+		return node.Post(ctx, apiEndpoint, headers, bytes.NewBuffer(body), resp)
+	})
+}
+
+// Delete sends a DELETE request to the healthiest beacon node able to serve it.
+func (c *FailoverJsonRestHandler) Delete(ctx context.Context, apiEndpoint string, data *bytes.Buffer, resp interface{}) error {
+	var body []byte
+	if data != nil {
+		body = data.Bytes()
+	}
+	return c.failover(ctx, func(node BeaconApiJsonRestHandler) error {
+		var buf *bytes.Buffer
+		if body != nil {
+			buf = bytes.NewBuffer(body)
+		}
+		return node.Delete(ctx, apiEndpoint, buf, resp)
+	})
+}
+
+// failover performs the request against the beacon nodes, the healthiest first, until a node serves it. A node
+// failing to serve the request is considered unreachable until its next health check.
+func (c *FailoverJsonRestHandler) failover(ctx context.Context, request func(node BeaconApiJsonRestHandler) error) error {
+	var err error
+	for i, idx := range c.orderedNodes() {
+		node := c.nodes[idx]
+		if err = request(node.handler); err == nil || !isNodeFailure(ctx, err) {
+			return err
+		}
+		c.setHealth(idx, nodeUnreachable, 0)
+		log.WithError(err).WithFields(logrus.Fields{
+			"host":         node.handler.host,
+			"attemptsLeft": len(c.nodes) - i - 1,
+		}).Warn("Beacon node failed to serve request, failing over to the next beacon node")
+	}
+	return err
+}
+
+// broadcastPost posts the request to all the healthy beacon nodes at once, or to all nodes when none is healthy. It
+// succeeds as soon as one node accepts the request. The response is only decoded from the healthiest node.
+func (c *FailoverJsonRestHandler) broadcastPost(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	body []byte,
+	resp interface{},
+) error {
+	targets := c.reachableNodes()
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, idx := range targets {
+		wg.Add(1)
+		go func(i, idx int) {
+			defer wg.Done()
+			var nodeResp interface{}
+			if i == 0 {
+				nodeResp = resp
+			}
+			errs[i] = c.nodes[idx].handler.Post(ctx, apiEndpoint, headers, bytes.NewBuffer(body), nodeResp)
+		}(i, idx)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if isNodeFailure(ctx, err) {
+			c.setHealth(targets[i], nodeUnreachable, 0)
+		}
+		log.WithError(err).WithField("host", c.nodes[targets[i]].handler.host).Debug("Beacon node failed to accept broadcast request")
+	}
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+	return errs[0]
+}
+
+// NodeResponse is the response of a beacon node to a request sent to all the beacon nodes.
+type NodeResponse struct {
+	Host string
+	Resp interface{}
+	Err  error
+}
+
+// GetAll sends a GET request to all the reachable beacon nodes at once, or to all nodes when none is reachable, each
+// response being decoded into a new object of newResp. done, when not nil, is called with each response as it arrives
+// and the number of nodes the request was sent to. GetAll returns the responses received, the healthiest node first,
+// once all the nodes answered, done returned true, or the context is done.
+func (c *FailoverJsonRestHandler) GetAll(
+	ctx context.Context,
+	endpoint string,
+	newResp func() interface{},
+	done func(r *NodeResponse, numNodes int) bool,
+) []*NodeResponse {
+	targets := c.reachableNodes()
+	type result struct {
+		i    int
+		resp *NodeResponse
+	}
+	// The channel is buffered for requests still in flight when GetAll returns not to block.
+	results := make(chan result, len(targets))
+	for i, idx := range targets {
+		go func(i, idx int) {
+			node := c.nodes[idx].handler
+			resp := newResp()
+			err := node.Get(ctx, endpoint, resp)
+			if err != nil && isNodeFailure(ctx, err) {
+				c.setHealth(idx, nodeUnreachable, 0)
+			}
+			results <- result{i: i, resp: &NodeResponse{Host: node.host, Resp: resp, Err: err}}
+		}(i, idx)
+	}
+
+	received := make([]*NodeResponse, len(targets))
+loop:
+	for range targets {
+		select {
+		case r := <-results:
+			received[r.i] = r.resp
+			if done != nil && done(r.resp, len(targets)) {
+				break loop
+			}
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	responses := make([]*NodeResponse, 0, len(targets))
+	for _, r := range received {
+		if r != nil {
+			responses = append(responses, r)
+		}
+	}
+	return responses
+}
+
+// MonitorHealth checks the health of all the beacon nodes at each interval, until the context is done.
+func (c *FailoverJsonRestHandler) MonitorHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	c.CheckHealth(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			c.CheckHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// CheckHealth checks the health and sync status of all the beacon nodes, which determines the node requests are
+// routed to.
+func (c *FailoverJsonRestHandler) CheckHealth(ctx context.Context) {
+	previous := c.Host()
+	var wg sync.WaitGroup
+	for idx := range c.nodes {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			health, syncDistance := checkNodeHealth(ctx, c.nodes[idx].handler)
+			c.setHealth(idx, health, syncDistance)
+		}(idx)
+	}
+	wg.Wait()
+
+	best := c.nodes[c.orderedNodes()[0]]
+	c.lock.RLock()
+	health := best.health
+	c.lock.RUnlock()
+	if best.handler.host != previous {
+		log.WithFields(logrus.Fields{
+			"previousHost": previous,
+			"host":         best.handler.host,
+			"health":       health.String(),
+		}).Info("Switched to a healthier beacon node")
+	}
+	if health == nodeUnreachable {
+		log.Warn("No beacon node is reachable")
+	}
+}
+
+// checkNodeHealth returns the health of a beacon node, from its health endpoint and its sync status.
+func checkNodeHealth(ctx context.Context, node BeaconApiJsonRestHandler) (nodeHealth, uint64) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, node.host+"/eth/v1/node/health", nil)
+	if err != nil {
+		return nodeUnreachable, 0
+	}
+	httpResp, err := node.client.Do(req)
+	if err != nil {
+		return nodeUnreachable, 0
+	}
+	if err := httpResp.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close health response body")
+	}
+	// The health endpoint returns 200 when the node is ready, 206 when it is syncing, and 503 when it is not
+	// initialized.
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusPartialContent {
+		return nodeUnreachable, 0
+	}
+
+	syncingResp := structs.SyncStatusResponse{}
+	if err := node.Get(ctx, "/eth/v1/node/syncing", &syncingResp); err != nil || syncingResp.Data == nil {
+		return nodeUnreachable, 0
+	}
+	syncDistance, err := strconv.ParseUint(syncingResp.Data.SyncDistance, 10, 64)
+	if err != nil {
+		return nodeUnreachable, 0
+	}
+	if httpResp.StatusCode != http.StatusOK || syncingResp.Data.IsSyncing || syncingResp.Data.IsOptimistic || syncingResp.Data.ElOffline {
+		return nodeSyncing, syncDistance
+	}
+	return nodeSynced, syncDistance
+}
+
+func (c *FailoverJsonRestHandler) setHealth(idx int, health nodeHealth, syncDistance uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.nodes[idx].health = health
+	c.nodes[idx].syncDistance = syncDistance
+}
+
+// reachableNodes returns the indices of the beacon nodes which are not unreachable, the healthiest first, or of all
+// the nodes when none is reachable.
+func (c *FailoverJsonRestHandler) reachableNodes() []int {
+	ordered := c.orderedNodes()
+	reachable := make([]int, 0, len(ordered))
+	c.lock.RLock()
+	for _, idx := range ordered {
+		if c.nodes[idx].health != nodeUnreachable {
+			reachable = append(reachable, idx)
+		}
+	}
+	c.lock.RUnlock()
+	if len(reachable) == 0 {
+		return ordered
+	}
+	return reachable
+}
+
+// orderedNodes returns the indices of the beacon nodes, the healthiest first. Equally healthy nodes are ordered by
+// sync distance, then in the configured order.
+func (c *FailoverJsonRestHandler) orderedNodes() []int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	ordered := make([]int, len(c.nodes))
+	for i := range ordered {
+		ordered[i] = i
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := c.nodes[ordered[i]], c.nodes[ordered[j]]
+		if a.health != b.health {
+			return a.health < b.health
+		}
+		return a.health == nodeSyncing && a.syncDistance < b.syncDistance
+	})
+	return ordered
+}
+
+// isNodeFailure returns whether the request failed because of the beacon node, in which case it is sent to the next
+// node. A request the node rejected, or which was cancelled, is not retried.
+func isNodeFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	jsonErr := &httputil.DefaultJsonError{}
+	if errors.As(err, &jsonErr) {
+		return jsonErr.Code >= http.StatusInternalServerError
+	}
+	return true
+}
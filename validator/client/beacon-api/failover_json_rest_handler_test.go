@@ -0,0 +1,154 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type testBeaconNode struct {
+	server      *httptest.Server
+	healthCode  int
+	syncing     bool
+	genesisCode int
+	posts       atomic.Int32
+}
+
+func newTestBeaconNode(t *testing.T) *testBeaconNode {
+	node := &testBeaconNode{healthCode: http.StatusOK, genesisCode: http.StatusOK}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/health", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(node.healthCode)
+	})
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, _ *http.Request) {
+		syncDistance := "0"
+		if node.syncing {
+			syncDistance = "100"
+		}
+		httputil.WriteJson(w, &structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{
+			HeadSlot:     "1",
+			SyncDistance: syncDistance,
+			IsSyncing:    node.syncing,
+		}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		if node.genesisCode != http.StatusOK {
+			httputil.HandleError(w, "error", node.genesisCode)
+			return
+		}
+		httputil.WriteJson(w, &structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: node.server.URL}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(w http.ResponseWriter, _ *http.Request) {
+		node.posts.Add(1)
+		w.Header().Set("Content-Type", api.JsonMediaType)
+	})
+	node.server = httptest.NewServer(mux)
+	t.Cleanup(node.server.Close)
+	return node
+}
+
+func newTestFailoverHandler(broadcast bool, nodes ...*testBeaconNode) *FailoverJsonRestHandler {
+	hosts := make([]string, len(nodes))
+	for i, node := range nodes {
+		hosts[i] = node.server.URL
+	}
+	return NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, hosts, broadcast)
+}
+
+func TestFailoverJsonRestHandler_Get(t *testing.T) {
+	ctx := context.Background()
+	first, second := newTestBeaconNode(t), newTestBeaconNode(t)
+	handler := newTestFailoverHandler(false, first, second)
+
+	resp := &structs.GetGenesisResponse{}
+	require.NoError(t, handler.Get(ctx, "/eth/v1/beacon/genesis", resp))
+	assert.Equal(t, first.server.URL, resp.Data.GenesisTime)
+
+	t.Run("fails over when the node fails", func(t *testing.T) {
+		first.genesisCode = http.StatusServiceUnavailable
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, handler.Get(ctx, "/eth/v1/beacon/genesis", resp))
+		assert.Equal(t, second.server.URL, resp.Data.GenesisTime)
+		assert.Equal(t, second.server.URL, handler.Host())
+	})
+	t.Run("does not fail over a rejected request", func(t *testing.T) {
+		second.genesisCode = http.StatusBadRequest
+		err := handler.Get(ctx, "/eth/v1/beacon/genesis", &structs.GetGenesisResponse{})
+		jsonErr := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &jsonErr))
+		assert.Equal(t, http.StatusBadRequest, jsonErr.Code)
+	})
+	t.Run("returns the last error when all nodes fail", func(t *testing.T) {
+		first.server.Close()
+		second.genesisCode = http.StatusInternalServerError
+		// The second node is the healthiest, the first node being tried last.
+		err := handler.Get(ctx, "/eth/v1/beacon/genesis", &structs.GetGenesisResponse{})
+		require.ErrorContains(t, "failed to perform request", err)
+	})
+}
+
+func TestFailoverJsonRestHandler_CheckHealth(t *testing.T) {
+	ctx := context.Background()
+	syncing, synced, down := newTestBeaconNode(t), newTestBeaconNode(t), newTestBeaconNode(t)
+	syncing.healthCode = http.StatusPartialContent
+	syncing.syncing = true
+	down.healthCode = http.StatusServiceUnavailable
+	handler := newTestFailoverHandler(false, down, syncing, synced)
+	assert.Equal(t, down.server.URL, handler.Host())
+
+	handler.CheckHealth(ctx)
+	assert.Equal(t, synced.server.URL, handler.Host())
+	assert.DeepEqual(t, []int{2, 1, 0}, handler.orderedNodes())
+
+	// The first configured node is preferred once it is synced again.
+	down.healthCode = http.StatusOK
+	handler.CheckHealth(ctx)
+	assert.Equal(t, down.server.URL, handler.Host())
+}
+
+func TestFailoverJsonRestHandler_Post(t *testing.T) {
+	ctx := context.Background()
+	body, err := json.Marshal([]*structs.Attestation{})
+	require.NoError(t, err)
+
+	t.Run("posts to the healthiest node", func(t *testing.T) {
+		first, second := newTestBeaconNode(t), newTestBeaconNode(t)
+		handler := newTestFailoverHandler(false, first, second)
+		require.NoError(t, handler.Post(ctx, "/eth/v1/beacon/pool/attestations", nil, bytes.NewBuffer(body), nil))
+		assert.Equal(t, int32(1), first.posts.Load())
+		assert.Equal(t, int32(0), second.posts.Load())
+	})
+	t.Run("broadcasts to all healthy nodes", func(t *testing.T) {
+		first, second, down := newTestBeaconNode(t), newTestBeaconNode(t), newTestBeaconNode(t)
+		handler := newTestFailoverHandler(true, first, second, down)
+		down.server.Close()
+		require.NoError(t, handler.Post(ctx, "/eth/v1/beacon/pool/attestations", nil, bytes.NewBuffer(body), nil))
+		assert.Equal(t, int32(1), first.posts.Load())
+		assert.Equal(t, int32(1), second.posts.Load())
+		assert.DeepEqual(t, []int{0, 1, 2}, handler.orderedNodes())
+		assert.Equal(t, nodeUnreachable, handler.nodes[2].health)
+	})
+}
+
+func TestNewJsonRestHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, ok := NewJsonRestHandler(ctx, http.Client{}, "http://127.0.0.1:3500", false).(*BeaconApiJsonRestHandler)
+	assert.Equal(t, true, ok)
+	handler, ok := NewJsonRestHandler(ctx, http.Client{}, "http://127.0.0.1:3500, http://127.0.0.1:3501", true).(*FailoverJsonRestHandler)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 2, len(handler.nodes))
+	assert.Equal(t, "http://127.0.0.1:3501", handler.nodes[1].handler.host)
+}
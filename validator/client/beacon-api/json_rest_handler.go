@@ -16,6 +16,7 @@ import (
 type JsonRestHandler interface {
 	Get(ctx context.Context, endpoint string, resp interface{}) error
 	Post(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer, resp interface{}) error
+	Delete(ctx context.Context, endpoint string, data *bytes.Buffer, resp interface{}) error
 	HttpClient() *http.Client
 	Host() string
 }
@@ -102,6 +103,35 @@ func (c BeaconApiJsonRestHandler) Post(
 	return decodeResp(httpResp, resp)
 }
 
+// Delete sends a DELETE request with an optional JSON body and decodes the response body as a JSON object into the passed in object.
+// If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the first return value.
+func (c BeaconApiJsonRestHandler) Delete(ctx context.Context, apiEndpoint string, data *bytes.Buffer, resp interface{}) error {
+	url := c.host + apiEndpoint
+	var body io.Reader
+	if data != nil {
+		body = data
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for endpoint %s", url)
+	}
+	if data != nil {
+		req.Header.Set("Content-Type", api.JsonMediaType)
+	}
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to perform request for endpoint %s", url)
+	}
+	defer func() {
+		if err = httpResp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	return decodeResp(httpResp, resp)
+}
+
 func decodeResp(httpResp *http.Response, resp interface{}) error {
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
@@ -95,6 +95,31 @@ func TestPost(t *testing.T) {
 	assert.DeepEqual(t, genesisJson, resp)
 }
 
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	dataBytes := []byte{1, 2, 3, 4, 5}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, api.JsonMediaType, r.Header.Get("Content-Type"))
+
+		receivedBytes, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.DeepEqual(t, dataBytes, receivedBytes)
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jsonRestHandler := BeaconApiJsonRestHandler{
+		client: http.Client{Timeout: time.Second * 5},
+		host:   server.URL,
+	}
+	require.NoError(t, jsonRestHandler.Delete(ctx, endpoint, bytes.NewBuffer(dataBytes), nil))
+}
+
 func Test_decodeResp(t *testing.T) {
 	type j struct {
 		Foo string `json:"foo"`
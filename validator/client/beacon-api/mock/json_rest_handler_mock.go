@@ -77,3 +77,16 @@ func (mr *MockJsonRestHandlerMockRecorder) Post(ctx, endpoint, headers, data, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockJsonRestHandler)(nil).Post), ctx, endpoint, headers, data, resp)
 }
 
+// Delete mocks base method.
+func (m *MockJsonRestHandler) Delete(ctx context.Context, endpoint string, data *bytes.Buffer, resp any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, endpoint, data, resp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockJsonRestHandlerMockRecorder) Delete(ctx, endpoint, data, resp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockJsonRestHandler)(nil).Delete), ctx, endpoint, data, resp)
+}
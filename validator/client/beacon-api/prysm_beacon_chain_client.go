@@ -1,21 +1,27 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	neturl "net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	validator2 "github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 )
 
 // NewPrysmBeaconChainClient returns implementation of iface.PrysmBeaconChainClient.
 func NewPrysmBeaconChainClient(jsonRestHandler JsonRestHandler, nodeClient iface.NodeClient) iface.PrysmBeaconChainClient {
-	return prysmBeaconChainClient{
+	return &prysmBeaconChainClient{
 		jsonRestHandler: jsonRestHandler,
 		nodeClient:      nodeClient,
 	}
@@ -24,17 +30,14 @@ func NewPrysmBeaconChainClient(jsonRestHandler JsonRestHandler, nodeClient iface
 type prysmBeaconChainClient struct {
 	jsonRestHandler JsonRestHandler
 	nodeClient      iface.NodeClient
+	// Whether the beacon node is a prysm beacon node, nil until known.
+	isPrysmLock sync.Mutex
+	isPrysm     *bool
 }
 
-func (c prysmBeaconChainClient) GetValidatorCount(ctx context.Context, stateID string, statuses []validator2.Status) ([]iface.ValidatorCount, error) {
-	// Check node version for prysm beacon node as it is a custom endpoint for prysm beacon node.
-	nodeVersion, err := c.nodeClient.GetVersion(ctx, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get node version")
-	}
-
-	if !strings.Contains(strings.ToLower(nodeVersion.Version), "prysm") {
-		return nil, iface.ErrNotSupported
+func (c *prysmBeaconChainClient) GetValidatorCount(ctx context.Context, stateID string, statuses []validator2.Status) ([]iface.ValidatorCount, error) {
+	if err := c.checkPrysmNode(ctx); err != nil {
+		return nil, err
 	}
 
 	queryParams := neturl.Values{}
@@ -45,7 +48,7 @@ func (c prysmBeaconChainClient) GetValidatorCount(ctx context.Context, stateID s
 	queryUrl := buildURL(fmt.Sprintf("/eth/v1/beacon/states/%s/validator_count", stateID), queryParams)
 
 	var validatorCountResponse structs.GetValidatorCountResponse
-	if err = c.jsonRestHandler.Get(ctx, queryUrl, &validatorCountResponse); err != nil {
+	if err := c.jsonRestHandler.Get(ctx, queryUrl, &validatorCountResponse); err != nil {
 		return nil, err
 	}
 
@@ -72,3 +75,82 @@ func (c prysmBeaconChainClient) GetValidatorCount(ctx context.Context, stateID s
 
 	return resp, nil
 }
+
+func (c *prysmBeaconChainClient) TrackValidators(ctx context.Context, pubkeys [][]byte) error {
+	if err := c.checkPrysmNode(ctx); err != nil {
+		return err
+	}
+	body, err := validatorMonitorPubkeysBody(pubkeys)
+	if err != nil {
+		return err
+	}
+	return c.jsonRestHandler.Post(ctx, "/prysm/v1/validators/monitor/pubkeys", nil, body, nil)
+}
+
+func (c *prysmBeaconChainClient) UntrackValidators(ctx context.Context, pubkeys [][]byte) error {
+	if err := c.checkPrysmNode(ctx); err != nil {
+		return err
+	}
+	body, err := validatorMonitorPubkeysBody(pubkeys)
+	if err != nil {
+		return err
+	}
+	return c.jsonRestHandler.Delete(ctx, "/prysm/v1/validators/monitor/pubkeys", body, nil)
+}
+
+func (c *prysmBeaconChainClient) SetBuilderRelays(ctx context.Context, relays map[primitives.ValidatorIndex][]string) error {
+	if err := c.checkPrysmNode(ctx); err != nil {
+		return err
+	}
+	indices := make([]primitives.ValidatorIndex, 0, len(relays))
+	for index := range relays {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	req := make([]*structs.ValidatorBuilderRelays, len(indices))
+	for i, index := range indices {
+		req[i] = &structs.ValidatorBuilderRelays{
+			ValidatorIndex: strconv.FormatUint(uint64(index), 10),
+			Relays:         relays[index],
+		}
+		if req[i].Relays == nil {
+			req[i].Relays = []string{}
+		}
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal builder relays request")
+	}
+	return c.jsonRestHandler.Post(ctx, "/prysm/v1/builder/relays/validators", nil, bytes.NewBuffer(body), nil)
+}
+
+// checkPrysmNode returns iface.ErrNotSupported if the beacon node is not a prysm beacon node,
+// as prysm custom endpoints are not available on other clients. The node version is only requested once.
+func (c *prysmBeaconChainClient) checkPrysmNode(ctx context.Context) error {
+	c.isPrysmLock.Lock()
+	defer c.isPrysmLock.Unlock()
+	if c.isPrysm == nil {
+		nodeVersion, err := c.nodeClient.GetVersion(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to get node version")
+		}
+		isPrysm := strings.Contains(strings.ToLower(nodeVersion.Version), "prysm")
+		c.isPrysm = &isPrysm
+	}
+	if !*c.isPrysm {
+		return iface.ErrNotSupported
+	}
+	return nil
+}
+
+func validatorMonitorPubkeysBody(pubkeys [][]byte) (*bytes.Buffer, error) {
+	req := &structs.ValidatorMonitorPubkeysRequest{Pubkeys: make([]string, len(pubkeys))}
+	for i, pk := range pubkeys {
+		req.Pubkeys[i] = hexutil.Encode(pk)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator monitor request")
+	}
+	return bytes.NewBuffer(body), nil
+}
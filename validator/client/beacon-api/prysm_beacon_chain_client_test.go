@@ -0,0 +1,59 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetBuilderRelays(t *testing.T) {
+	relays := map[primitives.ValidatorIndex][]string{
+		10: nil,
+		2:  {"https://relay-a.example.com", "https://relay-b.example.com"},
+	}
+
+	newClient := func(ctrl *gomock.Controller, version string) (*mock.MockJsonRestHandler, iface.PrysmBeaconChainClient) {
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Get(
+			gomock.Any(),
+			"/eth/v1/node/version",
+			&structs.GetVersionResponse{},
+		).Return(
+			nil,
+		).SetArg(
+			2,
+			structs.GetVersionResponse{Data: &structs.Version{Version: version}},
+		)
+		return jsonRestHandler, &prysmBeaconChainClient{
+			nodeClient:      &beaconApiNodeClient{jsonRestHandler: jsonRestHandler},
+			jsonRestHandler: jsonRestHandler,
+		}
+	}
+
+	t.Run("prysm beacon node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		jsonRestHandler, client := newClient(ctrl, "prysm/v0.0.1")
+		jsonRestHandler.EXPECT().Post(
+			gomock.Any(),
+			"/prysm/v1/builder/relays/validators",
+			nil,
+			bytes.NewBufferString(`[{"validator_index":"2","relays":["https://relay-a.example.com","https://relay-b.example.com"]},{"validator_index":"10","relays":[]}]`),
+			nil,
+		).Return(nil)
+		require.NoError(t, client.SetBuilderRelays(context.Background(), relays))
+	})
+	t.Run("not supported beacon node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		_, client := newClient(ctrl, "lighthouse/v0.0.1")
+		require.ErrorIs(t, client.SetBuilderRelays(context.Background(), relays), iface.ErrNotSupported)
+	})
+}
@@ -0,0 +1,80 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+func TestValidatorMonitorPubkeys(t *testing.T) {
+	pubkeys := [][]byte{bytes.Repeat([]byte{1}, 48), bytes.Repeat([]byte{2}, 48)}
+	marshalledRequest, err := json.Marshal(&structs.ValidatorMonitorPubkeysRequest{
+		Pubkeys: []string{hexutil.Encode(pubkeys[0]), hexutil.Encode(pubkeys[1])},
+	})
+	require.NoError(t, err)
+
+	newClient := func(ctrl *gomock.Controller, version string) (*mock.MockJsonRestHandler, iface.PrysmBeaconChainClient) {
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Get(
+			gomock.Any(),
+			"/eth/v1/node/version",
+			&structs.GetVersionResponse{},
+		).Return(
+			nil,
+		).SetArg(
+			2,
+			structs.GetVersionResponse{Data: &structs.Version{Version: version}},
+		)
+		return jsonRestHandler, &prysmBeaconChainClient{
+			nodeClient:      &beaconApiNodeClient{jsonRestHandler: jsonRestHandler},
+			jsonRestHandler: jsonRestHandler,
+		}
+	}
+
+	t.Run("track", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		jsonRestHandler, client := newClient(ctrl, "prysm/v0.0.1")
+		jsonRestHandler.EXPECT().Post(
+			gomock.Any(),
+			"/prysm/v1/validators/monitor/pubkeys",
+			nil,
+			bytes.NewBuffer(marshalledRequest),
+			nil,
+		).Return(nil)
+		require.NoError(t, client.TrackValidators(context.Background(), pubkeys))
+	})
+	t.Run("untrack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		jsonRestHandler, client := newClient(ctrl, "prysm/v0.0.1")
+		jsonRestHandler.EXPECT().Delete(
+			gomock.Any(),
+			"/prysm/v1/validators/monitor/pubkeys",
+			bytes.NewBuffer(marshalledRequest),
+			nil,
+		).Return(nil)
+		require.NoError(t, client.UntrackValidators(context.Background(), pubkeys))
+	})
+	t.Run("not supported beacon node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		_, client := newClient(ctrl, "lighthouse/v0.0.1")
+		require.ErrorIs(t, client.TrackValidators(context.Background(), pubkeys), iface.ErrNotSupported)
+	})
+	t.Run("node type is only checked once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		_, client := newClient(ctrl, "lighthouse/v0.0.1")
+		require.ErrorIs(t, client.TrackValidators(context.Background(), pubkeys), iface.ErrNotSupported)
+		require.ErrorIs(t, client.UntrackValidators(context.Background(), pubkeys), iface.ErrNotSupported)
+	})
+}
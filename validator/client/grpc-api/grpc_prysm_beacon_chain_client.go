@@ -52,6 +52,21 @@ func (g grpcPrysmBeaconChainClient) GetValidatorCount(ctx context.Context, _ str
 	return valCount, nil
 }
 
+// TrackValidators is not supported over gRPC, the validator monitor is only exposed through the prysm REST API.
+func (grpcPrysmBeaconChainClient) TrackValidators(_ context.Context, _ [][]byte) error {
+	return iface.ErrNotSupported
+}
+
+// SetBuilderRelays does nothing over gRPC, where the builder relays are sent with the PrepareBeaconProposer request.
+func (grpcPrysmBeaconChainClient) SetBuilderRelays(_ context.Context, _ map[primitives.ValidatorIndex][]string) error {
+	return nil
+}
+
+// UntrackValidators is not supported over gRPC, the validator monitor is only exposed through the prysm REST API.
+func (grpcPrysmBeaconChainClient) UntrackValidators(_ context.Context, _ [][]byte) error {
+	return iface.ErrNotSupported
+}
+
 // validatorCountByStatus returns a slice of validator count for each status in the given epoch.
 func validatorCountByStatus(validators []*ethpb.Validator, statuses []validator.Status, epoch primitives.Epoch) ([]iface.ValidatorCount, error) {
 	countByStatus := make(map[validator.Status]uint64)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 )
 
@@ -17,4 +18,11 @@ type ValidatorCount struct {
 // PrysmBeaconChainClient defines an interface required to implement all the prysm specific custom endpoints.
 type PrysmBeaconChainClient interface {
 	GetValidatorCount(context.Context, string, []validator.Status) ([]ValidatorCount, error)
+	// TrackValidators registers the validators with the given public keys with the beacon node's validator monitor.
+	TrackValidators(ctx context.Context, pubkeys [][]byte) error
+	// UntrackValidators removes the validators with the given public keys from the beacon node's validator monitor.
+	UntrackValidators(ctx context.Context, pubkeys [][]byte) error
+	// SetBuilderRelays sets the builder relays the beacon node may ask a payload header for each validator.
+	// An empty list permits all the relays of the beacon node.
+	SetBuilderRelays(ctx context.Context, relays map[primitives.ValidatorIndex][]string) error
 }
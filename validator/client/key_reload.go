@@ -1,13 +1,16 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	validator2 "github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"go.opencensus.io/trace"
 )
@@ -48,5 +51,112 @@ func (v *validator) HandleKeyReload(ctx context.Context, currentKeys [][fieldpar
 		valCount = int64(valCounts[0].Count)
 	}
 
-	return v.checkAndLogValidatorStatus(statuses, valCount), nil
+	anyActive = v.checkAndLogValidatorStatus(statuses, valCount)
+	v.updateMonitoredValidators(ctx, currentKeys)
+	return anyActive, nil
+}
+
+// maxMonitoredKeysPerRequest is the maximum number of keys the beacon node monitor accepts in a single request.
+const maxMonitoredKeysPerRequest = 512
+
+// RegisterMonitoredValidators registers all the loaded keys with the validator monitor of the beacon node. The beacon
+// node forgets them when it restarts, so they are registered again on each (re)connection and at each epoch start.
+// Keys without a validator in the beacon state are ignored by the beacon node. Errors are only logged, as monitoring
+// is not needed for the validator to perform its duties.
+func (v *validator) RegisterMonitoredValidators(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "validator.RegisterMonitoredValidators")
+	defer span.End()
+
+	km, err := v.Keymanager()
+	if err != nil {
+		log.WithError(err).Debug("Could not get keymanager to register validators with the beacon node monitor")
+		return
+	}
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get keys to register validators with the beacon node monitor")
+		return
+	}
+
+	v.monitoredKeysLock.Lock()
+	defer v.monitoredKeysLock.Unlock()
+	v.monitoredKeys = make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(keys))
+	pubkeys := make([][]byte, len(keys))
+	for i, key := range keys {
+		v.monitoredKeys[key] = true
+		pubkeys[i] = bytesutil.SafeCopyBytes(key[:])
+	}
+	if len(pubkeys) == 0 {
+		return
+	}
+	if err := inMonitorBatches(pubkeys, func(batch [][]byte) error {
+		return v.prysmBeaconClient.TrackValidators(ctx, batch)
+	}); err != nil {
+		logMonitorError(err)
+		return
+	}
+	log.WithField("count", len(pubkeys)).Debug("Registered validators with the beacon node monitor")
+}
+
+// updateMonitoredValidators registers the keys added by a key reload with the validator monitor of the beacon node,
+// and removes the keys which are no longer loaded. The monitored keys follow the loaded keys even when the beacon node
+// can't be updated, the loaded keys being registered again at the next epoch start.
+func (v *validator) updateMonitoredValidators(ctx context.Context, currentKeys [][fieldparams.BLSPubkeyLength]byte) {
+	v.monitoredKeysLock.Lock()
+	defer v.monitoredKeysLock.Unlock()
+	current := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(currentKeys))
+	var added [][]byte
+	for _, key := range currentKeys {
+		current[key] = true
+		if !v.monitoredKeys[key] {
+			added = append(added, bytesutil.SafeCopyBytes(key[:]))
+		}
+	}
+	var removed [][]byte
+	for key := range v.monitoredKeys {
+		if !current[key] {
+			removed = append(removed, bytesutil.SafeCopyBytes(key[:]))
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool { return bytes.Compare(removed[i], removed[j]) < 0 })
+	v.monitoredKeys = current
+
+	if len(removed) > 0 {
+		if err := inMonitorBatches(removed, func(batch [][]byte) error {
+			return v.prysmBeaconClient.UntrackValidators(ctx, batch)
+		}); err != nil {
+			logMonitorError(err)
+		} else {
+			log.WithField("count", len(removed)).Info("Removed validators from the beacon node monitor")
+		}
+	}
+	if len(added) > 0 {
+		if err := inMonitorBatches(added, func(batch [][]byte) error {
+			return v.prysmBeaconClient.TrackValidators(ctx, batch)
+		}); err != nil {
+			logMonitorError(err)
+		} else {
+			log.WithField("count", len(added)).Info("Registered validators with the beacon node monitor")
+		}
+	}
+}
+
+// inMonitorBatches calls f with the given keys split in batches the beacon node monitor accepts.
+func inMonitorBatches(pubkeys [][]byte, f func([][]byte) error) error {
+	for len(pubkeys) > 0 {
+		n := min(len(pubkeys), maxMonitoredKeysPerRequest)
+		if err := f(pubkeys[:n]); err != nil {
+			return err
+		}
+		pubkeys = pubkeys[n:]
+	}
+	return nil
+}
+
+func logMonitorError(err error) {
+	if errors.Is(err, iface.ErrNotSupported) {
+		log.Debug("Beacon node does not support registering validators with its monitor")
+		return
+	}
+	log.WithError(err).Warn("Could not update the validators tracked by the beacon node monitor")
 }
@@ -53,6 +53,7 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 			"head",
 			[]validator2.Status{validator2.Active},
 		).Return([]iface.ValidatorCount{}, nil)
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), [][]byte{inactive.pub[:], active.pub[:]}).Return(nil)
 
 		anyActive, err := v.HandleKeyReload(context.Background(), [][fieldparams.BLSPubkeyLength]byte{inactive.pub, active.pub})
 		require.NoError(t, err)
@@ -61,6 +62,94 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 		assert.LogsContain(t, hook, "Validator activated")
 	})
 
+	t.Run("monitored keys follow reloads", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+
+		kept := randKeypair(t)
+		deleted := randKeypair(t)
+		imported := randKeypair(t)
+
+		client := validatormock.NewMockValidatorClient(ctrl)
+		prysmBeaconClient := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		v := validator{
+			validatorClient:   client,
+			keyManager:        newMockKeymanager(t, kept, imported),
+			genesisTime:       1,
+			prysmBeaconClient: prysmBeaconClient,
+			monitoredKeys: map[[fieldparams.BLSPubkeyLength]byte]bool{
+				kept.pub:    true,
+				deleted.pub: true,
+			},
+		}
+
+		resp := testutil.GenerateMultipleValidatorStatusResponse([][]byte{kept.pub[:], imported.pub[:]})
+		resp.Statuses[0].Status = ethpb.ValidatorStatus_ACTIVE
+		resp.Statuses[1].Status = ethpb.ValidatorStatus_PENDING
+		client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+		prysmBeaconClient.EXPECT().GetValidatorCount(gomock.Any(), "head", gomock.Any()).Return([]iface.ValidatorCount{}, nil)
+		prysmBeaconClient.EXPECT().UntrackValidators(gomock.Any(), [][]byte{deleted.pub[:]}).Return(nil)
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), [][]byte{imported.pub[:]}).Return(nil)
+
+		_, err := v.HandleKeyReload(context.Background(), [][fieldparams.BLSPubkeyLength]byte{kept.pub, imported.pub})
+		require.NoError(t, err)
+		require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte]bool{kept.pub: true, imported.pub: true}, v.monitoredKeys)
+		assert.LogsContain(t, hook, "Removed validators from the beacon node monitor")
+		assert.LogsContain(t, hook, "Registered validators with the beacon node monitor")
+	})
+
+	t.Run("monitor not supported", func(t *testing.T) {
+		kp := randKeypair(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		prysmBeaconClient := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		v := validator{
+			validatorClient:   client,
+			keyManager:        newMockKeymanager(t, kp),
+			genesisTime:       1,
+			prysmBeaconClient: prysmBeaconClient,
+		}
+
+		resp := testutil.GenerateMultipleValidatorStatusResponse([][]byte{kp.pub[:]})
+		resp.Statuses[0].Status = ethpb.ValidatorStatus_ACTIVE
+		client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+		prysmBeaconClient.EXPECT().GetValidatorCount(gomock.Any(), "head", gomock.Any()).Return(nil, iface.ErrNotSupported)
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), [][]byte{kp.pub[:]}).Return(iface.ErrNotSupported)
+
+		anyActive, err := v.HandleKeyReload(context.Background(), [][fieldparams.BLSPubkeyLength]byte{kp.pub})
+		require.NoError(t, err)
+		assert.Equal(t, true, anyActive)
+		assert.Equal(t, 1, len(v.monitoredKeys))
+	})
+
+	t.Run("monitor untrack error", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+
+		deleted := randKeypair(t)
+		imported := randKeypair(t)
+
+		client := validatormock.NewMockValidatorClient(ctrl)
+		prysmBeaconClient := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		v := validator{
+			validatorClient:   client,
+			keyManager:        newMockKeymanager(t, imported),
+			genesisTime:       1,
+			prysmBeaconClient: prysmBeaconClient,
+			monitoredKeys:     map[[fieldparams.BLSPubkeyLength]byte]bool{deleted.pub: true},
+		}
+
+		resp := testutil.GenerateMultipleValidatorStatusResponse([][]byte{imported.pub[:]})
+		resp.Statuses[0].Status = ethpb.ValidatorStatus_ACTIVE
+		client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+		prysmBeaconClient.EXPECT().GetValidatorCount(gomock.Any(), "head", gomock.Any()).Return([]iface.ValidatorCount{}, nil)
+		prysmBeaconClient.EXPECT().UntrackValidators(gomock.Any(), [][]byte{deleted.pub[:]}).Return(errors.New("untrack failed"))
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), [][]byte{imported.pub[:]}).Return(nil)
+
+		_, err := v.HandleKeyReload(context.Background(), [][fieldparams.BLSPubkeyLength]byte{imported.pub})
+		require.NoError(t, err)
+		require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte]bool{imported.pub: true}, v.monitoredKeys)
+		assert.LogsContain(t, hook, "untrack failed")
+		assert.LogsContain(t, hook, "Registered validators with the beacon node monitor")
+	})
+
 	t.Run("no active", func(t *testing.T) {
 		hook := logTest.NewGlobal()
 
@@ -89,6 +178,7 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 			"head",
 			[]validator2.Status{validator2.Active},
 		).Return([]iface.ValidatorCount{}, nil)
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), [][]byte{kp.pub[:]}).Return(nil)
 
 		anyActive, err := v.HandleKeyReload(context.Background(), [][fieldparams.BLSPubkeyLength]byte{kp.pub})
 		require.NoError(t, err)
@@ -117,3 +207,37 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 		assert.ErrorContains(t, "error", err)
 	})
 }
+
+func TestValidator_RegisterMonitoredValidators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kps := make([]keypair, maxMonitoredKeysPerRequest+1)
+	pubkeys := make([][]byte, len(kps))
+	for i := range kps {
+		kps[i] = randKeypair(t)
+	}
+	km := newMockKeymanager(t, kps...)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	for i := range keys {
+		pubkeys[i] = keys[i][:]
+	}
+
+	prysmBeaconClient := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+	v := validator{
+		keyManager:        km,
+		prysmBeaconClient: prysmBeaconClient,
+		monitoredKeys:     map[[fieldparams.BLSPubkeyLength]byte]bool{randKeypair(t).pub: true},
+	}
+
+	gomock.InOrder(
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), pubkeys[:maxMonitoredKeysPerRequest]).Return(nil),
+		prysmBeaconClient.EXPECT().TrackValidators(gomock.Any(), pubkeys[maxMonitoredKeysPerRequest:]).Return(nil),
+	)
+	v.RegisterMonitoredValidators(context.Background())
+	require.Equal(t, len(kps), len(v.monitoredKeys))
+	for _, key := range keys {
+		assert.Equal(t, true, v.monitoredKeys[key])
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
@@ -60,6 +61,35 @@ func SubmitValidatorRegistrations(
 	return lastErr
 }
 
+// SubmitBuilderRelays sends the builder relays permitted by the validators of the proposer settings to the beacon node,
+// which only asks these relays for a payload header when one of the validators proposes. The relays are a prysm
+// extension of the builder flow, other beacon nodes use all their relays.
+func SubmitBuilderRelays(
+	ctx context.Context,
+	prysmClient iface.PrysmBeaconChainClient,
+	proposerReqs []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer,
+) error {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitBuilderRelays")
+	defer span.End()
+
+	relays := make(map[primitives.ValidatorIndex][]string, len(proposerReqs))
+	restricted := false
+	for _, r := range proposerReqs {
+		relays[r.ValidatorIndex] = r.Relays
+		restricted = restricted || len(r.Relays) > 0
+	}
+	if err := prysmClient.SetBuilderRelays(ctx, relays); err != nil {
+		if errors.Is(err, iface.ErrNotSupported) {
+			if restricted {
+				log.Warn("Beacon node does not support per-validator builder relays, all its relays may be used")
+			}
+			return nil
+		}
+		return errors.Wrap(err, "could not submit builder relays to beacon node")
+	}
+	return nil
+}
+
 // Sings validator registration obj with the proposer domain and private key.
 func signValidatorRegistration(ctx context.Context, signer iface.SigningFunc, reg *ethpb.ValidatorRegistrationV1) ([]byte, error) {
 	// Per spec, we want the fork version and genesis validator to be nil.
@@ -13,7 +13,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
 )
 
@@ -129,6 +133,39 @@ func TestSubmitValidatorRegistration_CantSign(t *testing.T) {
 	}
 }
 
+func TestSubmitBuilderRelays(t *testing.T) {
+	ctx := context.Background()
+	proposerReqs := []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+		{ValidatorIndex: 1, FeeRecipient: make([]byte, 20), Relays: []string{"https://relay-a.example.com"}},
+		{ValidatorIndex: 2, FeeRecipient: make([]byte, 20)},
+	}
+	relays := map[primitives.ValidatorIndex][]string{1: {"https://relay-a.example.com"}, 2: nil}
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		client := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		client.EXPECT().SetBuilderRelays(gomock.Any(), relays).Return(nil)
+		require.NoError(t, SubmitBuilderRelays(ctx, client, proposerReqs))
+	})
+	t.Run("not supported", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		client := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		client.EXPECT().SetBuilderRelays(gomock.Any(), relays).Return(iface.ErrNotSupported)
+		require.NoError(t, SubmitBuilderRelays(ctx, client, proposerReqs))
+		assert.LogsContain(t, hook, "Beacon node does not support per-validator builder relays")
+	})
+	t.Run("error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		client := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		client.EXPECT().SetBuilderRelays(gomock.Any(), relays).Return(errors.New("bad"))
+		require.ErrorContains(t, "could not submit builder relays", SubmitBuilderRelays(ctx, client, proposerReqs))
+	})
+}
+
 func Test_signValidatorRegistration(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
@@ -68,6 +68,7 @@ func run(ctx context.Context, v iface.Validator) {
 			log.WithError(err).Fatal("Failed to update proposer settings") // allow fatal. skipcq
 		}
 	}
+	go v.RegisterMonitoredValidators(ctx)
 	for {
 		ctx, span := trace.StartSpan(ctx, "validator.processSlot")
 		select {
@@ -107,6 +108,8 @@ func run(ctx context.Context, v iface.Validator) {
 						log.WithError(err).Warn("Failed to update proposer settings")
 					}
 				}()
+				// The beacon node forgets the validators registered with its monitor when it restarts.
+				go v.RegisterMonitoredValidators(ctx)
 			}
 
 			// Start fetching domain data for the next epoch.
@@ -135,6 +138,7 @@ func run(ctx context.Context, v iface.Validator) {
 					handleAssignmentError(err, headSlot)
 					continue
 				}
+				go v.RegisterMonitoredValidators(ctx)
 			}
 		case e := <-eventsChan:
 			v.ProcessEvent(e)
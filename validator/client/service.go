@@ -77,6 +77,8 @@ type ValidatorService struct {
 	Web3SignerConfig       *remoteweb3signer.SetupConfig
 	proposerSettings       *proposer.Settings
 	validatorsRegBatchSize int
+	beaconApiBroadcast     bool
+	attestationConsensus   bool
 }
 
 // Config for the validator service.
@@ -103,6 +105,8 @@ type Config struct {
 	ProposerSettings           *proposer.Settings
 	BeaconApiEndpoint          string
 	BeaconApiTimeout           time.Duration
+	BeaconApiBroadcast         bool
+	AttestationConsensus       bool
 	ValidatorsRegBatchSize     int
 }
 
@@ -133,6 +137,8 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		Web3SignerConfig:       cfg.Web3SignerConfig,
 		proposerSettings:       cfg.ProposerSettings,
 		validatorsRegBatchSize: cfg.ValidatorsRegBatchSize,
+		beaconApiBroadcast:     cfg.BeaconApiBroadcast,
+		attestationConsensus:   cfg.AttestationConsensus,
 		distributed:            cfg.Distributed,
 	}
 
@@ -194,12 +200,18 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	restHandler := beaconApi.NewBeaconApiJsonRestHandler(
+	restHandler := beaconApi.NewJsonRestHandler(
+		v.ctx,
 		http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
 		v.conn.GetBeaconApiUrl(),
+		v.beaconApiBroadcast,
 	)
 
-	validatorClient := validatorClientFactory.NewValidatorClient(v.conn, restHandler)
+	var validatorClientOpts []beaconApi.ValidatorClientOpt
+	if v.attestationConsensus {
+		validatorClientOpts = append(validatorClientOpts, beaconApi.WithAttestationDataConsensus())
+	}
+	validatorClient := validatorClientFactory.NewValidatorClient(v.conn, restHandler, validatorClientOpts...)
 
 	valStruct := &validator{
 		validatorClient:                validatorClient,
@@ -213,6 +225,7 @@ func (v *ValidatorService) Start() {
 		startBalances:                  make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
 		prevBalance:                    make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
 		pubkeyToValidatorIndex:         make(map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex),
+		monitoredKeys:                  make(map[[fieldparams.BLSPubkeyLength]byte]bool),
 		signedValidatorRegistrations:   make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1),
 		submittedAtts:                  make(map[submittedAttKey]*submittedAtt),
 		submittedAggregates:            make(map[submittedAttKey]*submittedAtt),
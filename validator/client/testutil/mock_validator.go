@@ -242,6 +242,9 @@ func (*FakeValidator) HasProposerSettings() bool {
 	return true
 }
 
+// RegisterMonitoredValidators for mocking
+func (*FakeValidator) RegisterMonitoredValidators(_ context.Context) {}
+
 // PushProposerSettings for mocking
 func (fv *FakeValidator) PushProposerSettings(ctx context.Context, km keymanager.IKeymanager, slot primitives.Slot, deadline time.Time) error {
 	nctx, cancel := context.WithDeadline(ctx, deadline)
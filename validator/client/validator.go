@@ -89,6 +89,8 @@ type validator struct {
 	duties                             *ethpb.DutiesResponse
 	prevBalance                        map[[fieldparams.BLSPubkeyLength]byte]uint64
 	pubkeyToValidatorIndex             map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex
+	monitoredKeysLock                  sync.Mutex
+	monitoredKeys                      map[[fieldparams.BLSPubkeyLength]byte]bool
 	signedValidatorRegistrations       map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1
 	attSelections                      map[attSelectionKey]iface.BeaconCommitteeSelection
 	graffitiOrderedIndex               uint64
@@ -1065,6 +1067,11 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 	if err := SubmitValidatorRegistrations(ctx, v.validatorClient, signedRegReqs, v.validatorsRegBatchSize); err != nil {
 		return errors.Wrap(ErrBuilderValidatorRegistration, err.Error())
 	}
+	if len(signedRegReqs) > 0 {
+		if err := SubmitBuilderRelays(ctx, v.prysmBeaconClient, proposerReqs); err != nil {
+			return errors.Wrap(ErrBuilderValidatorRegistration, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -1157,11 +1164,16 @@ func (v *validator) buildPrepProposerReqs(ctx context.Context, pubkeys [][fieldp
 	for _, k := range pubkeys {
 		// Default case: Define fee recipient to burn address
 		var feeRecipient common.Address
+		// Default case: Permit all the relays of the beacon node
+		var relays []string
 
 		// If fee recipient is defined in default configuration, use it
 		if v.ProposerSettings() != nil && v.ProposerSettings().DefaultConfig != nil && v.ProposerSettings().DefaultConfig.FeeRecipientConfig != nil {
 			feeRecipient = v.ProposerSettings().DefaultConfig.FeeRecipientConfig.FeeRecipient // Use cli config for fee recipient.
 		}
+		if v.ProposerSettings() != nil && v.ProposerSettings().DefaultConfig != nil && v.ProposerSettings().DefaultConfig.BuilderConfig != nil {
+			relays = v.ProposerSettings().DefaultConfig.BuilderConfig.Relays // Use default config for relays.
+		}
 
 		// If fee recipient is defined for this specific pubkey in proposer configuration, use it
 		if v.ProposerSettings() != nil && v.ProposerSettings().ProposeConfig != nil {
@@ -1170,6 +1182,9 @@ func (v *validator) buildPrepProposerReqs(ctx context.Context, pubkeys [][fieldp
 			if ok && config != nil && config.FeeRecipientConfig != nil {
 				feeRecipient = config.FeeRecipientConfig.FeeRecipient // Use file config for fee recipient.
 			}
+			if ok && config != nil && config.BuilderConfig != nil {
+				relays = config.BuilderConfig.Relays // Use file config for relays.
+			}
 		}
 
 		validatorIndex, ok := v.pubkeyToValidatorIndex[k]
@@ -1180,6 +1195,7 @@ func (v *validator) buildPrepProposerReqs(ctx context.Context, pubkeys [][fieldp
 		prepareProposerReqs = append(prepareProposerReqs, &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
 			ValidatorIndex: validatorIndex,
 			FeeRecipient:   feeRecipient[:],
+			Relays:         relays,
 		})
 	}
 	return prepareProposerReqs, nil
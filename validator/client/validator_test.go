@@ -1447,6 +1447,8 @@ func TestValidator_PushSettings(t *testing.T) {
 		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, isSlashingProtectionMinimal)
 		client := validatormock.NewMockValidatorClient(ctrl)
 		nodeClient := validatormock.NewMockNodeClient(ctrl)
+		prysmBeaconClient := validatormock.NewMockPrysmBeaconChainClient(ctrl)
+		prysmBeaconClient.EXPECT().SetBuilderRelays(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		defaultFeeHex := "0x046Fb65722E7b2455043BFEBf6177F1D2e9738D9"
 		byteValueAddress, err := hexutil.Decode("0x046Fb65722E7b2455043BFEBf6177F1D2e9738D9")
 		require.NoError(t, err)
@@ -1987,6 +1989,7 @@ func TestValidator_PushSettings(t *testing.T) {
 			t.Run(tt.name, func(t *testing.T) {
 				hook := logTest.NewGlobal()
 				v := tt.validatorSetter(t)
+				v.prysmBeaconClient = prysmBeaconClient
 				km, err := v.Keymanager()
 				require.NoError(t, err)
 				pubkeys, err := km.FetchValidatingPublicKeys(ctx)
@@ -2181,6 +2184,10 @@ func TestValidator_buildPrepProposerReqs_WithDefaultConfig(t *testing.T) {
 
 	defaultFeeRecipient := getFeeRecipientFromString(t, "0xdddddddddddddddddddddddddddddddddddddddd")
 
+	// Relays
+	relays1 := []string{"https://relay-1.example.com"}
+	defaultRelays := []string{"https://relay-a.example.com", "https://relay-b.example.com"}
+
 	pubkeyToStatus := map[[fieldparams.BLSPubkeyLength]byte]ethpb.ValidatorStatus{
 		pubkey1: ethpb.ValidatorStatus_ACTIVE,
 		pubkey2: ethpb.ValidatorStatus_ACTIVE,
@@ -2247,12 +2254,20 @@ func TestValidator_buildPrepProposerReqs_WithDefaultConfig(t *testing.T) {
 				FeeRecipientConfig: &proposer.FeeRecipientConfig{
 					FeeRecipient: defaultFeeRecipient,
 				},
+				BuilderConfig: &proposer.BuilderConfig{
+					Enabled: true,
+					Relays:  defaultRelays,
+				},
 			},
 			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
 				pubkey1: {
 					FeeRecipientConfig: &proposer.FeeRecipientConfig{
 						FeeRecipient: feeRecipient1,
 					},
+					BuilderConfig: &proposer.BuilderConfig{
+						Enabled: true,
+						Relays:  relays1,
+					},
 				},
 				pubkey2: {
 					FeeRecipientConfig: &proposer.FeeRecipientConfig{
@@ -2268,6 +2283,9 @@ func TestValidator_buildPrepProposerReqs_WithDefaultConfig(t *testing.T) {
 					FeeRecipientConfig: &proposer.FeeRecipientConfig{
 						FeeRecipient: feeRecipient8,
 					},
+					BuilderConfig: &proposer.BuilderConfig{
+						Enabled: false,
+					},
 				},
 			},
 		},
@@ -2296,18 +2314,22 @@ func TestValidator_buildPrepProposerReqs_WithDefaultConfig(t *testing.T) {
 		{
 			ValidatorIndex: 1,
 			FeeRecipient:   feeRecipient1[:],
+			Relays:         relays1,
 		},
 		{
 			ValidatorIndex: 2,
 			FeeRecipient:   feeRecipient2[:],
+			Relays:         defaultRelays,
 		},
 		{
 			ValidatorIndex: 4,
 			FeeRecipient:   defaultFeeRecipient[:],
+			Relays:         defaultRelays,
 		},
 		{
 			ValidatorIndex: 7,
 			FeeRecipient:   defaultFeeRecipient[:],
+			Relays:         defaultRelays,
 		},
 		{
 			ValidatorIndex: 8,
@@ -549,6 +549,8 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ProposerSettings:           ps,
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiBroadcast:         c.cliCtx.Bool(flags.BeaconRESTApiBroadcastFlag.Name),
+		AttestationConsensus:       c.cliCtx.Bool(flags.BeaconRESTApiAttestationConsensusFlag.Name),
 		ValidatorsRegBatchSize:     c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
 		Distributed:                c.cliCtx.Bool(flags.EnableDistributed.Name),
 	})